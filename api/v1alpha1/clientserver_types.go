@@ -0,0 +1,96 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClientServerSpec defines the desired state of ClientServer
+type ClientServerSpec struct {
+	// Namespace of the CLI server, it's created when it doesn't exist
+	//+kubebuilder:default:=trusted-artifact-signer
+	//+kubebuilder:validation:Pattern:="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	//+kubebuilder:validation:MaxLength=63
+	//+kubebuilder:validation:XValidation:rule=(self == oldSelf),message=Field is immutable
+	Namespace string `json:"namespace,omitempty"`
+	// Define whether you want to export service or not
+	//+kubebuilder:default:={enabled: true}
+	ExternalAccess ClientServerExternalAccess `json:"externalAccess,omitempty"`
+	// Secret in the namespace of the CLI server with the certificate (tls.crt) and private key (tls.key) of the Ingress.
+	// OpenShift generates the certificate of the Route when unset.
+	//+optional
+	TLSSecretRef *LocalObjectReference `json:"tlsSecretRef,omitempty"`
+	// Overrides of the CLI server pods
+	//+optional
+	Pod ClientServerPod `json:"pod,omitempty"`
+}
+
+// ClientServerExternalAccess configures the Ingress of the CLI server. Unlike the other components, the Ingress is removed when it's disabled.
+type ClientServerExternalAccess struct {
+	// If set to true, the Operator will create an Ingress or a Route resource.
+	//+kubebuilder:default:=true
+	Enabled bool `json:"enabled"`
+	// Set hostname for your Ingress/Route.
+	Host string `json:"host,omitempty"`
+}
+
+type ClientServerPod struct {
+	// Number of CLI server replicas
+	//+kubebuilder:validation:Minimum:=1
+	//+kubebuilder:default:=1
+	//+optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Compute resources of the CLI server container
+	//+optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	//+optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	//+optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	//+optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+}
+
+// ClientServerStatus defines the observed state of ClientServer
+type ClientServerStatus struct {
+	// URL serving the clients, e.g. <url>/clients/linux/cosign-amd64.gz,
+	// and the client configuration of the Securesign instances, e.g. <url>/config/<namespace>.<name>.env
+	Url string `json:"url,omitempty"`
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:scope=Cluster
+//+kubebuilder:validation:XValidation:rule=(self.metadata.name == 'cli-server'),message=The name must be cli-server
+//+kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="The component status"
+//+kubebuilder:printcolumn:name="Namespace",type=string,JSONPath=`.spec.namespace`,description="The namespace of the CLI server"
+//+kubebuilder:printcolumn:name="URL",type=string,JSONPath=`.status.url`,description="The component url"
+
+// ClientServer is the Schema for the clientservers API.
+// It serves the cosign, gitsign, rekor-cli and ec binaries, there is a single ClientServer named cli-server in the cluster.
+type ClientServer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClientServerSpec   `json:"spec,omitempty"`
+	Status ClientServerStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ClientServerList contains a list of ClientServer
+type ClientServerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClientServer `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClientServer{}, &ClientServerList{})
+}
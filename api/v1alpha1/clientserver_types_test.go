@@ -0,0 +1,69 @@
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("ClientServer", func() {
+
+	Context("ClientServerSpec", func() {
+		It("can be created", func() {
+			created := generateClientServerObject("cli-server")
+			Expect(k8sClient.Create(context.Background(), created)).To(Succeed())
+
+			fetched := &ClientServer{}
+			Expect(k8sClient.Get(context.Background(), getKey(created), fetched)).To(Succeed())
+			Expect(fetched.Spec.Namespace).To(Equal("trusted-artifact-signer"))
+			Expect(fetched.Spec.ExternalAccess.Enabled).To(BeTrue())
+
+			Expect(k8sClient.Delete(context.Background(), created)).To(Succeed())
+		})
+
+		It("external access can be disabled", func() {
+			created := generateClientServerObject("cli-server")
+			Expect(k8sClient.Create(context.Background(), created)).To(Succeed())
+
+			fetched := &ClientServer{}
+			Expect(k8sClient.Get(context.Background(), getKey(created), fetched)).To(Succeed())
+			fetched.Spec.ExternalAccess.Enabled = false
+			Expect(k8sClient.Update(context.Background(), fetched)).To(Succeed())
+
+			Expect(k8sClient.Delete(context.Background(), created)).To(Succeed())
+		})
+
+		Context("is validated", func() {
+			It("name is cli-server", func() {
+				invalid := generateClientServerObject("other")
+				Expect(apierrors.IsInvalid(k8sClient.Create(context.Background(), invalid))).To(BeTrue())
+				Expect(k8sClient.Create(context.Background(), invalid)).
+					To(MatchError(ContainSubstring("The name must be cli-server")))
+			})
+
+			It("namespace is immutable", func() {
+				created := generateClientServerObject("cli-server")
+				Expect(k8sClient.Create(context.Background(), created)).To(Succeed())
+
+				fetched := &ClientServer{}
+				Expect(k8sClient.Get(context.Background(), getKey(created), fetched)).To(Succeed())
+				fetched.Spec.Namespace = "clients"
+				Expect(apierrors.IsInvalid(k8sClient.Update(context.Background(), fetched))).To(BeTrue())
+				Expect(k8sClient.Update(context.Background(), fetched)).
+					To(MatchError(ContainSubstring("Field is immutable")))
+
+				Expect(k8sClient.Delete(context.Background(), created)).To(Succeed())
+			})
+		})
+	})
+})
+
+func generateClientServerObject(name string) *ClientServer {
+	return &ClientServer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}
+}
@@ -2,6 +2,7 @@ package v1alpha1
 
 import (
 	k8sresource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type ExternalAccess struct {
@@ -21,6 +22,15 @@ type MonitoringConfig struct {
 	Enabled bool `json:"enabled"`
 }
 
+// TrillianService references the Trillian instance storing the Merkle tree of a transparency log.
+type TrillianService struct {
+	// Name of the Trillian instance in the namespace, the Trillian with the name of the log is used when unset.
+	// The log connects to its Logserver and trusts its CA certificate.
+	//+kubebuilder:validation:Pattern:="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	//+optional
+	Name string `json:"name,omitempty"`
+}
+
 // LocalObjectReference contains enough information to let you locate the
 // referenced object inside the same namespace.
 // +structType=atomic
@@ -64,3 +74,38 @@ type Pvc struct {
 	//+optional
 	StorageClass string `json:"storageClass,omitempty"`
 }
+
+// KeyDiscovery defines how the keys of other components are found when they aren't referenced explicitly.
+//   - Status: the keys are read from the status of the components managed by the same Securesign
+//   - Labels: the keys are searched in the namespace by the rhtas.redhat.com/<key> labels, this fails when several Secrets match
+//
+// +kubebuilder:validation:Enum:=Status;Labels
+type KeyDiscovery string
+
+const (
+	KeyDiscoveryStatus KeyDiscovery = "Status"
+	KeyDiscoveryLabels KeyDiscovery = "Labels"
+)
+
+// LogMonitor configuration of the transparency log consistency verification
+type LogMonitor struct {
+	// If set to true, the Operator will periodically fetch signed checkpoints of the log
+	// and verify their consistency with the previously verified checkpoint.
+	//+kubebuilder:default:=false
+	Enabled bool `json:"enabled"`
+	// Interval between two consecutive checks, defaults to 10 minutes
+	//+optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+}
+
+// LogMonitorStatus contains the last verified state of the transparency log
+type LogMonitorStatus struct {
+	// The ID of a Trillian tree the checkpoint belongs to.
+	TreeID *int64 `json:"treeID,omitempty"`
+	// Size of the tree at the last verified checkpoint
+	TreeSize int64 `json:"treeSize,omitempty"`
+	// Base64 encoded root hash of the last verified checkpoint
+	RootHash string `json:"rootHash,omitempty"`
+	// Time of the last consistency check
+	LastCheckTime *metav1.Time `json:"lastCheckTime,omitempty"`
+}
@@ -10,11 +10,15 @@ import (
 // CTlogSpec defines the desired state of CTlog component
 // +kubebuilder:validation:XValidation:rule=(!has(self.publicKeyRef) || has(self.privateKeyRef)),message=privateKeyRef cannot be empty
 // +kubebuilder:validation:XValidation:rule=(!has(self.privateKeyPasswordRef) || has(self.privateKeyRef)),message=privateKeyRef cannot be empty
+// +kubebuilder:validation:XValidation:rule=(!has(self.notAfterStart) || !has(self.notAfterLimit) || self.notAfterStart < self.notAfterLimit),message=notAfterStart must be before notAfterLimit
 type CTlogSpec struct {
 	// The ID of a Trillian tree that stores the log data.
 	// If it is unset, the operator will create new Merkle tree in the Trillian backend
 	//+optional
 	TreeID *int64 `json:"treeID,omitempty"`
+	// Trillian storing the Merkle tree of the log
+	//+optional
+	Trillian TrillianService `json:"trillian,omitempty"`
 
 	// The private key used for signing STHs etc.
 	//+optional
@@ -35,8 +39,50 @@ type CTlogSpec struct {
 	//+optional
 	RootCertificates []SecretKeySelector `json:"rootCertificates,omitempty"`
 
+	// How the Fulcio root certificate is found when rootCertificates is empty.
+	// Status resolves it from the Fulcio managed by the same Securesign,
+	// Labels searches the namespace for the Secret labeled with rhtas.redhat.com/fulcio_v1.crt.pem.
+	//+kubebuilder:default:=Status
+	//+optional
+	KeyDiscovery KeyDiscovery `json:"keyDiscovery,omitempty"`
+
+	// Prefix of the log endpoints, the log is served at http://ctlog.<namespace>.svc/<prefix>.
+	// The prefix is propagated to the Fulcio managed by the same Securesign.
+	//+kubebuilder:default:=trusted-artifact-signer
+	//+kubebuilder:validation:Pattern:="^[-._a-zA-Z0-9]+$"
+	//+optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// Extended key usages accepted by the log, a certificate is accepted if it has any of them.
+	// Use Any to accept certificates with any extended key usage.
+	//+kubebuilder:default:={CodeSigning}
+	//+kubebuilder:validation:XValidation:rule="self.all(u, u in ['Any', 'ServerAuth', 'ClientAuth', 'CodeSigning', 'EmailProtection', 'IPSECEndSystem', 'IPSECTunnel', 'IPSECUser', 'TimeStamping', 'OCSPSigning', 'MicrosoftServerGatedCrypto', 'NetscapeServerGatedCrypto'])",message=unknown extended key usage
+	//+kubebuilder:validation:MinItems:=1
+	//+listType=set
+	//+optional
+	ExtKeyUsages []string `json:"extKeyUsages,omitempty"`
+
+	// If set to true, the log rejects expired certificates
+	//+optional
+	RejectExpired bool `json:"rejectExpired,omitempty"`
+
+	// If set to true, the log accepts only CA certificates
+	//+optional
+	AcceptOnlyCA bool `json:"acceptOnlyCA,omitempty"`
+
+	// The log accepts only certificates expiring at or after the time
+	//+optional
+	NotAfterStart *metav1.Time `json:"notAfterStart,omitempty"`
+
+	// The log accepts only certificates expiring before the time
+	//+optional
+	NotAfterLimit *metav1.Time `json:"notAfterLimit,omitempty"`
+
 	//Enable Service monitors for ctlog
 	Monitoring MonitoringConfig `json:"monitoring,omitempty"`
+
+	// Periodic verification of the log consistency
+	LogMonitor LogMonitor `json:"logMonitor,omitempty"`
 }
 
 // CTlogStatus defines the observed state of CTlog component
@@ -48,6 +94,11 @@ type CTlogStatus struct {
 	RootCertificates      []SecretKeySelector   `json:"rootCertificates,omitempty"`
 	// The ID of a Trillian tree that stores the log data.
 	TreeID *int64 `json:"treeID,omitempty"`
+	// Internal URL of the log including the prefix
+	//+optional
+	Url string `json:"url,omitempty"`
+	// The last verified state of the log
+	LogMonitor *LogMonitorStatus `json:"logMonitor,omitempty"`
 	// +listType=map
 	// +listMapKey=type
 	// +patchStrategy=merge
@@ -1,6 +1,8 @@
 package v1alpha1
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"golang.org/x/net/context"
@@ -55,6 +57,25 @@ var _ = Describe("CTlog", func() {
 					To(MatchError(ContainSubstring("privateKeyRef cannot be empty")))
 			})
 
+			It("extended key usage", func() {
+				invalidObject := generateCTlogObject("ext-key-usage-invalid")
+				invalidObject.Spec.ExtKeyUsages = []string{"CodeSigning", "DocumentSigning"}
+
+				Expect(apierrors.IsInvalid(k8sClient.Create(context.Background(), invalidObject))).To(BeTrue())
+				Expect(k8sClient.Create(context.Background(), invalidObject)).
+					To(MatchError(ContainSubstring("unknown extended key usage")))
+			})
+
+			It("certificate expiration range", func() {
+				invalidObject := generateCTlogObject("not-after-invalid")
+				invalidObject.Spec.NotAfterStart = &metav1.Time{Time: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+				invalidObject.Spec.NotAfterLimit = &metav1.Time{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+				Expect(apierrors.IsInvalid(k8sClient.Create(context.Background(), invalidObject))).To(BeTrue())
+				Expect(k8sClient.Create(context.Background(), invalidObject)).
+					To(MatchError(ContainSubstring("notAfterStart must be before notAfterLimit")))
+			})
+
 			It("private key password", func() {
 				invalidObject := generateCTlogObject("private-key-password-invalid")
 				invalidObject.Spec.PublicKeyRef = &SecretKeySelector{
@@ -76,6 +97,9 @@ var _ = Describe("CTlog", func() {
 
 			BeforeEach(func() {
 				expectedCTlogInstance = *generateCTlogObject("foo")
+				expectedCTlogInstance.Spec.Prefix = "trusted-artifact-signer"
+				expectedCTlogInstance.Spec.ExtKeyUsages = []string{"CodeSigning"}
+				expectedCTlogInstance.Spec.KeyDiscovery = KeyDiscoveryStatus
 			})
 
 			When("CR spec is empty", func() {
@@ -131,6 +155,11 @@ var _ = Describe("CTlog", func() {
 									},
 								},
 							},
+							KeyDiscovery:  KeyDiscoveryLabels,
+							Prefix:        "shard-2024",
+							ExtKeyUsages:  []string{"CodeSigning", "TimeStamping"},
+							RejectExpired: true,
+							AcceptOnlyCA:  true,
 						},
 					}
 
@@ -21,6 +21,25 @@ type FulcioSpec struct {
 	// ConfigMap with additional bundle of trusted CA
 	//+optional
 	TrustedCA *LocalObjectReference `json:"trustedCA,omitempty"`
+	// CTlog the issued certificates are submitted to
+	//+optional
+	Ctlog FulcioCtlog `json:"ctlog,omitempty"`
+}
+
+type FulcioCtlog struct {
+	// Address of the CTlog service, the ctlog service in the namespace is used when unset
+	//+optional
+	Address string `json:"address,omitempty"`
+	// Port of the CTlog service
+	//+kubebuilder:validation:Minimum:=1
+	//+kubebuilder:validation:Maximum:=65535
+	//+optional
+	Port *int32 `json:"port,omitempty"`
+	// Prefix of the log endpoints.
+	// The prefix of the CTlog managed by the same Securesign is used when unset, trusted-artifact-signer otherwise.
+	//+kubebuilder:validation:Pattern:="^[-._a-zA-Z0-9]+$"
+	//+optional
+	Prefix string `json:"prefix,omitempty"`
 }
 
 // FulcioCert defines fields for system-generated certificate
@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	k8sresource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -13,6 +14,9 @@ type RekorSpec struct {
 	// If it is unset, the operator will create new Merkle tree in the Trillian backend
 	//+optional
 	TreeID *int64 `json:"treeID,omitempty"`
+	// Trillian storing the Merkle tree of the log
+	//+optional
+	Trillian TrillianService `json:"trillian,omitempty"`
 	// Define whether you want to export service or not
 	ExternalAccess ExternalAccess `json:"externalAccess,omitempty"`
 	//Enable Service monitors for rekor
@@ -28,6 +32,60 @@ type RekorSpec struct {
 	// BackFillRedis CronJob Configuration
 	//+kubebuilder:default:={enabled: true, schedule: "0 0 * * *"}
 	BackFillRedis BackFillRedis `json:"backFillRedis,omitempty"`
+	// Periodic verification of the log consistency
+	LogMonitor LogMonitor `json:"logMonitor,omitempty"`
+	// Rekor server tuning
+	//+optional
+	Server RekorServer `json:"server,omitempty"`
+}
+
+type RekorServer struct {
+	// Maximum size of the HTTP request body accepted by the server. Requests are not limited when unset.
+	// The format of this field matches that defined by kubernetes/apimachinery.
+	//+optional
+	//+kubebuilder:validation:XIntOrString
+	//+kubebuilder:validation:Pattern:="^(([0-9]+(\\.[0-9]*)?)|(\\.[0-9]+))(([KMGTPE]i)|[kMGTPE])?$"
+	MaxRequestBodySize *k8sresource.Quantity `json:"maxRequestBodySize,omitempty"`
+	// Maximum size of the attestation stored by the server, Rekor defaults to 100Ki when unset.
+	// The format of this field matches that defined by kubernetes/apimachinery.
+	//+optional
+	//+kubebuilder:validation:XIntOrString
+	//+kubebuilder:validation:Pattern:="^(([0-9]+(\\.[0-9]*)?)|(\\.[0-9]+))(([KMGTPE]i)|[kMGTPE])?$"
+	MaxAttestationSize *k8sresource.Quantity `json:"maxAttestationSize,omitempty"`
+	// API endpoints served by the server, identified by the operationId of the Rekor OpenAPI.
+	// All endpoints are served when unset, e.g. omit createLogEntry to serve a read-only log.
+	//+kubebuilder:validation:MinItems:=1
+	//+listType=set
+	//+optional
+	EnabledAPIEndpoints []RekorAPIEndpoint `json:"enabledAPIEndpoints,omitempty"`
+	// Kinds of entries accepted by the log, e.g. hashedrekord and dsse only. All kinds are accepted when unset.
+	// Requires Rekor server v1.5.2 or newer, older servers fail to start when it is set.
+	//+kubebuilder:validation:MinItems:=1
+	//+listType=set
+	//+optional
+	EnabledEntryTypes []RekorEntryType `json:"enabledEntryTypes,omitempty"`
+	// Per-client rate limits enforced by the Ingress or Route of the server, requires the external access.
+	// The limits are applied by the OpenShift router or the NGINX ingress controller.
+	//+optional
+	RateLimit *RekorRateLimit `json:"rateLimit,omitempty"`
+}
+
+// RekorAPIEndpoint is the operationId of a Rekor API endpoint
+// +kubebuilder:validation:Enum:=createLogEntry;getLogEntryByIndex;getLogEntryByUUID;searchLogQuery;getLogInfo;getLogProof;getPublicKey;searchIndex
+type RekorAPIEndpoint string
+
+// RekorEntryType is the kind of a Rekor log entry
+// +kubebuilder:validation:Enum:=alpine;cose;dsse;hashedrekord;helm;intoto;jar;rekord;rfc3161;rpm;tuf
+type RekorEntryType string
+
+type RekorRateLimit struct {
+	// Maximum number of HTTP requests per second from a single client IP address
+	//+kubebuilder:validation:Minimum:=1
+	RequestsPerSecond int32 `json:"requestsPerSecond"`
+	// Maximum number of concurrent connections from a single client IP address
+	//+kubebuilder:validation:Minimum:=1
+	//+optional
+	Connections *int32 `json:"connections,omitempty"`
 }
 
 type RekorSigner struct {
@@ -48,6 +106,24 @@ type RekorSearchUI struct {
 	//+kubebuilder:validation:XValidation:rule=(self || !oldSelf),message=Feature cannot be disabled
 	//+kubebuilder:default:=true
 	Enabled *bool `json:"enabled"`
+	// URL of the Rekor server queried by the UI, it may point to any Rekor shard including the external ones.
+	// The URL of the managed Rekor server is used when unset.
+	//+optional
+	//+kubebuilder:validation:Pattern:="^https?://[^\\s]+$"
+	RekorUrl string `json:"rekorUrl,omitempty"`
+	// Put the OAuth proxy in front of the UI, so only authenticated users can browse the log.
+	//+optional
+	OAuthProxy OAuthProxy `json:"oauthProxy,omitempty"`
+}
+
+type OAuthProxy struct {
+	// If set to true, the Operator will deploy the OAuth proxy sidecar, only supported on OpenShift.
+	//+kubebuilder:default:=false
+	Enabled bool `json:"enabled"`
+	// Verb the authenticated user must be allowed on the Rekor resource to access the UI, defaults to get.
+	//+kubebuilder:validation:Enum:=get;list;update
+	//+optional
+	Verb string `json:"verb,omitempty"`
 }
 
 type BackFillRedis struct {
@@ -61,15 +137,30 @@ type BackFillRedis struct {
 	Schedule string `json:"schedule,omitempty"`
 }
 
+type RekorSearchUIStatus struct {
+	// URL of the Rekor server browsed by the UI
+	RekorUrl string `json:"rekorUrl,omitempty"`
+	// True when the access to the UI is protected by the OAuth proxy
+	Protected bool `json:"protected"`
+}
+
 // RekorStatus defines the observed state of Rekor
 type RekorStatus struct {
-	ServerConfigRef  *LocalObjectReference `json:"serverConfigRef,omitempty"`
-	Signer           RekorSigner           `json:"signer,omitempty"`
-	PvcName          string                `json:"pvcName,omitempty"`
-	Url              string                `json:"url,omitempty"`
-	RekorSearchUIUrl string                `json:"rekorSearchUIUrl,omitempty"`
+	ServerConfigRef *LocalObjectReference `json:"serverConfigRef,omitempty"`
+	Signer          RekorSigner           `json:"signer,omitempty"`
+	// Reference to the public key of the signer
+	//+optional
+	PublicKeyRef     *SecretKeySelector `json:"publicKeyRef,omitempty"`
+	PvcName          string             `json:"pvcName,omitempty"`
+	Url              string             `json:"url,omitempty"`
+	RekorSearchUIUrl string             `json:"rekorSearchUIUrl,omitempty"`
+	// State of the Rekor Search UI
+	//+optional
+	RekorSearchUI *RekorSearchUIStatus `json:"rekorSearchUI,omitempty"`
 	// The ID of a Trillian tree that stores the log data.
 	TreeID *int64 `json:"treeID,omitempty"`
+	// The last verified state of the log
+	LogMonitor *LogMonitorStatus `json:"logMonitor,omitempty"`
 	// +listType=map
 	// +listMapKey=type
 	// +patchStrategy=merge
@@ -164,6 +164,46 @@ var _ = Describe("Rekor", func() {
 				Expect(k8sClient.Create(context.Background(), invalidObject)).
 					To(MatchError(ContainSubstring("spec.pvc.name in body should match")))
 			})
+
+			It("search UI rekor url", func() {
+				invalidObject := generateRekorObject("search-ui-url")
+				invalidObject.Spec.RekorSearchUI.RekorUrl = "rekor.local"
+				Expect(apierrors.IsInvalid(k8sClient.Create(context.Background(), invalidObject))).To(BeTrue())
+				Expect(k8sClient.Create(context.Background(), invalidObject)).
+					To(MatchError(ContainSubstring("spec.rekorSearchUI.rekorUrl in body should match")))
+			})
+
+			It("server request size limits", func() {
+				invalidObject := generateRekorObject("server-limits")
+				invalidObject.Spec.Server.MaxRequestBodySize = utils.Pointer(k8sresource.MustParse("-1Mi"))
+				Expect(apierrors.IsInvalid(k8sClient.Create(context.Background(), invalidObject))).To(BeTrue())
+				Expect(k8sClient.Create(context.Background(), invalidObject)).
+					To(MatchError(ContainSubstring("spec.server.maxRequestBodySize")))
+			})
+
+			It("server API endpoints", func() {
+				invalidObject := generateRekorObject("server-endpoints")
+				invalidObject.Spec.Server.EnabledAPIEndpoints = []RekorAPIEndpoint{"deleteLogEntry"}
+				Expect(apierrors.IsInvalid(k8sClient.Create(context.Background(), invalidObject))).To(BeTrue())
+				Expect(k8sClient.Create(context.Background(), invalidObject)).
+					To(MatchError(ContainSubstring("spec.server.enabledAPIEndpoints[0]")))
+			})
+
+			It("server entry types", func() {
+				invalidObject := generateRekorObject("server-entry-types")
+				invalidObject.Spec.Server.EnabledEntryTypes = []RekorEntryType{"spdx"}
+				Expect(apierrors.IsInvalid(k8sClient.Create(context.Background(), invalidObject))).To(BeTrue())
+				Expect(k8sClient.Create(context.Background(), invalidObject)).
+					To(MatchError(ContainSubstring("spec.server.enabledEntryTypes[0]")))
+			})
+
+			It("server rate limit", func() {
+				invalidObject := generateRekorObject("server-rate-limit")
+				invalidObject.Spec.Server.RateLimit = &RekorRateLimit{RequestsPerSecond: 0}
+				Expect(apierrors.IsInvalid(k8sClient.Create(context.Background(), invalidObject))).To(BeTrue())
+				Expect(k8sClient.Create(context.Background(), invalidObject)).
+					To(MatchError(ContainSubstring("spec.server.rateLimit.requestsPerSecond")))
+			})
 		})
 
 		Context("Default settings", func() {
@@ -241,6 +281,13 @@ var _ = Describe("Rekor", func() {
 									Key: "key",
 								},
 							},
+							Server: RekorServer{
+								MaxRequestBodySize:  utils.Pointer(k8sresource.MustParse("10Mi")),
+								MaxAttestationSize:  utils.Pointer(k8sresource.MustParse("512Ki")),
+								EnabledAPIEndpoints: []RekorAPIEndpoint{"createLogEntry", "getLogInfo"},
+								EnabledEntryTypes:   []RekorEntryType{"hashedrekord", "dsse"},
+								RateLimit:           &RekorRateLimit{RequestsPerSecond: 10, Connections: utils.Pointer(int32(20))},
+							},
 						},
 					}
 
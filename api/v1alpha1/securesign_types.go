@@ -24,13 +24,113 @@ import (
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // SecuresignSpec defines the desired state of Securesign
+// +kubebuilder:validation:XValidation:rule=(!has(self.trillian) || !has(self.trillian.mode) || self.trillian.mode != 'Disabled' || ((has(self.rekor) && has(self.rekor.mode) && self.rekor.mode != 'Managed') && (has(self.ctlog) && has(self.ctlog.mode) && self.ctlog.mode != 'Managed'))),message=Trillian can be disabled only when neither Rekor nor CTlog is managed
+// +kubebuilder:validation:XValidation:rule=(!has(self.ctlog) || !has(self.ctlog.mode) || self.ctlog.mode != 'External' || (has(self.fulcio) && ((has(self.fulcio.mode) && self.fulcio.mode != 'Managed') || (has(self.fulcio.ctlog) && has(self.fulcio.ctlog.address))))),message=fulcio.ctlog.address must be set when the CTlog is external
 type SecuresignSpec struct {
-	Rekor    RekorSpec    `json:"rekor,omitempty"`
-	Fulcio   FulcioSpec   `json:"fulcio,omitempty"`
-	Trillian TrillianSpec `json:"trillian,omitempty"`
+	Rekor    SecuresignRekorSpec    `json:"rekor,omitempty"`
+	Fulcio   SecuresignFulcioSpec   `json:"fulcio,omitempty"`
+	Trillian SecuresignTrillianSpec `json:"trillian,omitempty"`
 	//+kubebuilder:default:={keys:{{name: rekor.pub},{name: ctfe.pub},{name: fulcio_v1.crt.pem}}}
-	Tuf   TufSpec   `json:"tuf,omitempty"`
-	Ctlog CTlogSpec `json:"ctlog,omitempty"`
+	Tuf   SecuresignTufSpec   `json:"tuf,omitempty"`
+	Ctlog SecuresignCTlogSpec `json:"ctlog,omitempty"`
+	// Timestamp authority trusted by the clients
+	//+optional
+	Tsa *SecuresignTSASpec `json:"tsa,omitempty"`
+}
+
+// ComponentMode defines how a component of the Securesign is provided.
+//   - Managed: the operator deploys the component
+//   - External: the component runs outside of the Securesign, its endpoint and keys are used by TUF and the client configuration
+//   - Disabled: the component is not deployed nor used
+//
+// +kubebuilder:validation:Enum:=Managed;External;Disabled
+type ComponentMode string
+
+const (
+	ComponentManaged  ComponentMode = "Managed"
+	ComponentExternal ComponentMode = "External"
+	ComponentDisabled ComponentMode = "Disabled"
+)
+
+// ExternalService references a component running outside of the Securesign.
+type ExternalService struct {
+	// URL of the service
+	//+required
+	//+kubebuilder:validation:Pattern:="^https?://[^\\s]+$"
+	Url string `json:"url"`
+	// Public key of the Rekor or CTlog, or the PEM encoded certificate chain of Fulcio.
+	// It's published as a TUF target and in the trusted root.
+	//+optional
+	KeyRef *SecretKeySelector `json:"keyRef,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule=(!has(self.mode) || self.mode != 'External' || (has(self.external) && has(self.external.keyRef))),message=external url and keyRef must be set for the External mode
+type SecuresignRekorSpec struct {
+	RekorSpec `json:",inline"`
+	// How the Rekor is provided
+	//+kubebuilder:default:=Managed
+	//+optional
+	Mode ComponentMode `json:"mode,omitempty"`
+	// Rekor used in the External mode
+	//+optional
+	External *ExternalService `json:"external,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule=(!has(self.mode) || self.mode != 'External' || (has(self.external) && has(self.external.keyRef))),message=external url and keyRef must be set for the External mode
+type SecuresignFulcioSpec struct {
+	FulcioSpec `json:",inline"`
+	// How the Fulcio is provided
+	//+kubebuilder:default:=Managed
+	//+optional
+	Mode ComponentMode `json:"mode,omitempty"`
+	// Fulcio used in the External mode, keyRef references its certificate chain
+	//+optional
+	External *ExternalService `json:"external,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule=(!has(self.mode) || self.mode != 'External' || (has(self.external) && has(self.external.keyRef))),message=external url and keyRef must be set for the External mode
+type SecuresignCTlogSpec struct {
+	CTlogSpec `json:",inline"`
+	// How the CTlog is provided
+	//+kubebuilder:default:=Managed
+	//+optional
+	Mode ComponentMode `json:"mode,omitempty"`
+	// CTlog used in the External mode
+	//+optional
+	External *ExternalService `json:"external,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule=(!has(self.mode) || self.mode != 'External' || has(self.external)),message=external url must be set for the External mode
+type SecuresignTufSpec struct {
+	TufSpec `json:",inline"`
+	// How the TUF repository is provided
+	//+kubebuilder:default:=Managed
+	//+optional
+	Mode ComponentMode `json:"mode,omitempty"`
+	// TUF repository used in the External mode
+	//+optional
+	External *ExternalService `json:"external,omitempty"`
+}
+
+// SecuresignTSASpec references a timestamp authority running outside of the Securesign.
+type SecuresignTSASpec struct {
+	// URL of the timestamp authority, it's published in the signing configuration
+	//+optional
+	//+kubebuilder:validation:Pattern:="^https?://[^\\s]+$"
+	Url string `json:"url,omitempty"`
+	// PEM encoded certificate chain of the timestamp authority.
+	// It's published as the tsa.certchain.pem TUF target and in the trusted root.
+	//+required
+	CertChainRef SecretKeySelector `json:"certChainRef"`
+}
+
+// +kubebuilder:validation:XValidation:rule=(!has(self.mode) || self.mode != 'External'),message=Trillian can't be external
+type SecuresignTrillianSpec struct {
+	TrillianSpec `json:",inline"`
+	// How the Trillian is provided, it may be disabled when neither Rekor nor CTlog is managed
+	//+kubebuilder:default:=Managed
+	//+optional
+	Mode ComponentMode `json:"mode,omitempty"`
 }
 
 // SecuresignStatus defines the observed state of Securesign
@@ -40,21 +140,102 @@ type SecuresignStatus struct {
 	// +patchStrategy=merge
 	// +patchMergeKey=type
 	// +optional
-	Conditions   []metav1.Condition     `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
-	RekorStatus  SecuresignRekorStatus  `json:"rekor,omitempty"`
-	FulcioStatus SecuresignFulcioStatus `json:"fulcio,omitempty"`
-	TufStatus    SecuresignTufStatus    `json:"tuf,omitempty"`
+	Conditions     []metav1.Condition       `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
+	RekorStatus    SecuresignRekorStatus    `json:"rekor,omitempty"`
+	FulcioStatus   SecuresignFulcioStatus   `json:"fulcio,omitempty"`
+	TufStatus      SecuresignTufStatus      `json:"tuf,omitempty"`
+	CTlogStatus    SecuresignCTlogStatus    `json:"ctlog,omitempty"`
+	TrillianStatus SecuresignTrillianStatus `json:"trillian,omitempty"`
+	// Generation of the Securesign the components were reconciled with
+	//+optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Sigstore trusted root and signing configuration generated from the component statuses
+	//+optional
+	TrustedRoot *SecuresignTrustedRootStatus `json:"trustedRoot,omitempty"`
+	// Configuration of the Sigstore clients generated from the component statuses
+	//+optional
+	ClientConfig *SecuresignClientConfigStatus `json:"clientConfig,omitempty"`
+}
+
+// SecuresignComponentStatus summarizes the state of a component of the Securesign.
+type SecuresignComponentStatus struct {
+	// How the component is provided
+	//+optional
+	Mode ComponentMode `json:"mode,omitempty"`
+	// Image of the component server deployed by the operator
+	//+optional
+	Image string `json:"image,omitempty"`
+	// Message of the last failure reported by the component, it's cleared once the component is ready
+	//+optional
+	LastError string `json:"lastError,omitempty"`
+	// Generation of the component the status was copied from
+	//+optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
 
 type SecuresignRekorStatus struct {
-	Url string `json:"url,omitempty"`
+	SecuresignComponentStatus `json:",inline"`
+	Url                       string `json:"url,omitempty"`
+	// URL of the Rekor Search UI
+	//+optional
+	RekorSearchUIUrl string `json:"rekorSearchUIUrl,omitempty"`
+	// The ID of a Trillian tree that stores the log data.
+	//+optional
+	TreeID *int64 `json:"treeID,omitempty"`
+	// Reference to the public key of the log
+	//+optional
+	PublicKeyRef *SecretKeySelector `json:"publicKeyRef,omitempty"`
 }
 
 type SecuresignFulcioStatus struct {
-	Url string `json:"url,omitempty"`
+	SecuresignComponentStatus `json:",inline"`
+	Url                       string `json:"url,omitempty"`
+	// Reference to the certificate chain of the Fulcio CA
+	//+optional
+	CARef *SecretKeySelector `json:"caRef,omitempty"`
+	// Expiration of the Fulcio CA certificate
+	//+optional
+	CANotAfter *metav1.Time `json:"caNotAfter,omitempty"`
 }
 
 type SecuresignTufStatus struct {
+	SecuresignComponentStatus `json:",inline"`
+	Url                       string `json:"url,omitempty"`
+	// Version of the published root metadata
+	//+optional
+	RootVersion int64 `json:"rootVersion,omitempty"`
+}
+
+type SecuresignCTlogStatus struct {
+	SecuresignComponentStatus `json:",inline"`
+	Url                       string `json:"url,omitempty"`
+	// The ID of a Trillian tree that stores the log data.
+	//+optional
+	TreeID *int64 `json:"treeID,omitempty"`
+	// Reference to the public key of the log
+	//+optional
+	PublicKeyRef *SecretKeySelector `json:"publicKeyRef,omitempty"`
+}
+
+type SecuresignTrillianStatus struct {
+	SecuresignComponentStatus `json:",inline"`
+	// Version of the Trillian database schema
+	//+optional
+	SchemaVersion string `json:"schemaVersion,omitempty"`
+}
+
+type SecuresignTrustedRootStatus struct {
+	// ConfigMap with the trusted_root.json and signing_config.json
+	ConfigMapRef *LocalObjectReference `json:"configMapRef,omitempty"`
+	// Secret with the same documents, they are published as TUF targets
+	SecretRef *LocalObjectReference `json:"secretRef,omitempty"`
+}
+
+type SecuresignClientConfigStatus struct {
+	// ConfigMap with the client settings, it can be used as the environment of a container
+	ConfigMapRef *LocalObjectReference `json:"configMapRef,omitempty"`
+	// URL of the shell file exporting the client settings, served by the CLI server
+	//+optional
 	Url string `json:"url,omitempty"`
 }
 
@@ -64,6 +245,11 @@ type SecuresignTufStatus struct {
 //+kubebuilder:printcolumn:name="Rekor URL",type=string,JSONPath=`.status.rekor.url`,description="The rekor url"
 //+kubebuilder:printcolumn:name="Fulcio URL",type=string,JSONPath=`.status.fulcio.url`,description="The fulcio url"
 //+kubebuilder:printcolumn:name="Tuf URL",type=string,JSONPath=`.status.tuf.url`,description="The tuf url"
+//+kubebuilder:printcolumn:name="CTlog URL",type=string,JSONPath=`.status.ctlog.url`,description="The ctlog url",priority=1
+//+kubebuilder:printcolumn:name="Rekor UI URL",type=string,JSONPath=`.status.rekor.rekorSearchUIUrl`,description="The rekor search ui url",priority=1
+//+kubebuilder:printcolumn:name="Fulcio CA Expiry",type=date,JSONPath=`.status.fulcio.caNotAfter`,description="The expiration of the fulcio CA certificate",priority=1
+//+kubebuilder:printcolumn:name="Message",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].message`,description="The reason the Securesign isn't ready",priority=1
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // Securesign is the Schema for the securesigns API
 type Securesign struct {
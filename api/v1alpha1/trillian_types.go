@@ -28,6 +28,154 @@ type TrillianSpec struct {
 	Db TrillianDB `json:"database,omitempty"`
 	// Enable Monitoring for Logsigner and Logserver
 	Monitoring MonitoringConfig `json:"monitoring,omitempty"`
+	// TLS configuration of the Logserver and Logsigner gRPC endpoints
+	//+optional
+	TLS TrillianTLS `json:"tls,omitempty"`
+	// Logsigner configuration
+	//+optional
+	LogSigner TrillianLogSigner `json:"logSigner,omitempty"`
+	// Quota limits of the Logserver, e.g. to stop a single client from exhausting the write capacity of the log
+	//+optional
+	Quota TrillianQuota `json:"quota,omitempty"`
+	// Desired state of Trillian trees, e.g. to freeze the tree of a retired log shard.
+	// Trees which are not listed keep their current state.
+	//+listType=map
+	//+listMapKey=treeID
+	//+optional
+	Trees []TrillianTreeState `json:"trees,omitempty"`
+}
+
+type TrillianLogSigner struct {
+	// Number of Logsigner replicas. Multiple replicas elect the replica signing each tree,
+	// the election runs on the etcd server deployed by the Operator.
+	//+kubebuilder:validation:Minimum:=1
+	//+kubebuilder:default:=1
+	//+optional
+	Replicas *int32 `json:"replicas,omitempty"`
+}
+
+type TrillianQuota struct {
+	// Maximum number of entries waiting for the Logsigner before new entries are rejected,
+	// enforced by the database quota system. Ignored when token buckets are configured.
+	//+kubebuilder:validation:Minimum:=1
+	//+optional
+	MaxUnsequencedRows *int64 `json:"maxUnsequencedRows,omitempty"`
+	// If set to true, the quotas are evaluated and reported by the metrics but no request is rejected
+	//+optional
+	DryRun bool `json:"dryRun,omitempty"`
+	// Token buckets shared by all requests.
+	// Token buckets are enforced by the etcd quota system, the etcd server is deployed by the Operator.
+	//+optional
+	Global *TrillianQuotaBuckets `json:"global,omitempty"`
+	// Token buckets of the requests to the tree
+	//+listType=map
+	//+listMapKey=treeID
+	//+optional
+	Trees []TrillianTreeQuota `json:"trees,omitempty"`
+	// Token buckets of the requests charged to the user
+	//+kubebuilder:validation:XValidation:rule="self.all(u, !has(u.write) || has(u.write.replenishTokens))",message=user bucket must be replenished over time
+	//+listType=map
+	//+listMapKey=user
+	//+optional
+	Users []TrillianUserQuota `json:"users,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule=(!has(self.read) || has(self.read.replenishTokens)),message=read bucket must be replenished over time
+type TrillianQuotaBuckets struct {
+	// Bucket charged by the read requests
+	//+optional
+	Read *TrillianTokenBucket `json:"read,omitempty"`
+	// Bucket charged by the write requests
+	//+optional
+	Write *TrillianTokenBucket `json:"write,omitempty"`
+}
+
+type TrillianTreeQuota struct {
+	// ID of the Trillian tree
+	//+required
+	TreeID int64 `json:"treeID"`
+
+	TrillianQuotaBuckets `json:",inline"`
+}
+
+type TrillianUserQuota struct {
+	// Quota user the requests are charged to
+	//+kubebuilder:validation:Pattern:=`^[^/]+$`
+	//+required
+	User string `json:"user"`
+
+	TrillianQuotaBuckets `json:",inline"`
+}
+
+// +kubebuilder:validation:XValidation:rule=(has(self.replenishTokens) == has(self.replenishIntervalSeconds)),message=replenishTokens and replenishIntervalSeconds must be set together
+type TrillianTokenBucket struct {
+	// Maximum number of tokens in the bucket, each request consumes a token
+	//+kubebuilder:validation:Minimum:=1
+	//+required
+	MaxTokens int64 `json:"maxTokens"`
+	// Number of tokens added to the bucket every replenish interval.
+	// When unset, the tokens are replenished as the Logsigner integrates the entries, only supported by the global and tree write buckets.
+	//+kubebuilder:validation:Minimum:=1
+	//+optional
+	ReplenishTokens *int64 `json:"replenishTokens,omitempty"`
+	// Interval of the replenishment in seconds
+	//+kubebuilder:validation:Minimum:=1
+	//+optional
+	ReplenishIntervalSeconds *int64 `json:"replenishIntervalSeconds,omitempty"`
+}
+
+type TrillianTreeLeader struct {
+	// ID of the Trillian tree
+	TreeID int64 `json:"treeID"`
+	// Logsigner pod elected to sign the tree
+	Pod string `json:"pod"`
+}
+
+type TrillianTreeState struct {
+	// ID of the Trillian tree
+	//+required
+	TreeID int64 `json:"treeID"`
+	// State of the tree. DRAINING tree does not accept new entries but integrates the queued ones,
+	// FROZEN tree is read-only.
+	//+kubebuilder:validation:Enum:=ACTIVE;DRAINING;FROZEN
+	//+required
+	State string `json:"state"`
+}
+
+type TrillianTree struct {
+	// ID of the Trillian tree
+	TreeID int64 `json:"treeID"`
+	// Type of the tree, e.g. LOG or PREORDERED_LOG
+	Type string `json:"type"`
+	// State of the tree, e.g. ACTIVE or FROZEN
+	State string `json:"state"`
+	// Display name of the tree
+	//+optional
+	DisplayName string `json:"displayName,omitempty"`
+	// Custom resource using the tree, e.g. Rekor/rekor
+	//+optional
+	Owner string `json:"owner,omitempty"`
+}
+
+type TrillianTLS struct {
+	// If set to true, the Logserver and Logsigner serve gRPC over TLS and all Trillian clients verify the server certificate.
+	// Certificates are issued by OpenShift service CA or by the internal CA managed by the Operator on other clusters.
+	//+kubebuilder:default:=false
+	Enabled bool `json:"enabled"`
+	// Secret with the serving certificate (tls.crt), private key (tls.key) and CA certificate (ca.crt), e.g. issued by cert-manager.
+	// The certificate must be valid for the Logserver and Logsigner services.
+	// The Operator issues the certificates when unset.
+	//+optional
+	CertificateRef *LocalObjectReference `json:"certificateRef,omitempty"`
+}
+
+type TrillianTLSStatus struct {
+	// Secret with the serving certificate of the Logserver
+	LogServerCertRef *LocalObjectReference `json:"logServerCertRef,omitempty"`
+	// Secret with the serving certificate of the Logsigner
+	LogSignerCertRef *LocalObjectReference `json:"logSignerCertRef,omitempty"`
+	// ConfigMap with the CA certificate trusted by Trillian clients
+	CACertRef *LocalObjectReference `json:"caCertRef,omitempty"`
 }
 
 type TrillianDB struct {
@@ -46,11 +194,90 @@ type TrillianDB struct {
 	// PVC configuration
 	//+kubebuilder:default:={size: "5Gi", retain: true}
 	Pvc Pvc `json:"pvc,omitempty"`
+	// TLS configuration of the connection to the database
+	//+optional
+	TLS TrillianDBTLS `json:"tls,omitempty"`
+	// Limits of the database connection pool of the Logserver and Logsigner
+	//+optional
+	ConnectionPool TrillianDBConnectionPool `json:"connectionPool,omitempty"`
+	// Rotation of the credentials of the managed database.
+	// The rotation is also requested on demand by setting the rhtas.redhat.com/rotate-db-credentials annotation to a new value.
+	//+optional
+	CredentialsRotation TrillianDBCredentialsRotation `json:"credentialsRotation,omitempty"`
+}
+
+type TrillianDBCredentialsRotation struct {
+	// Schedule of the rotation in the cron format, e.g. "0 0 1 * *".
+	// The credentials are rotated on demand only when unset.
+	//+optional
+	Schedule string `json:"schedule,omitempty"`
+}
+
+type TrillianDBCredentialsStatus struct {
+	// Time the credentials were last rotated
+	//+optional
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+	// Value of the rotation annotation handled by the last rotation
+	//+optional
+	LastRotationRequest string `json:"lastRotationRequest,omitempty"`
+	// Secret with the new credentials while the rotation is in progress
+	//+optional
+	PendingSecretRef *LocalObjectReference `json:"pendingSecretRef,omitempty"`
+	// Step of the rotation in progress.
+	// Retain: the new password of the alternate database account is set while the current account is still accepted.
+	// Discard: the Logserver and Logsigner roll out with the new credentials, then the previous account is removed.
+	//+kubebuilder:validation:Enum:=Retain;Discard
+	//+optional
+	Step string `json:"step,omitempty"`
+}
+
+type TrillianDBTLS struct {
+	// Verification of the database server certificate.
+	// Disabled: the connection is not encrypted.
+	// SkipVerify: the connection is encrypted, the server certificate is not verified.
+	// VerifyFull: the connection is encrypted, the server certificate and host name are verified.
+	// Defaults to VerifyFull when the CA certificate is set, Disabled otherwise.
+	//+kubebuilder:validation:Enum:=Disabled;SkipVerify;VerifyFull
+	//+optional
+	Mode string `json:"mode,omitempty"`
+	// Secret with the CA certificate bundle used to verify the database server certificate.
+	// System CA certificates are used when unset.
+	//+optional
+	CACertRef *SecretKeySelector `json:"caCertRef,omitempty"`
+}
+
+type TrillianDBConnectionPool struct {
+	// Maximum number of open connections to the database
+	//+kubebuilder:validation:Minimum:=1
+	//+optional
+	MaxOpenConnections *int32 `json:"maxOpenConnections,omitempty"`
+	// Maximum number of idle connections kept in the pool
+	//+kubebuilder:validation:Minimum:=0
+	//+optional
+	MaxIdleConnections *int32 `json:"maxIdleConnections,omitempty"`
 }
 
 // TrillianStatus defines the observed state of Trillian
 type TrillianStatus struct {
 	Db TrillianDB `json:"database,omitempty"`
+	// Version of the Trillian database schema
+	//+optional
+	SchemaVersion string `json:"schemaVersion,omitempty"`
+	// Rotation of the credentials of the managed database
+	//+optional
+	DbCredentials TrillianDBCredentialsStatus `json:"databaseCredentials,omitempty"`
+	// TLS configuration of the gRPC endpoints, unset when TLS is disabled
+	TLS *TrillianTLSStatus `json:"tls,omitempty"`
+	// Logsigner replicas elected to sign the trees, set when multiple Logsigner replicas are deployed
+	//+listType=map
+	//+listMapKey=treeID
+	//+optional
+	SignerLeaders []TrillianTreeLeader `json:"signerLeaders,omitempty"`
+	// Trees known to the Logserver
+	//+listType=map
+	//+listMapKey=treeID
+	//+optional
+	Trees []TrillianTree `json:"trees,omitempty"`
 	// +listType=map
 	// +listMapKey=type
 	// +patchStrategy=merge
@@ -100,6 +100,38 @@ var _ = Describe("Trillian", func() {
 				})
 			})
 
+			When("quota", func() {
+				It("read bucket is replenished over time", func() {
+					invalidObject := generateTrillianObject("quota-read")
+					invalidObject.Spec.Quota.Global = &TrillianQuotaBuckets{
+						Read: &TrillianTokenBucket{MaxTokens: 100},
+					}
+					Expect(apierrors.IsInvalid(k8sClient.Create(context.Background(), invalidObject))).To(BeTrue())
+					Expect(k8sClient.Create(context.Background(), invalidObject)).
+						To(MatchError(ContainSubstring("read bucket must be replenished over time")))
+				})
+
+				It("user bucket is replenished over time", func() {
+					invalidObject := generateTrillianObject("quota-user")
+					invalidObject.Spec.Quota.Users = []TrillianUserQuota{
+						{User: "rekor", TrillianQuotaBuckets: TrillianQuotaBuckets{Write: &TrillianTokenBucket{MaxTokens: 100}}},
+					}
+					Expect(apierrors.IsInvalid(k8sClient.Create(context.Background(), invalidObject))).To(BeTrue())
+					Expect(k8sClient.Create(context.Background(), invalidObject)).
+						To(MatchError(ContainSubstring("user bucket must be replenished over time")))
+				})
+
+				It("replenishment is set together", func() {
+					invalidObject := generateTrillianObject("quota-replenish")
+					invalidObject.Spec.Quota.Global = &TrillianQuotaBuckets{
+						Write: &TrillianTokenBucket{MaxTokens: 100, ReplenishTokens: utils.Pointer(int64(10))},
+					}
+					Expect(apierrors.IsInvalid(k8sClient.Create(context.Background(), invalidObject))).To(BeTrue())
+					Expect(k8sClient.Create(context.Background(), invalidObject)).
+						To(MatchError(ContainSubstring("replenishTokens and replenishIntervalSeconds must be set together")))
+				})
+			})
+
 			It("checking pvc name", func() {
 				invalidObject := generateTrillianObject("trillian3")
 				invalidObject.Spec.Db.Pvc.Name = "-invalid-name!"
@@ -156,6 +188,43 @@ var _ = Describe("Trillian", func() {
 								DatabaseSecretRef: &LocalObjectReference{
 									Name: "secret",
 								},
+								TLS: TrillianDBTLS{
+									Mode: "VerifyFull",
+									CACertRef: &SecretKeySelector{
+										LocalObjectReference: LocalObjectReference{Name: "db-ca"},
+										Key:                  "ca.crt",
+									},
+								},
+								ConnectionPool: TrillianDBConnectionPool{
+									MaxOpenConnections: utils.Pointer(int32(20)),
+									MaxIdleConnections: utils.Pointer(int32(5)),
+								},
+								CredentialsRotation: TrillianDBCredentialsRotation{
+									Schedule: "0 0 1 * *",
+								},
+							},
+							LogSigner: TrillianLogSigner{
+								Replicas: utils.Pointer(int32(3)),
+							},
+							Quota: TrillianQuota{
+								MaxUnsequencedRows: utils.Pointer(int64(500000)),
+								DryRun:             true,
+								Global: &TrillianQuotaBuckets{
+									Write: &TrillianTokenBucket{MaxTokens: 288000},
+								},
+								Trees: []TrillianTreeQuota{
+									{TreeID: 1, TrillianQuotaBuckets: TrillianQuotaBuckets{
+										Read: &TrillianTokenBucket{MaxTokens: 1000, ReplenishTokens: utils.Pointer(int64(100)), ReplenishIntervalSeconds: utils.Pointer(int64(1))},
+									}},
+								},
+								Users: []TrillianUserQuota{
+									{User: "rekor", TrillianQuotaBuckets: TrillianQuotaBuckets{
+										Write: &TrillianTokenBucket{MaxTokens: 100, ReplenishTokens: utils.Pointer(int64(10)), ReplenishIntervalSeconds: utils.Pointer(int64(60))},
+									}},
+								},
+							},
+							Trees: []TrillianTreeState{
+								{TreeID: 1, State: "FROZEN"},
 							},
 						},
 					}
@@ -0,0 +1,121 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TrillianBackupSpec defines the desired state of TrillianBackup
+type TrillianBackupSpec struct {
+	// Trillian instance in the namespace whose database is backed up
+	//+kubebuilder:validation:XValidation:rule=(self == oldSelf),message=Field is immutable
+	//+required
+	TrillianRef LocalObjectReference `json:"trillianRef"`
+	// Schedule of the backups in the cron format, e.g. "0 3 * * *".
+	// A single backup is taken when unset.
+	//+optional
+	Schedule string `json:"schedule,omitempty"`
+	// Storage of the database dumps
+	//+kubebuilder:validation:XValidation:rule=(self == oldSelf),message=Field is immutable
+	//+required
+	Target BackupTarget `json:"target"`
+	// Number of successful dumps kept in the target, older dumps are deleted.
+	//+kubebuilder:validation:Minimum:=1
+	//+kubebuilder:default:=7
+	Retention int32 `json:"retention,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule=(has(self.pvc) != has(self.s3)),message=exactly one of pvc or s3 target must be set
+type BackupTarget struct {
+	// Persistent volume claim storing the dumps
+	//+optional
+	Pvc *Pvc `json:"pvc,omitempty"`
+	// S3-compatible object storage
+	//+optional
+	S3 *S3Target `json:"s3,omitempty"`
+}
+
+type S3Target struct {
+	// URL of the S3-compatible endpoint without a path, e.g. https://s3.us-east-1.amazonaws.com
+	//+kubebuilder:validation:Pattern:=`^https?://[^\s/]+/?$`
+	//+required
+	Endpoint string `json:"endpoint"`
+	// Bucket storing the dumps, it must exist
+	//+kubebuilder:validation:MinLength=3
+	//+required
+	Bucket string `json:"bucket"`
+	// Key prefix of the dumps, e.g. trillian/
+	//+optional
+	Prefix string `json:"prefix,omitempty"`
+	// Region of the bucket, defaults to us-east-1
+	//+optional
+	Region string `json:"region,omitempty"`
+	// Secret with the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY keys
+	//+required
+	CredentialsRef LocalObjectReference `json:"credentialsRef"`
+}
+
+type BackupRecord struct {
+	// Name of the dump
+	Name string `json:"name"`
+	// Location of the dump, e.g. pvc://trillian-backup/name.sql.gz or s3://bucket/prefix/name.sql.gz
+	Location string `json:"location"`
+	// Succeeded or Failed
+	Result string `json:"result"`
+	// Time the backup started
+	//+optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// Time the backup finished
+	//+optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// Failure message
+	//+optional
+	Message string `json:"message,omitempty"`
+}
+
+// TrillianBackupStatus defines the observed state of TrillianBackup
+type TrillianBackupStatus struct {
+	// Persistent volume claim storing the dumps
+	//+optional
+	PvcName string `json:"pvcName,omitempty"`
+	// Time the last successful backup finished
+	//+optional
+	LastSuccessfulTime *metav1.Time `json:"lastSuccessfulTime,omitempty"`
+	// Recent backups ordered from the newest one.
+	// Successful backups are listed until their dumps are deleted by the retention policy.
+	//+optional
+	History []BackupRecord `json:"history,omitempty"`
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="The backup status"
+//+kubebuilder:printcolumn:name="Schedule",type=string,JSONPath=`.spec.schedule`,description="The backup schedule"
+//+kubebuilder:printcolumn:name="Last Backup",type=date,JSONPath=`.status.lastSuccessfulTime`,description="The last successful backup"
+
+// TrillianBackup is the Schema for the trillianbackups API
+type TrillianBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TrillianBackupSpec   `json:"spec,omitempty"`
+	Status TrillianBackupStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// TrillianBackupList contains a list of TrillianBackup
+type TrillianBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TrillianBackup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TrillianBackup{}, &TrillianBackupList{})
+}
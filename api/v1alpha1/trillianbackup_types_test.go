@@ -0,0 +1,160 @@
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/controllers/common/utils"
+	"golang.org/x/net/context"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	k8sresource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("TrillianBackup", func() {
+
+	Context("TrillianBackupSpec", func() {
+		It("can be created", func() {
+			created := generateTrillianBackupObject("backup-create")
+			Expect(k8sClient.Create(context.Background(), created)).To(Succeed())
+
+			fetched := &TrillianBackup{}
+			Expect(k8sClient.Get(context.Background(), getKey(created), fetched)).To(Succeed())
+			Expect(fetched).To(Equal(created))
+		})
+
+		It("can be updated", func() {
+			created := generateTrillianBackupObject("backup-update")
+			Expect(k8sClient.Create(context.Background(), created)).To(Succeed())
+
+			fetched := &TrillianBackup{}
+			Expect(k8sClient.Get(context.Background(), getKey(created), fetched)).To(Succeed())
+			Expect(fetched).To(Equal(created))
+
+			fetched.Spec.Schedule = "0 */6 * * *"
+			fetched.Spec.Retention = 3
+			Expect(k8sClient.Update(context.Background(), fetched)).To(Succeed())
+		})
+
+		It("can be deleted", func() {
+			created := generateTrillianBackupObject("backup-delete")
+			Expect(k8sClient.Create(context.Background(), created)).To(Succeed())
+
+			Expect(k8sClient.Delete(context.Background(), created)).To(Succeed())
+			Expect(k8sClient.Get(context.Background(), getKey(created), created)).ToNot(Succeed())
+		})
+
+		Context("is validated", func() {
+			It("target is immutable", func() {
+				created := generateTrillianBackupObject("backup-target-immutable")
+				Expect(k8sClient.Create(context.Background(), created)).To(Succeed())
+
+				fetched := &TrillianBackup{}
+				Expect(k8sClient.Get(context.Background(), getKey(created), fetched)).To(Succeed())
+				fetched.Spec.Target.Pvc.Name = "other"
+				Expect(apierrors.IsInvalid(k8sClient.Update(context.Background(), fetched))).To(BeTrue())
+				Expect(k8sClient.Update(context.Background(), fetched)).
+					To(MatchError(ContainSubstring("Field is immutable")))
+			})
+
+			It("exactly one target", func() {
+				invalidObject := generateTrillianBackupObject("backup-two-targets")
+				invalidObject.Spec.Target.S3 = &S3Target{
+					Endpoint:       "https://s3.example.com",
+					Bucket:         "bucket",
+					CredentialsRef: LocalObjectReference{Name: "credentials"},
+				}
+				Expect(apierrors.IsInvalid(k8sClient.Create(context.Background(), invalidObject))).To(BeTrue())
+				Expect(k8sClient.Create(context.Background(), invalidObject)).
+					To(MatchError(ContainSubstring("exactly one of pvc or s3 target must be set")))
+
+				invalidObject.Spec.Target = BackupTarget{}
+				Expect(k8sClient.Create(context.Background(), invalidObject)).
+					To(MatchError(ContainSubstring("exactly one of pvc or s3 target must be set")))
+			})
+
+			It("retention is positive", func() {
+				invalidObject := generateTrillianBackupObject("backup-retention")
+				invalidObject.Spec.Retention = -1
+				Expect(apierrors.IsInvalid(k8sClient.Create(context.Background(), invalidObject))).To(BeTrue())
+				Expect(k8sClient.Create(context.Background(), invalidObject)).
+					To(MatchError(ContainSubstring("should be greater than or equal to 1")))
+			})
+
+			It("S3 endpoint is URL", func() {
+				invalidObject := generateTrillianBackupObject("backup-s3-endpoint")
+				invalidObject.Spec.Target = BackupTarget{S3: &S3Target{
+					Endpoint:       "s3.example.com",
+					Bucket:         "bucket",
+					CredentialsRef: LocalObjectReference{Name: "credentials"},
+				}}
+				Expect(apierrors.IsInvalid(k8sClient.Create(context.Background(), invalidObject))).To(BeTrue())
+				Expect(k8sClient.Create(context.Background(), invalidObject)).
+					To(MatchError(ContainSubstring("spec.target.s3.endpoint")))
+			})
+		})
+
+		Context("Default settings", func() {
+			When("retention is not set", func() {
+				It("keeps 7 dumps", func() {
+					instance := generateTrillianBackupObject("backup-defaults")
+					instance.Spec.Retention = 0
+					Expect(k8sClient.Create(context.Background(), instance)).To(Succeed())
+
+					fetched := &TrillianBackup{}
+					Expect(k8sClient.Get(context.Background(), getKey(instance), fetched)).To(Succeed())
+					Expect(fetched.Spec.Retention).To(Equal(int32(7)))
+				})
+			})
+
+			When("CR is fully populated", func() {
+				It("outputs the CR", func() {
+					instance := TrillianBackup{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "backup-full-manifest",
+							Namespace: "default",
+						},
+						Spec: TrillianBackupSpec{
+							TrillianRef: LocalObjectReference{Name: "trillian"},
+							Schedule:    "0 3 * * *",
+							Retention:   14,
+							Target: BackupTarget{
+								S3: &S3Target{
+									Endpoint:       "https://s3.us-east-1.amazonaws.com",
+									Bucket:         "bucket",
+									Prefix:         "trillian/",
+									Region:         "us-east-1",
+									CredentialsRef: LocalObjectReference{Name: "credentials"},
+								},
+							},
+						},
+					}
+
+					Expect(k8sClient.Create(context.Background(), &instance)).To(Succeed())
+					fetched := &TrillianBackup{}
+					Expect(k8sClient.Get(context.Background(), getKey(&instance), fetched)).To(Succeed())
+					Expect(fetched.Spec).To(Equal(instance.Spec))
+				})
+			})
+		})
+	})
+})
+
+func generateTrillianBackupObject(name string) *TrillianBackup {
+	storage := k8sresource.MustParse("1Gi")
+	return &TrillianBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Spec: TrillianBackupSpec{
+			TrillianRef: LocalObjectReference{Name: "trillian"},
+			Retention:   7,
+			Target: BackupTarget{
+				Pvc: &Pvc{
+					Size:   &storage,
+					Retain: utils.Pointer(true),
+				},
+			},
+		},
+	}
+}
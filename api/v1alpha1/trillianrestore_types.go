@@ -0,0 +1,72 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TrillianRestoreSpec defines the desired state of TrillianRestore
+type TrillianRestoreSpec struct {
+	// Trillian instance in the namespace whose database is restored
+	//+kubebuilder:validation:XValidation:rule=(self == oldSelf),message=Field is immutable
+	//+required
+	TrillianRef LocalObjectReference `json:"trillianRef"`
+	// Dump loaded to the database
+	//+kubebuilder:validation:XValidation:rule=(self == oldSelf),message=Field is immutable
+	//+required
+	Source RestoreSource `json:"source"`
+}
+
+type RestoreSource struct {
+	// TrillianBackup which took the dump
+	//+required
+	BackupRef LocalObjectReference `json:"backupRef"`
+	// Name of the dump from the backup history, the latest successful dump is used when unset
+	//+optional
+	Dump string `json:"dump,omitempty"`
+}
+
+// TrillianRestoreStatus defines the observed state of TrillianRestore
+type TrillianRestoreStatus struct {
+	// Dump loaded to the database
+	//+optional
+	Dump *BackupRecord `json:"dump,omitempty"`
+	// Time the restore started
+	//+optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// Time the restore finished
+	//+optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// +listType=map
+	// +listMapKey=type
+	// +patchStrategy=merge
+	// +patchMergeKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="The restore status"
+//+kubebuilder:printcolumn:name="Dump",type=string,JSONPath=`.status.dump.name`,description="The restored dump"
+
+// TrillianRestore is the Schema for the trillianrestores API
+type TrillianRestore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TrillianRestoreSpec   `json:"spec,omitempty"`
+	Status TrillianRestoreStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// TrillianRestoreList contains a list of TrillianRestore
+type TrillianRestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TrillianRestore `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TrillianRestore{}, &TrillianRestoreList{})
+}
@@ -0,0 +1,61 @@
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/context"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("TrillianRestore", func() {
+
+	Context("TrillianRestoreSpec", func() {
+		It("can be created", func() {
+			created := generateTrillianRestoreObject("restore-create")
+			Expect(k8sClient.Create(context.Background(), created)).To(Succeed())
+
+			fetched := &TrillianRestore{}
+			Expect(k8sClient.Get(context.Background(), getKey(created), fetched)).To(Succeed())
+			Expect(fetched).To(Equal(created))
+		})
+
+		It("can be deleted", func() {
+			created := generateTrillianRestoreObject("restore-delete")
+			Expect(k8sClient.Create(context.Background(), created)).To(Succeed())
+
+			Expect(k8sClient.Delete(context.Background(), created)).To(Succeed())
+			Expect(k8sClient.Get(context.Background(), getKey(created), created)).ToNot(Succeed())
+		})
+
+		Context("is validated", func() {
+			It("source is immutable", func() {
+				created := generateTrillianRestoreObject("restore-source-immutable")
+				Expect(k8sClient.Create(context.Background(), created)).To(Succeed())
+
+				fetched := &TrillianRestore{}
+				Expect(k8sClient.Get(context.Background(), getKey(created), fetched)).To(Succeed())
+				fetched.Spec.Source.Dump = "trillian-backup-other"
+				Expect(apierrors.IsInvalid(k8sClient.Update(context.Background(), fetched))).To(BeTrue())
+				Expect(k8sClient.Update(context.Background(), fetched)).
+					To(MatchError(ContainSubstring("Field is immutable")))
+			})
+		})
+	})
+})
+
+func generateTrillianRestoreObject(name string) *TrillianRestore {
+	return &TrillianRestore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Spec: TrillianRestoreSpec{
+			TrillianRef: LocalObjectReference{Name: "trillian"},
+			Source: RestoreSource{
+				BackupRef: LocalObjectReference{Name: "backup"},
+				Dump:      "trillian-backup-backup-28123456",
+			},
+		},
+	}
+}
@@ -19,6 +19,106 @@ type TufSpec struct {
 	//+kubebuilder:default:={{name: rekor.pub},{name: ctfe.pub},{name: fulcio_v1.crt.pem}}
 	//+kubebuilder:validation:MinItems:=1
 	Keys []TufKey `json:"keys,omitempty"`
+	// Private keys signing the TUF metadata.
+	// The operator generates the keys which are not set and stores them in a new tuf-<name>-keys-<suffix> Secret.
+	//+optional
+	RoleKeys TufRoleKeys `json:"roleKeys,omitempty"`
+	// Keys and signatures of the root metadata
+	//+kubebuilder:default:={threshold: 1}
+	Root TufRoot `json:"root,omitempty"`
+	// Storage of the TUF repository served by the HTTP server
+	//+kubebuilder:default:={type: ConfigMap}
+	Repository TufRepository `json:"repository,omitempty"`
+	// Validity of the signed metadata
+	//+optional
+	Expiration TufExpiration `json:"expiration,omitempty"`
+	// How the keys without secret reference are found.
+	// Status resolves them from the Fulcio, CTlog and Rekor managed by the same Securesign,
+	// Labels searches the namespace for Secrets labeled with rhtas.redhat.com/<key name>.
+	//+kubebuilder:default:=Status
+	//+optional
+	KeyDiscovery KeyDiscovery `json:"keyDiscovery,omitempty"`
+}
+
+// TufExpiration configures the validity of the TUF metadata.
+// The snapshot and timestamp metadata are signed again before they expire.
+// The root and targets metadata are usually signed with offline keys, so the operator only warns before they expire.
+type TufExpiration struct {
+	// Validity of the root metadata, defaults to 1 year
+	//+optional
+	Root *metav1.Duration `json:"root,omitempty"`
+	// Validity of the targets metadata, defaults to 1 year
+	//+optional
+	Targets *metav1.Duration `json:"targets,omitempty"`
+	// Validity of the snapshot metadata, defaults to 1 week
+	//+optional
+	Snapshot *metav1.Duration `json:"snapshot,omitempty"`
+	// Validity of the timestamp metadata, defaults to 1 day
+	//+optional
+	Timestamp *metav1.Duration `json:"timestamp,omitempty"`
+	// The snapshot and timestamp metadata are signed again when they expire within this period, defaults to 6 hours.
+	// At most half of the validity is used.
+	//+optional
+	ResignBefore *metav1.Duration `json:"resignBefore,omitempty"`
+	// The root and targets metadata expiring within this period are reported by the MetadataExpiring condition, defaults to 30 days
+	//+optional
+	WarnBefore *metav1.Duration `json:"warnBefore,omitempty"`
+}
+
+// TufRoleKeys references the PEM encoded ed25519 or ECDSA P-256 private keys of the top-level TUF roles.
+type TufRoleKeys struct {
+	//+optional
+	Root *SecretKeySelector `json:"root,omitempty"`
+	//+optional
+	Targets *SecretKeySelector `json:"targets,omitempty"`
+	//+optional
+	Snapshot *SecretKeySelector `json:"snapshot,omitempty"`
+	//+optional
+	Timestamp *SecretKeySelector `json:"timestamp,omitempty"`
+}
+
+// TufRoot configures the signing of the root metadata.
+// A change of the root keys or of the keys of the other roles stages a new root version in the tuf-root-staged-<name> ConfigMap.
+// The staged version is signed by the root keys held by the operator, both from the published and the new root,
+// and it's published once it's signed by the threshold of the published and the new root keys and by the required signatures.
+type TufRoot struct {
+	// Additional root keys along with roleKeys.root.
+	// A PEM encoded private key signs the root metadata in the operator,
+	// a PEM encoded public key is an offline key whose signatures are supplied in signatures.
+	//+optional
+	Keys []SecretKeySelector `json:"keys,omitempty"`
+	// Number of root keys required to sign the root metadata
+	//+kubebuilder:default:=1
+	//+kubebuilder:validation:Minimum:=1
+	Threshold int `json:"threshold,omitempty"`
+	// Signatures of the staged root metadata made outside the operator.
+	// Each Secret key contains a TUF signature as JSON: {"keyid": "...", "sig": "..."}
+	//+optional
+	Signatures []SecretKeySelector `json:"signatures,omitempty"`
+	// Number of keys not held by the operator which must sign a new root version before it's published
+	//+kubebuilder:validation:Minimum:=0
+	//+optional
+	RequiredSignatures int `json:"requiredSignatures,omitempty"`
+}
+
+// TufRepositoryType defines where the TUF repository is stored.
+type TufRepositoryType string
+
+const (
+	// TufRepositoryConfigMap serves the repository directly from the ConfigMap generated by the operator.
+	TufRepositoryConfigMap TufRepositoryType = "ConfigMap"
+	// TufRepositoryPVC copies the repository from the generated ConfigMap to a persistent volume,
+	// which keeps the previous root versions and allows bigger targets.
+	TufRepositoryPVC TufRepositoryType = "PVC"
+)
+
+type TufRepository struct {
+	//+kubebuilder:validation:Enum:=ConfigMap;PVC
+	//+kubebuilder:default:=ConfigMap
+	Type TufRepositoryType `json:"type,omitempty"`
+	// Persistent volume used by the PVC repository type
+	//+kubebuilder:default:={size: "100Mi", retain: true}
+	Pvc Pvc `json:"pvc,omitempty"`
 }
 
 type TufKey struct {
@@ -36,7 +136,14 @@ type TufKey struct {
 // TufStatus defines the observed state of Tuf
 type TufStatus struct {
 	Keys []TufKey `json:"keys,omitempty"`
-	Url  string   `json:"url,omitempty"`
+	// Resolved private keys signing the TUF metadata
+	RoleKeys *TufRoleKeys `json:"roleKeys,omitempty"`
+	// Version and expiration of the published metadata
+	Metadata *TufMetadataStatus `json:"metadata,omitempty"`
+	// Published and staged root versions
+	Root    *TufRootStatus `json:"root,omitempty"`
+	PvcName string         `json:"pvcName,omitempty"`
+	Url     string         `json:"url,omitempty"`
 	// +listType=map
 	// +listMapKey=type
 	// +patchStrategy=merge
@@ -45,6 +152,29 @@ type TufStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
 }
 
+type TufMetadataStatus struct {
+	Root      TufRoleMetadataStatus `json:"root"`
+	Targets   TufRoleMetadataStatus `json:"targets"`
+	Snapshot  TufRoleMetadataStatus `json:"snapshot"`
+	Timestamp TufRoleMetadataStatus `json:"timestamp"`
+}
+
+type TufRootStatus struct {
+	// Version of the published root metadata
+	PublishedVersion int64 `json:"publishedVersion,omitempty"`
+	// Version of the root metadata waiting for signatures
+	StagedVersion int64 `json:"stagedVersion,omitempty"`
+	// Number of keys not held by the operator which signed the staged root metadata
+	Signatures int `json:"signatures,omitempty"`
+	// Root keys of the published root metadata, they sign the staged root metadata along with the new root keys
+	Keys []SecretKeySelector `json:"keys,omitempty"`
+}
+
+type TufRoleMetadataStatus struct {
+	Version int64       `json:"version"`
+	Expires metav1.Time `json:"expires"`
+}
+
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 //+kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].reason`,description="The component status"
@@ -1,11 +1,15 @@
 package v1alpha1
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/controllers/common/utils"
 	"golang.org/x/net/context"
 	_ "k8s.io/api/apps/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	k8sresource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -95,6 +99,14 @@ var _ = Describe("TUF", func() {
 				Expect(k8sClient.Create(context.Background(), invalidObject)).
 					To(MatchError(ContainSubstring("body should match '^[-._a-zA-Z0-9]+$'")))
 			})
+
+			It("repository type", func() {
+				invalidObject := generateTufObject("repository-type")
+				invalidObject.Spec.Repository.Type = "Secret"
+				Expect(apierrors.IsInvalid(k8sClient.Create(context.Background(), invalidObject))).To(BeTrue())
+				Expect(k8sClient.Create(context.Background(), invalidObject)).
+					To(MatchError(ContainSubstring("supported values: \"ConfigMap\", \"PVC\"")))
+			})
 		})
 
 		Context("Default settings", func() {
@@ -147,6 +159,54 @@ var _ = Describe("TUF", func() {
 									},
 								},
 							},
+							RoleKeys: TufRoleKeys{
+								Root: &SecretKeySelector{
+									LocalObjectReference: LocalObjectReference{
+										Name: "tuf-keys",
+									},
+									Key: "root",
+								},
+								Timestamp: &SecretKeySelector{
+									LocalObjectReference: LocalObjectReference{
+										Name: "tuf-online-keys",
+									},
+									Key: "timestamp",
+								},
+							},
+							Root: TufRoot{
+								Keys: []SecretKeySelector{
+									{
+										LocalObjectReference: LocalObjectReference{
+											Name: "tuf-offline-keys",
+										},
+										Key: "root",
+									},
+								},
+								Threshold:          2,
+								RequiredSignatures: 1,
+								Signatures: []SecretKeySelector{
+									{
+										LocalObjectReference: LocalObjectReference{
+											Name: "tuf-root-signature",
+										},
+										Key: "signature",
+									},
+								},
+							},
+							Repository: TufRepository{
+								Type: TufRepositoryPVC,
+								Pvc: Pvc{
+									Name:         "tuf-pvc",
+									Size:         utils.Pointer(k8sresource.MustParse("1Gi")),
+									Retain:       utils.Pointer(false),
+									StorageClass: "fast",
+								},
+							},
+							Expiration: TufExpiration{
+								Timestamp:    &metav1.Duration{Duration: time.Hour},
+								ResignBefore: &metav1.Duration{Duration: 15 * time.Minute},
+							},
+							KeyDiscovery: KeyDiscoveryLabels,
 						},
 					}
 
@@ -207,6 +267,17 @@ func generateTufObject(name string) *Tuf {
 					Name: "fulcio_v1.crt.pem",
 				},
 			},
+			Root: TufRoot{
+				Threshold: 1,
+			},
+			KeyDiscovery: KeyDiscoveryStatus,
+			Repository: TufRepository{
+				Type: TufRepositoryConfigMap,
+				Pvc: Pvc{
+					Retain: utils.Pointer(true),
+					Size:   utils.Pointer(k8sresource.MustParse("100Mi")),
+				},
+			},
 		},
 	}
 }
@@ -21,7 +21,8 @@ limitations under the License.
 package v1alpha1
 
 import (
-	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -45,6 +46,54 @@ func (in *BackFillRedis) DeepCopy() *BackFillRedis {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRecord) DeepCopyInto(out *BackupRecord) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRecord.
+func (in *BackupRecord) DeepCopy() *BackupRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupTarget) DeepCopyInto(out *BackupTarget) {
+	*out = *in
+	if in.Pvc != nil {
+		in, out := &in.Pvc, &out.Pvc
+		*out = new(Pvc)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(S3Target)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupTarget.
+func (in *BackupTarget) DeepCopy() *BackupTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CTlog) DeepCopyInto(out *CTlog) {
 	*out = *in
@@ -112,6 +161,7 @@ func (in *CTlogSpec) DeepCopyInto(out *CTlogSpec) {
 		*out = new(int64)
 		**out = **in
 	}
+	out.Trillian = in.Trillian
 	if in.PrivateKeyRef != nil {
 		in, out := &in.PrivateKeyRef, &out.PrivateKeyRef
 		*out = new(SecretKeySelector)
@@ -132,7 +182,21 @@ func (in *CTlogSpec) DeepCopyInto(out *CTlogSpec) {
 		*out = make([]SecretKeySelector, len(*in))
 		copy(*out, *in)
 	}
+	if in.ExtKeyUsages != nil {
+		in, out := &in.ExtKeyUsages, &out.ExtKeyUsages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NotAfterStart != nil {
+		in, out := &in.NotAfterStart, &out.NotAfterStart
+		*out = (*in).DeepCopy()
+	}
+	if in.NotAfterLimit != nil {
+		in, out := &in.NotAfterLimit, &out.NotAfterLimit
+		*out = (*in).DeepCopy()
+	}
 	out.Monitoring = in.Monitoring
+	in.LogMonitor.DeepCopyInto(&out.LogMonitor)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CTlogSpec.
@@ -178,9 +242,14 @@ func (in *CTlogStatus) DeepCopyInto(out *CTlogStatus) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.LogMonitor != nil {
+		in, out := &in.LogMonitor, &out.LogMonitor
+		*out = new(LogMonitorStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -197,6 +266,168 @@ func (in *CTlogStatus) DeepCopy() *CTlogStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientServer) DeepCopyInto(out *ClientServer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientServer.
+func (in *ClientServer) DeepCopy() *ClientServer {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClientServer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientServerExternalAccess) DeepCopyInto(out *ClientServerExternalAccess) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientServerExternalAccess.
+func (in *ClientServerExternalAccess) DeepCopy() *ClientServerExternalAccess {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientServerExternalAccess)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientServerList) DeepCopyInto(out *ClientServerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClientServer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientServerList.
+func (in *ClientServerList) DeepCopy() *ClientServerList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientServerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClientServerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientServerPod) DeepCopyInto(out *ClientServerPod) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientServerPod.
+func (in *ClientServerPod) DeepCopy() *ClientServerPod {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientServerPod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientServerSpec) DeepCopyInto(out *ClientServerSpec) {
+	*out = *in
+	out.ExternalAccess = in.ExternalAccess
+	if in.TLSSecretRef != nil {
+		in, out := &in.TLSSecretRef, &out.TLSSecretRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	in.Pod.DeepCopyInto(&out.Pod)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientServerSpec.
+func (in *ClientServerSpec) DeepCopy() *ClientServerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientServerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientServerStatus) DeepCopyInto(out *ClientServerStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientServerStatus.
+func (in *ClientServerStatus) DeepCopy() *ClientServerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientServerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalAccess) DeepCopyInto(out *ExternalAccess) {
 	*out = *in
@@ -212,6 +443,26 @@ func (in *ExternalAccess) DeepCopy() *ExternalAccess {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalService) DeepCopyInto(out *ExternalService) {
+	*out = *in
+	if in.KeyRef != nil {
+		in, out := &in.KeyRef, &out.KeyRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalService.
+func (in *ExternalService) DeepCopy() *ExternalService {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalService)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Fulcio) DeepCopyInto(out *Fulcio) {
 	*out = *in
@@ -294,6 +545,26 @@ func (in *FulcioConfig) DeepCopy() *FulcioConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FulcioCtlog) DeepCopyInto(out *FulcioCtlog) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FulcioCtlog.
+func (in *FulcioCtlog) DeepCopy() *FulcioCtlog {
+	if in == nil {
+		return nil
+	}
+	out := new(FulcioCtlog)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FulcioList) DeepCopyInto(out *FulcioList) {
 	*out = *in
@@ -338,6 +609,7 @@ func (in *FulcioSpec) DeepCopyInto(out *FulcioSpec) {
 		*out = new(LocalObjectReference)
 		**out = **in
 	}
+	in.Ctlog.DeepCopyInto(&out.Ctlog)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FulcioSpec.
@@ -365,7 +637,7 @@ func (in *FulcioStatus) DeepCopyInto(out *FulcioStatus) {
 	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -397,6 +669,50 @@ func (in *LocalObjectReference) DeepCopy() *LocalObjectReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogMonitor) DeepCopyInto(out *LogMonitor) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogMonitor.
+func (in *LogMonitor) DeepCopy() *LogMonitor {
+	if in == nil {
+		return nil
+	}
+	out := new(LogMonitor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogMonitorStatus) DeepCopyInto(out *LogMonitorStatus) {
+	*out = *in
+	if in.TreeID != nil {
+		in, out := &in.TreeID, &out.TreeID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LastCheckTime != nil {
+		in, out := &in.LastCheckTime, &out.LastCheckTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogMonitorStatus.
+func (in *LogMonitorStatus) DeepCopy() *LogMonitorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LogMonitorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MonitoringConfig) DeepCopyInto(out *MonitoringConfig) {
 	*out = *in
@@ -412,6 +728,21 @@ func (in *MonitoringConfig) DeepCopy() *MonitoringConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OAuthProxy) DeepCopyInto(out *OAuthProxy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuthProxy.
+func (in *OAuthProxy) DeepCopy() *OAuthProxy {
+	if in == nil {
+		return nil
+	}
+	out := new(OAuthProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OIDCIssuer) DeepCopyInto(out *OIDCIssuer) {
 	*out = *in
@@ -511,6 +842,26 @@ func (in *RekorList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RekorRateLimit) DeepCopyInto(out *RekorRateLimit) {
+	*out = *in
+	if in.Connections != nil {
+		in, out := &in.Connections, &out.Connections
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RekorRateLimit.
+func (in *RekorRateLimit) DeepCopy() *RekorRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(RekorRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RekorSearchUI) DeepCopyInto(out *RekorSearchUI) {
 	*out = *in
@@ -519,6 +870,7 @@ func (in *RekorSearchUI) DeepCopyInto(out *RekorSearchUI) {
 		*out = new(bool)
 		**out = **in
 	}
+	out.OAuthProxy = in.OAuthProxy
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RekorSearchUI.
@@ -532,44 +884,102 @@ func (in *RekorSearchUI) DeepCopy() *RekorSearchUI {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RekorSigner) DeepCopyInto(out *RekorSigner) {
+func (in *RekorSearchUIStatus) DeepCopyInto(out *RekorSearchUIStatus) {
 	*out = *in
-	if in.PasswordRef != nil {
-		in, out := &in.PasswordRef, &out.PasswordRef
-		*out = new(SecretKeySelector)
-		**out = **in
-	}
-	if in.KeyRef != nil {
-		in, out := &in.KeyRef, &out.KeyRef
-		*out = new(SecretKeySelector)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RekorSigner.
-func (in *RekorSigner) DeepCopy() *RekorSigner {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RekorSearchUIStatus.
+func (in *RekorSearchUIStatus) DeepCopy() *RekorSearchUIStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RekorSigner)
+	out := new(RekorSearchUIStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RekorSpec) DeepCopyInto(out *RekorSpec) {
+func (in *RekorServer) DeepCopyInto(out *RekorServer) {
 	*out = *in
-	if in.TreeID != nil {
-		in, out := &in.TreeID, &out.TreeID
-		*out = new(int64)
-		**out = **in
+	if in.MaxRequestBodySize != nil {
+		in, out := &in.MaxRequestBodySize, &out.MaxRequestBodySize
+		x := (*in).DeepCopy()
+		*out = &x
 	}
-	out.ExternalAccess = in.ExternalAccess
-	out.Monitoring = in.Monitoring
-	in.RekorSearchUI.DeepCopyInto(&out.RekorSearchUI)
+	if in.MaxAttestationSize != nil {
+		in, out := &in.MaxAttestationSize, &out.MaxAttestationSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.EnabledAPIEndpoints != nil {
+		in, out := &in.EnabledAPIEndpoints, &out.EnabledAPIEndpoints
+		*out = make([]RekorAPIEndpoint, len(*in))
+		copy(*out, *in)
+	}
+	if in.EnabledEntryTypes != nil {
+		in, out := &in.EnabledEntryTypes, &out.EnabledEntryTypes
+		*out = make([]RekorEntryType, len(*in))
+		copy(*out, *in)
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(RekorRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RekorServer.
+func (in *RekorServer) DeepCopy() *RekorServer {
+	if in == nil {
+		return nil
+	}
+	out := new(RekorServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RekorSigner) DeepCopyInto(out *RekorSigner) {
+	*out = *in
+	if in.PasswordRef != nil {
+		in, out := &in.PasswordRef, &out.PasswordRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.KeyRef != nil {
+		in, out := &in.KeyRef, &out.KeyRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RekorSigner.
+func (in *RekorSigner) DeepCopy() *RekorSigner {
+	if in == nil {
+		return nil
+	}
+	out := new(RekorSigner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RekorSpec) DeepCopyInto(out *RekorSpec) {
+	*out = *in
+	if in.TreeID != nil {
+		in, out := &in.TreeID, &out.TreeID
+		*out = new(int64)
+		**out = **in
+	}
+	out.Trillian = in.Trillian
+	out.ExternalAccess = in.ExternalAccess
+	out.Monitoring = in.Monitoring
+	in.RekorSearchUI.DeepCopyInto(&out.RekorSearchUI)
 	in.Signer.DeepCopyInto(&out.Signer)
 	in.Pvc.DeepCopyInto(&out.Pvc)
 	in.BackFillRedis.DeepCopyInto(&out.BackFillRedis)
+	in.LogMonitor.DeepCopyInto(&out.LogMonitor)
+	in.Server.DeepCopyInto(&out.Server)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RekorSpec.
@@ -591,197 +1001,1208 @@ func (in *RekorStatus) DeepCopyInto(out *RekorStatus) {
 		**out = **in
 	}
 	in.Signer.DeepCopyInto(&out.Signer)
+	if in.PublicKeyRef != nil {
+		in, out := &in.PublicKeyRef, &out.PublicKeyRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.RekorSearchUI != nil {
+		in, out := &in.RekorSearchUI, &out.RekorSearchUI
+		*out = new(RekorSearchUIStatus)
+		**out = **in
+	}
+	if in.TreeID != nil {
+		in, out := &in.TreeID, &out.TreeID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LogMonitor != nil {
+		in, out := &in.LogMonitor, &out.LogMonitor
+		*out = new(LogMonitorStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RekorStatus.
+func (in *RekorStatus) DeepCopy() *RekorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RekorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreSource) DeepCopyInto(out *RestoreSource) {
+	*out = *in
+	out.BackupRef = in.BackupRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreSource.
+func (in *RestoreSource) DeepCopy() *RestoreSource {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3Target) DeepCopyInto(out *S3Target) {
+	*out = *in
+	out.CredentialsRef = in.CredentialsRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3Target.
+func (in *S3Target) DeepCopy() *S3Target {
+	if in == nil {
+		return nil
+	}
+	out := new(S3Target)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretKeySelector) DeepCopyInto(out *SecretKeySelector) {
+	*out = *in
+	out.LocalObjectReference = in.LocalObjectReference
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeySelector.
+func (in *SecretKeySelector) DeepCopy() *SecretKeySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretKeySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Securesign) DeepCopyInto(out *Securesign) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Securesign.
+func (in *Securesign) DeepCopy() *Securesign {
+	if in == nil {
+		return nil
+	}
+	out := new(Securesign)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Securesign) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecuresignCTlogSpec) DeepCopyInto(out *SecuresignCTlogSpec) {
+	*out = *in
+	in.CTlogSpec.DeepCopyInto(&out.CTlogSpec)
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(ExternalService)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuresignCTlogSpec.
+func (in *SecuresignCTlogSpec) DeepCopy() *SecuresignCTlogSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecuresignCTlogSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecuresignCTlogStatus) DeepCopyInto(out *SecuresignCTlogStatus) {
+	*out = *in
+	out.SecuresignComponentStatus = in.SecuresignComponentStatus
 	if in.TreeID != nil {
 		in, out := &in.TreeID, &out.TreeID
 		*out = new(int64)
 		**out = **in
 	}
+	if in.PublicKeyRef != nil {
+		in, out := &in.PublicKeyRef, &out.PublicKeyRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuresignCTlogStatus.
+func (in *SecuresignCTlogStatus) DeepCopy() *SecuresignCTlogStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecuresignCTlogStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecuresignClientConfigStatus) DeepCopyInto(out *SecuresignClientConfigStatus) {
+	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuresignClientConfigStatus.
+func (in *SecuresignClientConfigStatus) DeepCopy() *SecuresignClientConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecuresignClientConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecuresignComponentStatus) DeepCopyInto(out *SecuresignComponentStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuresignComponentStatus.
+func (in *SecuresignComponentStatus) DeepCopy() *SecuresignComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecuresignComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecuresignFulcioSpec) DeepCopyInto(out *SecuresignFulcioSpec) {
+	*out = *in
+	in.FulcioSpec.DeepCopyInto(&out.FulcioSpec)
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(ExternalService)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuresignFulcioSpec.
+func (in *SecuresignFulcioSpec) DeepCopy() *SecuresignFulcioSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecuresignFulcioSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecuresignFulcioStatus) DeepCopyInto(out *SecuresignFulcioStatus) {
+	*out = *in
+	out.SecuresignComponentStatus = in.SecuresignComponentStatus
+	if in.CARef != nil {
+		in, out := &in.CARef, &out.CARef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.CANotAfter != nil {
+		in, out := &in.CANotAfter, &out.CANotAfter
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuresignFulcioStatus.
+func (in *SecuresignFulcioStatus) DeepCopy() *SecuresignFulcioStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecuresignFulcioStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecuresignList) DeepCopyInto(out *SecuresignList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Securesign, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuresignList.
+func (in *SecuresignList) DeepCopy() *SecuresignList {
+	if in == nil {
+		return nil
+	}
+	out := new(SecuresignList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecuresignList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecuresignRekorSpec) DeepCopyInto(out *SecuresignRekorSpec) {
+	*out = *in
+	in.RekorSpec.DeepCopyInto(&out.RekorSpec)
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(ExternalService)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuresignRekorSpec.
+func (in *SecuresignRekorSpec) DeepCopy() *SecuresignRekorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecuresignRekorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecuresignRekorStatus) DeepCopyInto(out *SecuresignRekorStatus) {
+	*out = *in
+	out.SecuresignComponentStatus = in.SecuresignComponentStatus
+	if in.TreeID != nil {
+		in, out := &in.TreeID, &out.TreeID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.PublicKeyRef != nil {
+		in, out := &in.PublicKeyRef, &out.PublicKeyRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuresignRekorStatus.
+func (in *SecuresignRekorStatus) DeepCopy() *SecuresignRekorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecuresignRekorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecuresignSpec) DeepCopyInto(out *SecuresignSpec) {
+	*out = *in
+	in.Rekor.DeepCopyInto(&out.Rekor)
+	in.Fulcio.DeepCopyInto(&out.Fulcio)
+	in.Trillian.DeepCopyInto(&out.Trillian)
+	in.Tuf.DeepCopyInto(&out.Tuf)
+	in.Ctlog.DeepCopyInto(&out.Ctlog)
+	if in.Tsa != nil {
+		in, out := &in.Tsa, &out.Tsa
+		*out = new(SecuresignTSASpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuresignSpec.
+func (in *SecuresignSpec) DeepCopy() *SecuresignSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecuresignSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecuresignStatus) DeepCopyInto(out *SecuresignStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.RekorStatus.DeepCopyInto(&out.RekorStatus)
+	in.FulcioStatus.DeepCopyInto(&out.FulcioStatus)
+	out.TufStatus = in.TufStatus
+	in.CTlogStatus.DeepCopyInto(&out.CTlogStatus)
+	out.TrillianStatus = in.TrillianStatus
+	if in.TrustedRoot != nil {
+		in, out := &in.TrustedRoot, &out.TrustedRoot
+		*out = new(SecuresignTrustedRootStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientConfig != nil {
+		in, out := &in.ClientConfig, &out.ClientConfig
+		*out = new(SecuresignClientConfigStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuresignStatus.
+func (in *SecuresignStatus) DeepCopy() *SecuresignStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecuresignStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecuresignTSASpec) DeepCopyInto(out *SecuresignTSASpec) {
+	*out = *in
+	out.CertChainRef = in.CertChainRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuresignTSASpec.
+func (in *SecuresignTSASpec) DeepCopy() *SecuresignTSASpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecuresignTSASpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecuresignTrillianSpec) DeepCopyInto(out *SecuresignTrillianSpec) {
+	*out = *in
+	in.TrillianSpec.DeepCopyInto(&out.TrillianSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuresignTrillianSpec.
+func (in *SecuresignTrillianSpec) DeepCopy() *SecuresignTrillianSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecuresignTrillianSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecuresignTrillianStatus) DeepCopyInto(out *SecuresignTrillianStatus) {
+	*out = *in
+	out.SecuresignComponentStatus = in.SecuresignComponentStatus
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuresignTrillianStatus.
+func (in *SecuresignTrillianStatus) DeepCopy() *SecuresignTrillianStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecuresignTrillianStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecuresignTrustedRootStatus) DeepCopyInto(out *SecuresignTrustedRootStatus) {
+	*out = *in
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuresignTrustedRootStatus.
+func (in *SecuresignTrustedRootStatus) DeepCopy() *SecuresignTrustedRootStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecuresignTrustedRootStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecuresignTufSpec) DeepCopyInto(out *SecuresignTufSpec) {
+	*out = *in
+	in.TufSpec.DeepCopyInto(&out.TufSpec)
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(ExternalService)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuresignTufSpec.
+func (in *SecuresignTufSpec) DeepCopy() *SecuresignTufSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecuresignTufSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecuresignTufStatus) DeepCopyInto(out *SecuresignTufStatus) {
+	*out = *in
+	out.SecuresignComponentStatus = in.SecuresignComponentStatus
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuresignTufStatus.
+func (in *SecuresignTufStatus) DeepCopy() *SecuresignTufStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecuresignTufStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Trillian) DeepCopyInto(out *Trillian) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Trillian.
+func (in *Trillian) DeepCopy() *Trillian {
+	if in == nil {
+		return nil
+	}
+	out := new(Trillian)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Trillian) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrillianBackup) DeepCopyInto(out *TrillianBackup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianBackup.
+func (in *TrillianBackup) DeepCopy() *TrillianBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(TrillianBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrillianBackup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrillianBackupList) DeepCopyInto(out *TrillianBackupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TrillianBackup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianBackupList.
+func (in *TrillianBackupList) DeepCopy() *TrillianBackupList {
+	if in == nil {
+		return nil
+	}
+	out := new(TrillianBackupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrillianBackupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrillianBackupSpec) DeepCopyInto(out *TrillianBackupSpec) {
+	*out = *in
+	out.TrillianRef = in.TrillianRef
+	in.Target.DeepCopyInto(&out.Target)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianBackupSpec.
+func (in *TrillianBackupSpec) DeepCopy() *TrillianBackupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TrillianBackupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrillianBackupStatus) DeepCopyInto(out *TrillianBackupStatus) {
+	*out = *in
+	if in.LastSuccessfulTime != nil {
+		in, out := &in.LastSuccessfulTime, &out.LastSuccessfulTime
+		*out = (*in).DeepCopy()
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]BackupRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianBackupStatus.
+func (in *TrillianBackupStatus) DeepCopy() *TrillianBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TrillianBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrillianDB) DeepCopyInto(out *TrillianDB) {
+	*out = *in
+	if in.Create != nil {
+		in, out := &in.Create, &out.Create
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DatabaseSecretRef != nil {
+		in, out := &in.DatabaseSecretRef, &out.DatabaseSecretRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	in.Pvc.DeepCopyInto(&out.Pvc)
+	in.TLS.DeepCopyInto(&out.TLS)
+	in.ConnectionPool.DeepCopyInto(&out.ConnectionPool)
+	out.CredentialsRotation = in.CredentialsRotation
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianDB.
+func (in *TrillianDB) DeepCopy() *TrillianDB {
+	if in == nil {
+		return nil
+	}
+	out := new(TrillianDB)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrillianDBConnectionPool) DeepCopyInto(out *TrillianDBConnectionPool) {
+	*out = *in
+	if in.MaxOpenConnections != nil {
+		in, out := &in.MaxOpenConnections, &out.MaxOpenConnections
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxIdleConnections != nil {
+		in, out := &in.MaxIdleConnections, &out.MaxIdleConnections
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianDBConnectionPool.
+func (in *TrillianDBConnectionPool) DeepCopy() *TrillianDBConnectionPool {
+	if in == nil {
+		return nil
+	}
+	out := new(TrillianDBConnectionPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrillianDBCredentialsRotation) DeepCopyInto(out *TrillianDBCredentialsRotation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianDBCredentialsRotation.
+func (in *TrillianDBCredentialsRotation) DeepCopy() *TrillianDBCredentialsRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(TrillianDBCredentialsRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrillianDBCredentialsStatus) DeepCopyInto(out *TrillianDBCredentialsStatus) {
+	*out = *in
+	if in.LastRotationTime != nil {
+		in, out := &in.LastRotationTime, &out.LastRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.PendingSecretRef != nil {
+		in, out := &in.PendingSecretRef, &out.PendingSecretRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianDBCredentialsStatus.
+func (in *TrillianDBCredentialsStatus) DeepCopy() *TrillianDBCredentialsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TrillianDBCredentialsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrillianDBTLS) DeepCopyInto(out *TrillianDBTLS) {
+	*out = *in
+	if in.CACertRef != nil {
+		in, out := &in.CACertRef, &out.CACertRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianDBTLS.
+func (in *TrillianDBTLS) DeepCopy() *TrillianDBTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(TrillianDBTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrillianList) DeepCopyInto(out *TrillianList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Trillian, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianList.
+func (in *TrillianList) DeepCopy() *TrillianList {
+	if in == nil {
+		return nil
+	}
+	out := new(TrillianList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrillianList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrillianLogSigner) DeepCopyInto(out *TrillianLogSigner) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianLogSigner.
+func (in *TrillianLogSigner) DeepCopy() *TrillianLogSigner {
+	if in == nil {
+		return nil
+	}
+	out := new(TrillianLogSigner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrillianQuota) DeepCopyInto(out *TrillianQuota) {
+	*out = *in
+	if in.MaxUnsequencedRows != nil {
+		in, out := &in.MaxUnsequencedRows, &out.MaxUnsequencedRows
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Global != nil {
+		in, out := &in.Global, &out.Global
+		*out = new(TrillianQuotaBuckets)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Trees != nil {
+		in, out := &in.Trees, &out.Trees
+		*out = make([]TrillianTreeQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]TrillianUserQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianQuota.
+func (in *TrillianQuota) DeepCopy() *TrillianQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(TrillianQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrillianQuotaBuckets) DeepCopyInto(out *TrillianQuotaBuckets) {
+	*out = *in
+	if in.Read != nil {
+		in, out := &in.Read, &out.Read
+		*out = new(TrillianTokenBucket)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Write != nil {
+		in, out := &in.Write, &out.Write
+		*out = new(TrillianTokenBucket)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianQuotaBuckets.
+func (in *TrillianQuotaBuckets) DeepCopy() *TrillianQuotaBuckets {
+	if in == nil {
+		return nil
+	}
+	out := new(TrillianQuotaBuckets)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrillianRestore) DeepCopyInto(out *TrillianRestore) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianRestore.
+func (in *TrillianRestore) DeepCopy() *TrillianRestore {
+	if in == nil {
+		return nil
+	}
+	out := new(TrillianRestore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrillianRestore) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrillianRestoreList) DeepCopyInto(out *TrillianRestoreList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TrillianRestore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianRestoreList.
+func (in *TrillianRestoreList) DeepCopy() *TrillianRestoreList {
+	if in == nil {
+		return nil
+	}
+	out := new(TrillianRestoreList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrillianRestoreList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrillianRestoreSpec) DeepCopyInto(out *TrillianRestoreSpec) {
+	*out = *in
+	out.TrillianRef = in.TrillianRef
+	out.Source = in.Source
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianRestoreSpec.
+func (in *TrillianRestoreSpec) DeepCopy() *TrillianRestoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TrillianRestoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrillianRestoreStatus) DeepCopyInto(out *TrillianRestoreStatus) {
+	*out = *in
+	if in.Dump != nil {
+		in, out := &in.Dump, &out.Dump
+		*out = new(BackupRecord)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianRestoreStatus.
+func (in *TrillianRestoreStatus) DeepCopy() *TrillianRestoreStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TrillianRestoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrillianService) DeepCopyInto(out *TrillianService) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianService.
+func (in *TrillianService) DeepCopy() *TrillianService {
+	if in == nil {
+		return nil
+	}
+	out := new(TrillianService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrillianSpec) DeepCopyInto(out *TrillianSpec) {
+	*out = *in
+	in.Db.DeepCopyInto(&out.Db)
+	out.Monitoring = in.Monitoring
+	in.TLS.DeepCopyInto(&out.TLS)
+	in.LogSigner.DeepCopyInto(&out.LogSigner)
+	in.Quota.DeepCopyInto(&out.Quota)
+	if in.Trees != nil {
+		in, out := &in.Trees, &out.Trees
+		*out = make([]TrillianTreeState, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianSpec.
+func (in *TrillianSpec) DeepCopy() *TrillianSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TrillianSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrillianStatus) DeepCopyInto(out *TrillianStatus) {
+	*out = *in
+	in.Db.DeepCopyInto(&out.Db)
+	in.DbCredentials.DeepCopyInto(&out.DbCredentials)
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TrillianTLSStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SignerLeaders != nil {
+		in, out := &in.SignerLeaders, &out.SignerLeaders
+		*out = make([]TrillianTreeLeader, len(*in))
+		copy(*out, *in)
+	}
+	if in.Trees != nil {
+		in, out := &in.Trees, &out.Trees
+		*out = make([]TrillianTree, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RekorStatus.
-func (in *RekorStatus) DeepCopy() *RekorStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianStatus.
+func (in *TrillianStatus) DeepCopy() *TrillianStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RekorStatus)
+	out := new(TrillianStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretKeySelector) DeepCopyInto(out *SecretKeySelector) {
+func (in *TrillianTLS) DeepCopyInto(out *TrillianTLS) {
 	*out = *in
-	out.LocalObjectReference = in.LocalObjectReference
+	if in.CertificateRef != nil {
+		in, out := &in.CertificateRef, &out.CertificateRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeySelector.
-func (in *SecretKeySelector) DeepCopy() *SecretKeySelector {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianTLS.
+func (in *TrillianTLS) DeepCopy() *TrillianTLS {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretKeySelector)
+	out := new(TrillianTLS)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Securesign) DeepCopyInto(out *Securesign) {
+func (in *TrillianTLSStatus) DeepCopyInto(out *TrillianTLSStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.LogServerCertRef != nil {
+		in, out := &in.LogServerCertRef, &out.LogServerCertRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	if in.LogSignerCertRef != nil {
+		in, out := &in.LogSignerCertRef, &out.LogSignerCertRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
+	if in.CACertRef != nil {
+		in, out := &in.CACertRef, &out.CACertRef
+		*out = new(LocalObjectReference)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Securesign.
-func (in *Securesign) DeepCopy() *Securesign {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianTLSStatus.
+func (in *TrillianTLSStatus) DeepCopy() *TrillianTLSStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Securesign)
+	out := new(TrillianTLSStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Securesign) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecuresignFulcioStatus) DeepCopyInto(out *SecuresignFulcioStatus) {
+func (in *TrillianTokenBucket) DeepCopyInto(out *TrillianTokenBucket) {
 	*out = *in
+	if in.ReplenishTokens != nil {
+		in, out := &in.ReplenishTokens, &out.ReplenishTokens
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ReplenishIntervalSeconds != nil {
+		in, out := &in.ReplenishIntervalSeconds, &out.ReplenishIntervalSeconds
+		*out = new(int64)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuresignFulcioStatus.
-func (in *SecuresignFulcioStatus) DeepCopy() *SecuresignFulcioStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianTokenBucket.
+func (in *TrillianTokenBucket) DeepCopy() *TrillianTokenBucket {
 	if in == nil {
 		return nil
 	}
-	out := new(SecuresignFulcioStatus)
+	out := new(TrillianTokenBucket)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecuresignList) DeepCopyInto(out *SecuresignList) {
+func (in *TrillianTree) DeepCopyInto(out *TrillianTree) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Securesign, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuresignList.
-func (in *SecuresignList) DeepCopy() *SecuresignList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianTree.
+func (in *TrillianTree) DeepCopy() *TrillianTree {
 	if in == nil {
 		return nil
 	}
-	out := new(SecuresignList)
+	out := new(TrillianTree)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SecuresignList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecuresignRekorStatus) DeepCopyInto(out *SecuresignRekorStatus) {
+func (in *TrillianTreeLeader) DeepCopyInto(out *TrillianTreeLeader) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuresignRekorStatus.
-func (in *SecuresignRekorStatus) DeepCopy() *SecuresignRekorStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianTreeLeader.
+func (in *TrillianTreeLeader) DeepCopy() *TrillianTreeLeader {
 	if in == nil {
 		return nil
 	}
-	out := new(SecuresignRekorStatus)
+	out := new(TrillianTreeLeader)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecuresignSpec) DeepCopyInto(out *SecuresignSpec) {
+func (in *TrillianTreeQuota) DeepCopyInto(out *TrillianTreeQuota) {
 	*out = *in
-	in.Rekor.DeepCopyInto(&out.Rekor)
-	in.Fulcio.DeepCopyInto(&out.Fulcio)
-	in.Trillian.DeepCopyInto(&out.Trillian)
-	in.Tuf.DeepCopyInto(&out.Tuf)
-	in.Ctlog.DeepCopyInto(&out.Ctlog)
+	in.TrillianQuotaBuckets.DeepCopyInto(&out.TrillianQuotaBuckets)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuresignSpec.
-func (in *SecuresignSpec) DeepCopy() *SecuresignSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianTreeQuota.
+func (in *TrillianTreeQuota) DeepCopy() *TrillianTreeQuota {
 	if in == nil {
 		return nil
 	}
-	out := new(SecuresignSpec)
+	out := new(TrillianTreeQuota)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecuresignStatus) DeepCopyInto(out *SecuresignStatus) {
+func (in *TrillianTreeState) DeepCopyInto(out *TrillianTreeState) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	out.RekorStatus = in.RekorStatus
-	out.FulcioStatus = in.FulcioStatus
-	out.TufStatus = in.TufStatus
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuresignStatus.
-func (in *SecuresignStatus) DeepCopy() *SecuresignStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianTreeState.
+func (in *TrillianTreeState) DeepCopy() *TrillianTreeState {
 	if in == nil {
 		return nil
 	}
-	out := new(SecuresignStatus)
+	out := new(TrillianTreeState)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecuresignTufStatus) DeepCopyInto(out *SecuresignTufStatus) {
+func (in *TrillianUserQuota) DeepCopyInto(out *TrillianUserQuota) {
 	*out = *in
+	in.TrillianQuotaBuckets.DeepCopyInto(&out.TrillianQuotaBuckets)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuresignTufStatus.
-func (in *SecuresignTufStatus) DeepCopy() *SecuresignTufStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianUserQuota.
+func (in *TrillianUserQuota) DeepCopy() *TrillianUserQuota {
 	if in == nil {
 		return nil
 	}
-	out := new(SecuresignTufStatus)
+	out := new(TrillianUserQuota)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Trillian) DeepCopyInto(out *Trillian) {
+func (in *Tuf) DeepCopyInto(out *Tuf) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -789,18 +2210,18 @@ func (in *Trillian) DeepCopyInto(out *Trillian) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Trillian.
-func (in *Trillian) DeepCopy() *Trillian {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tuf.
+func (in *Tuf) DeepCopy() *Tuf {
 	if in == nil {
 		return nil
 	}
-	out := new(Trillian)
+	out := new(Tuf)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Trillian) DeepCopyObject() runtime.Object {
+func (in *Tuf) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -808,57 +2229,96 @@ func (in *Trillian) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TrillianDB) DeepCopyInto(out *TrillianDB) {
+func (in *TufExpiration) DeepCopyInto(out *TufExpiration) {
 	*out = *in
-	if in.Create != nil {
-		in, out := &in.Create, &out.Create
-		*out = new(bool)
+	if in.Root != nil {
+		in, out := &in.Root, &out.Root
+		*out = new(metav1.Duration)
 		**out = **in
 	}
-	if in.DatabaseSecretRef != nil {
-		in, out := &in.DatabaseSecretRef, &out.DatabaseSecretRef
-		*out = new(LocalObjectReference)
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Snapshot != nil {
+		in, out := &in.Snapshot, &out.Snapshot
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ResignBefore != nil {
+		in, out := &in.ResignBefore, &out.ResignBefore
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.WarnBefore != nil {
+		in, out := &in.WarnBefore, &out.WarnBefore
+		*out = new(metav1.Duration)
 		**out = **in
 	}
-	in.Pvc.DeepCopyInto(&out.Pvc)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianDB.
-func (in *TrillianDB) DeepCopy() *TrillianDB {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TufExpiration.
+func (in *TufExpiration) DeepCopy() *TufExpiration {
 	if in == nil {
 		return nil
 	}
-	out := new(TrillianDB)
+	out := new(TufExpiration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TrillianList) DeepCopyInto(out *TrillianList) {
+func (in *TufKey) DeepCopyInto(out *TufKey) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TufKey.
+func (in *TufKey) DeepCopy() *TufKey {
+	if in == nil {
+		return nil
+	}
+	out := new(TufKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TufList) DeepCopyInto(out *TufList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Trillian, len(*in))
+		*out = make([]Tuf, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianList.
-func (in *TrillianList) DeepCopy() *TrillianList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TufList.
+func (in *TufList) DeepCopy() *TufList {
 	if in == nil {
 		return nil
 	}
-	out := new(TrillianList)
+	out := new(TufList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *TrillianList) DeepCopyObject() runtime.Object {
+func (in *TufList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -866,124 +2326,136 @@ func (in *TrillianList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TrillianSpec) DeepCopyInto(out *TrillianSpec) {
+func (in *TufMetadataStatus) DeepCopyInto(out *TufMetadataStatus) {
 	*out = *in
-	in.Db.DeepCopyInto(&out.Db)
-	out.Monitoring = in.Monitoring
+	in.Root.DeepCopyInto(&out.Root)
+	in.Targets.DeepCopyInto(&out.Targets)
+	in.Snapshot.DeepCopyInto(&out.Snapshot)
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianSpec.
-func (in *TrillianSpec) DeepCopy() *TrillianSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TufMetadataStatus.
+func (in *TufMetadataStatus) DeepCopy() *TufMetadataStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(TrillianSpec)
+	out := new(TufMetadataStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TrillianStatus) DeepCopyInto(out *TrillianStatus) {
+func (in *TufRepository) DeepCopyInto(out *TufRepository) {
 	*out = *in
-	in.Db.DeepCopyInto(&out.Db)
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.Pvc.DeepCopyInto(&out.Pvc)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrillianStatus.
-func (in *TrillianStatus) DeepCopy() *TrillianStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TufRepository.
+func (in *TufRepository) DeepCopy() *TufRepository {
 	if in == nil {
 		return nil
 	}
-	out := new(TrillianStatus)
+	out := new(TufRepository)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Tuf) DeepCopyInto(out *Tuf) {
+func (in *TufRoleKeys) DeepCopyInto(out *TufRoleKeys) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Root != nil {
+		in, out := &in.Root, &out.Root
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.Snapshot != nil {
+		in, out := &in.Snapshot, &out.Snapshot
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tuf.
-func (in *Tuf) DeepCopy() *Tuf {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TufRoleKeys.
+func (in *TufRoleKeys) DeepCopy() *TufRoleKeys {
 	if in == nil {
 		return nil
 	}
-	out := new(Tuf)
+	out := new(TufRoleKeys)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Tuf) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TufRoleMetadataStatus) DeepCopyInto(out *TufRoleMetadataStatus) {
+	*out = *in
+	in.Expires.DeepCopyInto(&out.Expires)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TufRoleMetadataStatus.
+func (in *TufRoleMetadataStatus) DeepCopy() *TufRoleMetadataStatus {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(TufRoleMetadataStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TufKey) DeepCopyInto(out *TufKey) {
+func (in *TufRoot) DeepCopyInto(out *TufRoot) {
 	*out = *in
-	if in.SecretRef != nil {
-		in, out := &in.SecretRef, &out.SecretRef
-		*out = new(SecretKeySelector)
-		**out = **in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]SecretKeySelector, len(*in))
+		copy(*out, *in)
+	}
+	if in.Signatures != nil {
+		in, out := &in.Signatures, &out.Signatures
+		*out = make([]SecretKeySelector, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TufKey.
-func (in *TufKey) DeepCopy() *TufKey {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TufRoot.
+func (in *TufRoot) DeepCopy() *TufRoot {
 	if in == nil {
 		return nil
 	}
-	out := new(TufKey)
+	out := new(TufRoot)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TufList) DeepCopyInto(out *TufList) {
+func (in *TufRootStatus) DeepCopyInto(out *TufRootStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Tuf, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]SecretKeySelector, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TufList.
-func (in *TufList) DeepCopy() *TufList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TufRootStatus.
+func (in *TufRootStatus) DeepCopy() *TufRootStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(TufList)
+	out := new(TufRootStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *TufList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TufSpec) DeepCopyInto(out *TufSpec) {
 	*out = *in
@@ -995,6 +2467,10 @@ func (in *TufSpec) DeepCopyInto(out *TufSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	in.RoleKeys.DeepCopyInto(&out.RoleKeys)
+	in.Root.DeepCopyInto(&out.Root)
+	in.Repository.DeepCopyInto(&out.Repository)
+	in.Expiration.DeepCopyInto(&out.Expiration)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TufSpec.
@@ -1017,9 +2493,24 @@ func (in *TufStatus) DeepCopyInto(out *TufStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RoleKeys != nil {
+		in, out := &in.RoleKeys, &out.RoleKeys
+		*out = new(TufRoleKeys)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(TufMetadataStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Root != nil {
+		in, out := &in.Root, &out.Root
+		*out = new(TufRootStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -0,0 +1,66 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	consolev1 "github.com/openshift/api/console/v1"
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/clientserver/utils"
+	"github.com/securesign/operator/controllers/common/action"
+	"github.com/securesign/operator/controllers/common/utils/kubernetes"
+	"github.com/securesign/operator/controllers/constants"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func NewConsoleDownloadAction() action.Action[rhtasv1alpha1.ClientServer] {
+	return &consoleDownloadAction{}
+}
+
+type consoleDownloadAction struct {
+	action.BaseAction
+}
+
+func (i consoleDownloadAction) Name() string {
+	return "console downloads"
+}
+
+func (i consoleDownloadAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.ClientServer) bool {
+	return meta.IsStatusConditionTrue(instance.Status.Conditions, constants.Ready) &&
+		instance.Spec.ExternalAccess.Enabled && kubernetes.IsOpenShift(i.Client)
+}
+
+// Handle publishes the download links of the served clients in the OpenShift console,
+// the links follow the URL of the Ingress and the downloads of clients which are no longer served are removed.
+func (i consoleDownloadAction) Handle(ctx context.Context, instance *rhtasv1alpha1.ClientServer) *action.Result {
+	labels := constants.LabelsForComponent(ComponentName, instance.Name)
+
+	for name, description := range utils.Clients {
+		download := utils.CreateConsoleCLIDownload(name, instance.Status.Url, description, labels)
+		if err := controllerutil.SetControllerReference(instance, download, i.Client.Scheme()); err != nil {
+			return i.Failed(fmt.Errorf("could not set controller reference for ConsoleCLIDownload: %w", err))
+		}
+		if _, err := i.Ensure(ctx, download); err != nil {
+			return i.Failed(fmt.Errorf("could not create ConsoleCLIDownload %s: %w", name, err))
+		}
+	}
+
+	list := &consolev1.ConsoleCLIDownloadList{}
+	if err := i.Client.List(ctx, list, client.MatchingLabels(labels)); err != nil {
+		return i.Failed(err)
+	}
+	for idx := range list.Items {
+		download := &list.Items[idx]
+		if _, ok := utils.Clients[download.Name]; ok || !metav1.IsControlledBy(download, instance) {
+			continue
+		}
+		i.Logger.Info("Removing ConsoleCLIDownload of a client which isn't served", "name", download.Name)
+		if err := i.Client.Delete(ctx, download); client.IgnoreNotFound(err) != nil {
+			return i.Failed(err)
+		}
+	}
+	return i.Continue()
+}
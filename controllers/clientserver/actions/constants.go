@@ -0,0 +1,10 @@
+package actions
+
+import "github.com/securesign/operator/controllers/constants"
+
+const (
+	ComponentName  = "client-server"
+	DeploymentName = constants.ClientServerName
+	// Port of the HTTP server serving the clients
+	Port = 8080
+)
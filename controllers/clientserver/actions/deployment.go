@@ -0,0 +1,63 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/clientserver/utils"
+	"github.com/securesign/operator/controllers/common/action"
+	"github.com/securesign/operator/controllers/constants"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func NewDeployAction() action.Action[rhtasv1alpha1.ClientServer] {
+	return &deployAction{}
+}
+
+type deployAction struct {
+	action.BaseAction
+}
+
+func (i deployAction) Name() string {
+	return "deploy"
+}
+
+func (i deployAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.ClientServer) bool {
+	c := meta.FindStatusCondition(instance.Status.Conditions, constants.Ready)
+	return c.Reason == constants.Creating || c.Reason == constants.Ready
+}
+
+func (i deployAction) Handle(ctx context.Context, instance *rhtasv1alpha1.ClientServer) *action.Result {
+	var (
+		updated bool
+		err     error
+	)
+
+	labels := constants.LabelsFor(ComponentName, DeploymentName, instance.Name)
+	dp := utils.CreateDeployment(instance, DeploymentName, Port, labels)
+
+	if err = controllerutil.SetControllerReference(instance, dp, i.Client.Scheme()); err != nil {
+		return i.Failed(fmt.Errorf("could not set controller reference for Deployment: %w", err))
+	}
+
+	if updated, err = i.Ensure(ctx, dp); err != nil {
+		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+			Type:    constants.Ready,
+			Status:  metav1.ConditionFalse,
+			Reason:  constants.Failure,
+			Message: err.Error(),
+		})
+		return i.FailedWithStatusUpdate(ctx, fmt.Errorf("could not create CLI server: %w", err), instance)
+	}
+
+	if updated {
+		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{Type: constants.Ready,
+			Status: metav1.ConditionFalse, Reason: constants.Creating, Message: "Deployment created"})
+		return i.StatusUpdate(ctx, instance)
+	} else {
+		return i.Continue()
+	}
+}
@@ -0,0 +1,107 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/action"
+	"github.com/securesign/operator/controllers/common/utils/kubernetes"
+	"github.com/securesign/operator/controllers/constants"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func NewIngressAction() action.Action[rhtasv1alpha1.ClientServer] {
+	return &ingressAction{}
+}
+
+type ingressAction struct {
+	action.BaseAction
+}
+
+func (i ingressAction) Name() string {
+	return "ingress"
+}
+
+func (i ingressAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.ClientServer) bool {
+	c := meta.FindStatusCondition(instance.Status.Conditions, constants.Ready)
+	return c.Reason == constants.Creating || c.Reason == constants.Ready
+}
+
+// Handle creates the Ingress of the CLI server or deletes it when the external access is disabled.
+// The URL of the CLI server is resolved again in the initialize phase.
+func (i ingressAction) Handle(ctx context.Context, instance *rhtasv1alpha1.ClientServer) *action.Result {
+	var updated bool
+	ok := types.NamespacedName{Name: DeploymentName, Namespace: instance.Spec.Namespace}
+	if !instance.Spec.ExternalAccess.Enabled {
+		return i.remove(ctx, instance, ok)
+	}
+	labels := constants.LabelsFor(ComponentName, DeploymentName, instance.Name)
+
+	svc := &v1.Service{}
+	if err := i.Client.Get(ctx, ok, svc); err != nil {
+		return i.Failed(fmt.Errorf("could not find service for ingress: %w", err))
+	}
+
+	ingress, err := kubernetes.CreateIngress(ctx, i.Client, *svc, rhtasv1alpha1.ExternalAccess(instance.Spec.ExternalAccess), DeploymentName, labels)
+	if err != nil {
+		return i.Failed(fmt.Errorf("could not create ingress object: %w", err))
+	}
+	if instance.Spec.TLSSecretRef != nil {
+		ingress.Spec.TLS = []networkingv1.IngressTLS{
+			{
+				Hosts:      []string{ingress.Spec.Rules[0].Host},
+				SecretName: instance.Spec.TLSSecretRef.Name,
+			},
+		}
+	}
+
+	if err = controllerutil.SetControllerReference(instance, ingress, i.Client.Scheme()); err != nil {
+		return i.Failed(fmt.Errorf("could not set controller reference for Ingress: %w", err))
+	}
+
+	if updated, err = i.Ensure(ctx, ingress); err != nil {
+		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+			Type:    constants.Ready,
+			Status:  metav1.ConditionFalse,
+			Reason:  constants.Failure,
+			Message: err.Error(),
+		})
+		return i.FailedWithStatusUpdate(ctx, fmt.Errorf("could not create Ingress: %w", err), instance)
+	}
+
+	if updated {
+		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{Type: constants.Ready,
+			Status: metav1.ConditionFalse, Reason: constants.Creating, Message: "Ingress created"})
+		return i.StatusUpdate(ctx, instance)
+	} else {
+		return i.Continue()
+	}
+}
+
+func (i ingressAction) remove(ctx context.Context, instance *rhtasv1alpha1.ClientServer, key types.NamespacedName) *action.Result {
+	ingress := &networkingv1.Ingress{}
+	if err := i.Client.Get(ctx, key, ingress); err != nil {
+		if apierrors.IsNotFound(err) {
+			return i.Continue()
+		}
+		return i.Failed(fmt.Errorf("could not get Ingress: %w", err))
+	}
+	if !metav1.IsControlledBy(ingress, instance) {
+		return i.Continue()
+	}
+	if err := i.Client.Delete(ctx, ingress); client.IgnoreNotFound(err) != nil {
+		return i.Failed(fmt.Errorf("could not delete Ingress: %w", err))
+	}
+	i.Recorder.Event(instance, v1.EventTypeNormal, "IngressDeleted", "External access to the CLI server disabled")
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{Type: constants.Ready,
+		Status: metav1.ConditionFalse, Reason: constants.Creating, Message: "Ingress deleted"})
+	return i.StatusUpdate(ctx, instance)
+}
@@ -0,0 +1,58 @@
+package actions
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+	testAction "github.com/securesign/operator/controllers/common/test/action"
+	"github.com/securesign/operator/controllers/constants"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestIngressToggle(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.TODO()
+
+	instance := &v1alpha1.ClientServer{
+		ObjectMeta: metav1.ObjectMeta{Name: constants.ClientServerName, UID: "uid"},
+		Spec: v1alpha1.ClientServerSpec{
+			Namespace:      constants.ClientServerNamespace,
+			ExternalAccess: v1alpha1.ClientServerExternalAccess{Enabled: true, Host: "cli-server.example.com"},
+		},
+	}
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{Type: constants.Ready, Status: metav1.ConditionTrue, Reason: constants.Ready})
+	svc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: DeploymentName, Namespace: constants.ClientServerNamespace},
+		Spec:       v1.ServiceSpec{Ports: []v1.ServicePort{{Name: DeploymentName, Port: Port}}},
+	}
+	c := testAction.FakeClientBuilder().WithStatusSubresource(instance).WithObjects(instance, svc).Build()
+	key := types.NamespacedName{Name: DeploymentName, Namespace: constants.ClientServerNamespace}
+
+	a := testAction.PrepareAction(c, NewIngressAction())
+	g.Expect(a.CanHandle(ctx, instance)).To(BeTrue())
+	g.Expect(a.Handle(ctx, instance)).ToNot(BeNil())
+
+	ingress := &networkingv1.Ingress{}
+	g.Expect(c.Get(ctx, key, ingress)).To(Succeed())
+	g.Expect(ingress.Spec.Rules[0].Host).To(Equal("cli-server.example.com"))
+	g.Expect(metav1.IsControlledBy(ingress, instance)).To(BeTrue())
+
+	// the URL is resolved again once the Ingress is deleted
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{Type: constants.Ready, Status: metav1.ConditionTrue, Reason: constants.Ready})
+	instance.Spec.ExternalAccess.Enabled = false
+	g.Expect(c.Update(ctx, instance)).To(Succeed())
+	g.Expect(a.CanHandle(ctx, instance)).To(BeTrue())
+	g.Expect(a.Handle(ctx, instance)).ToNot(BeNil())
+	g.Expect(apierrors.IsNotFound(c.Get(ctx, key, ingress))).To(BeTrue())
+	g.Expect(meta.FindStatusCondition(instance.Status.Conditions, constants.Ready).Reason).To(Equal(constants.Creating))
+
+	// nothing left to delete
+	g.Expect(a.Handle(ctx, instance)).To(BeNil())
+}
@@ -0,0 +1,100 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	consolev1 "github.com/openshift/api/console/v1"
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/clientserver/utils"
+	"github.com/securesign/operator/controllers/common/action"
+	"github.com/securesign/operator/controllers/common/utils/kubernetes"
+	"github.com/securesign/operator/controllers/constants"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// legacyOwner is the CRD which owned the CLI server objects created by earlier versions of the operator on startup
+const legacyOwner = "securesigns.rhtas.redhat.com"
+
+func NewAdoptLegacyAction() action.Action[rhtasv1alpha1.ClientServer] {
+	return &adoptLegacyAction{}
+}
+
+type adoptLegacyAction struct {
+	action.BaseAction
+}
+
+func (i adoptLegacyAction) Name() string {
+	return "adopt legacy objects"
+}
+
+func (i adoptLegacyAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.ClientServer) bool {
+	return meta.FindStatusCondition(instance.Status.Conditions, constants.Ready) == nil
+}
+
+// Handle takes over the CLI server objects owned by the Securesign CRD.
+// The objects in the namespace of the ClientServer are adopted, so the Ingress keeps its host,
+// the objects left in another namespace are deleted and the namespace is released.
+func (i adoptLegacyAction) Handle(ctx context.Context, instance *rhtasv1alpha1.ClientServer) *action.Result {
+	legacy := []client.Object{
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: constants.ClientServerNamespace}},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: DeploymentName, Namespace: constants.ClientServerNamespace}},
+		&v1.Service{ObjectMeta: metav1.ObjectMeta{Name: DeploymentName, Namespace: constants.ClientServerNamespace}},
+		&networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: DeploymentName, Namespace: constants.ClientServerNamespace}},
+	}
+	if kubernetes.IsOpenShift(i.Client) {
+		for name := range utils.Clients {
+			legacy = append(legacy, &consolev1.ConsoleCLIDownload{ObjectMeta: metav1.ObjectMeta{Name: name}})
+		}
+	}
+
+	for _, obj := range legacy {
+		if err := i.Client.Get(ctx, types.NamespacedName{Name: obj.GetName(), Namespace: obj.GetNamespace()}, obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return i.Failed(err)
+		}
+		if !removeLegacyOwner(obj) {
+			continue
+		}
+		kind := reflect.TypeOf(obj).Elem().Name()
+		if obj.GetNamespace() != "" && obj.GetNamespace() != instance.Spec.Namespace {
+			if err := i.Client.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+				return i.Failed(fmt.Errorf("could not delete legacy %s: %w", kind, err))
+			}
+			continue
+		}
+		if _, ok := obj.(*v1.Namespace); !ok || obj.GetName() == instance.Spec.Namespace {
+			if err := controllerutil.SetControllerReference(instance, obj, i.Client.Scheme()); err != nil {
+				return i.Failed(fmt.Errorf("could not set controller reference for %s: %w", kind, err))
+			}
+		}
+		if err := i.Client.Update(ctx, obj); err != nil {
+			return i.Failed(fmt.Errorf("could not adopt legacy %s: %w", kind, err))
+		}
+		i.Logger.Info("Adopted legacy object", "kind", kind, "name", obj.GetName())
+	}
+	return i.Continue()
+}
+
+// removeLegacyOwner removes the owner reference of the Securesign CRD, it returns false when the object isn't owned by it.
+func removeLegacyOwner(obj client.Object) bool {
+	owners := obj.GetOwnerReferences()
+	for idx, owner := range owners {
+		if owner.Kind == "CustomResourceDefinition" && owner.Name == legacyOwner {
+			obj.SetOwnerReferences(append(owners[:idx], owners[idx+1:]...))
+			return true
+		}
+	}
+	return false
+}
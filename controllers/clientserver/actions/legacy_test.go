@@ -0,0 +1,85 @@
+package actions
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+	testAction "github.com/securesign/operator/controllers/common/test/action"
+	"github.com/securesign/operator/controllers/constants"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestAdoptLegacy(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.TODO()
+
+	legacyOwnerRef := []metav1.OwnerReference{{
+		APIVersion: "apiextensions.k8s.io/v1",
+		Kind:       "CustomResourceDefinition",
+		Name:       legacyOwner,
+		UID:        "crd-uid",
+	}}
+	legacy := func(meta metav1.ObjectMeta) metav1.ObjectMeta {
+		meta.OwnerReferences = legacyOwnerRef
+		return meta
+	}
+
+	instance := &v1alpha1.ClientServer{
+		ObjectMeta: metav1.ObjectMeta{Name: constants.ClientServerName, UID: "uid"},
+		Spec:       v1alpha1.ClientServerSpec{Namespace: constants.ClientServerNamespace},
+	}
+	c := testAction.FakeClientBuilder().WithObjects(
+		instance,
+		&v1.Namespace{ObjectMeta: legacy(metav1.ObjectMeta{Name: constants.ClientServerNamespace})},
+		&appsv1.Deployment{ObjectMeta: legacy(metav1.ObjectMeta{Name: DeploymentName, Namespace: constants.ClientServerNamespace})},
+		&networkingv1.Ingress{
+			ObjectMeta: legacy(metav1.ObjectMeta{Name: DeploymentName, Namespace: constants.ClientServerNamespace}),
+			Spec:       networkingv1.IngressSpec{Rules: []networkingv1.IngressRule{{Host: "cli-server.example.com"}}},
+		},
+		// not created by the operator
+		&v1.Service{ObjectMeta: metav1.ObjectMeta{Name: DeploymentName, Namespace: constants.ClientServerNamespace}},
+	).Build()
+
+	a := testAction.PrepareAction(c, NewAdoptLegacyAction())
+	g.Expect(a.CanHandle(ctx, instance)).To(BeTrue())
+	g.Expect(a.Handle(ctx, instance)).To(BeNil())
+
+	ingress := &networkingv1.Ingress{}
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: DeploymentName, Namespace: constants.ClientServerNamespace}, ingress)).To(Succeed())
+	g.Expect(metav1.IsControlledBy(ingress, instance)).To(BeTrue())
+	g.Expect(ingress.OwnerReferences).To(HaveLen(1))
+	g.Expect(ingress.Spec.Rules[0].Host).To(Equal("cli-server.example.com"))
+
+	ns := &v1.Namespace{}
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: constants.ClientServerNamespace}, ns)).To(Succeed())
+	g.Expect(metav1.IsControlledBy(ns, instance)).To(BeTrue())
+
+	svc := &v1.Service{}
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: DeploymentName, Namespace: constants.ClientServerNamespace}, svc)).To(Succeed())
+	g.Expect(svc.OwnerReferences).To(BeEmpty())
+
+	// the objects left in the previous namespace are removed
+	moved := &v1alpha1.ClientServer{
+		ObjectMeta: metav1.ObjectMeta{Name: constants.ClientServerName, UID: "uid"},
+		Spec:       v1alpha1.ClientServerSpec{Namespace: "clients"},
+	}
+	c = testAction.FakeClientBuilder().WithObjects(
+		moved,
+		&v1.Namespace{ObjectMeta: legacy(metav1.ObjectMeta{Name: constants.ClientServerNamespace})},
+		&appsv1.Deployment{ObjectMeta: legacy(metav1.ObjectMeta{Name: DeploymentName, Namespace: constants.ClientServerNamespace})},
+	).Build()
+	a = testAction.PrepareAction(c, NewAdoptLegacyAction())
+	g.Expect(a.Handle(ctx, moved)).To(BeNil())
+
+	err := c.Get(ctx, types.NamespacedName{Name: DeploymentName, Namespace: constants.ClientServerNamespace}, &appsv1.Deployment{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: constants.ClientServerNamespace}, ns)).To(Succeed())
+	g.Expect(ns.OwnerReferences).To(BeEmpty())
+}
@@ -0,0 +1,64 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/action"
+	"github.com/securesign/operator/controllers/constants"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func NewNamespaceAction() action.Action[rhtasv1alpha1.ClientServer] {
+	return &namespaceAction{}
+}
+
+type namespaceAction struct {
+	action.BaseAction
+}
+
+func (i namespaceAction) Name() string {
+	return "namespace"
+}
+
+func (i namespaceAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.ClientServer) bool {
+	c := meta.FindStatusCondition(instance.Status.Conditions, constants.Ready)
+	return c.Reason == constants.Pending || c.Reason == constants.Creating || c.Reason == constants.Ready
+}
+
+// Handle creates the namespace of the CLI server, an existing namespace isn't taken over by the ClientServer.
+func (i namespaceAction) Handle(ctx context.Context, instance *rhtasv1alpha1.ClientServer) *action.Result {
+	var (
+		err     error
+		updated bool
+	)
+	ns := &v1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   instance.Spec.Namespace,
+			Labels: constants.LabelsFor(ComponentName, instance.Spec.Namespace, instance.Name),
+		},
+	}
+	if err = controllerutil.SetControllerReference(instance, ns, i.Client.Scheme()); err != nil {
+		return i.Failed(fmt.Errorf("could not set controller reference for Namespace: %w", err))
+	}
+	if updated, err = i.Ensure(ctx, ns); err != nil {
+		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+			Type:    constants.Ready,
+			Status:  metav1.ConditionFalse,
+			Reason:  constants.Failure,
+			Message: err.Error(),
+		})
+		return i.FailedWithStatusUpdate(ctx, fmt.Errorf("could not create namespace: %w", err), instance)
+	}
+
+	if updated || meta.FindStatusCondition(instance.Status.Conditions, constants.Ready).Reason == constants.Pending {
+		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{Type: constants.Ready,
+			Status: metav1.ConditionFalse, Reason: constants.Creating, Message: "Namespace created"})
+		return i.StatusUpdate(ctx, instance)
+	}
+	return i.Continue()
+}
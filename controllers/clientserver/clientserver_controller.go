@@ -0,0 +1,140 @@
+package clientserver
+
+import (
+	"context"
+	"time"
+
+	consolev1 "github.com/openshift/api/console/v1"
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/clientserver/actions"
+	"github.com/securesign/operator/controllers/common/action"
+	"github.com/securesign/operator/controllers/common/utils/kubernetes"
+	"github.com/securesign/operator/controllers/constants"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// ClientServerReconciler reconciles a ClientServer object
+type ClientServerReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+//+kubebuilder:rbac:groups=rhtas.redhat.com,resources=clientservers,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=rhtas.redhat.com,resources=clientservers/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=rhtas.redhat.com,resources=clientservers/finalizers,verbs=update
+//+kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=console.openshift.io,resources=consoleclidownloads,verbs=create;get;list;watch;update;patch;delete
+
+// Reconcile deploys the HTTP server serving the client binaries and publishes their download links in the OpenShift console.
+func (r *ClientServerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	rlog := log.FromContext(ctx).WithName("controller").WithName("clientserver")
+	rlog.V(1).Info("Reconciling ClientServer", "request", req)
+
+	instance := &rhtasv1alpha1.ClientServer{}
+	if err := r.Client.Get(ctx, req.NamespacedName, instance); err != nil {
+		if apierrors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
+			// Return and don't requeue
+			return reconcile.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return reconcile.Result{}, err
+	}
+
+	target := instance.DeepCopy()
+	acs := []action.Action[rhtasv1alpha1.ClientServer]{
+		actions.NewAdoptLegacyAction(),
+		actions.NewToPendingPhaseAction(),
+
+		actions.NewNamespaceAction(),
+		actions.NewDeployAction(),
+		actions.NewServiceAction(),
+		actions.NewIngressAction(),
+
+		actions.NewToInitializePhaseAction(),
+
+		actions.NewInitializeAction(),
+		actions.NewConsoleDownloadAction(),
+	}
+
+	for _, a := range acs {
+		a.InjectClient(r.Client)
+		a.InjectLogger(rlog.WithName(a.Name()))
+		a.InjectRecorder(r.Recorder)
+
+		if a.CanHandle(ctx, target) {
+			rlog.V(2).Info("Executing " + a.Name())
+			result := a.Handle(ctx, target)
+			if result != nil {
+				return result.Result, result.Err
+			}
+		}
+	}
+	return reconcile.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ClientServerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&rhtasv1alpha1.ClientServer{}).
+		Owns(&appsv1.Deployment{}).
+		Owns(&v1.Service{}).
+		Owns(&networkingv1.Ingress{})
+	if kubernetes.IsOpenShift(mgr.GetClient()) {
+		b = b.Owns(&consolev1.ConsoleCLIDownload{})
+	}
+	return b.Complete(r)
+}
+
+// createDefaultBackoff doubles the delay between the attempts to create the default ClientServer up to a minute
+var createDefaultBackoff = wait.Backoff{
+	Duration: time.Second,
+	Factor:   2,
+	Jitter:   0.1,
+	Steps:    7,
+	Cap:      time.Minute,
+}
+
+// CreateDefault returns the runnable creating the ClientServer with the default settings when it doesn't exist,
+// so the clients are served right after the operator is installed.
+// Failed attempts are retried with a backoff until the creation succeeds or the operator stops, the failures are logged only
+// so the other controllers keep running.
+func CreateDefault(c client.Client) manager.Runnable {
+	return manager.RunnableFunc(func(ctx context.Context) error {
+		rlog := ctrl.Log.WithName("clientserver")
+		backoff := createDefaultBackoff
+		for {
+			instance := &rhtasv1alpha1.ClientServer{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   constants.ClientServerName,
+					Labels: constants.LabelsRHTAS(),
+				},
+			}
+			err := c.Create(ctx, instance)
+			if err == nil || apierrors.IsAlreadyExists(err) {
+				return nil
+			}
+			delay := backoff.Step()
+			rlog.Error(err, "unable to create the default ClientServer, retrying", "retryAfter", delay)
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(delay):
+			}
+		}
+	})
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clientserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/clientserver/actions"
+	"github.com/securesign/operator/controllers/constants"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("ClientServer controller", func() {
+	Context("ClientServer controller test", func() {
+
+		ctx := context.Background()
+
+		typeName := types.NamespacedName{Name: constants.ClientServerName}
+		objectKey := types.NamespacedName{Name: actions.DeploymentName, Namespace: constants.ClientServerNamespace}
+
+		AfterEach(func() {
+			By("removing the custom resource for the Kind ClientServer")
+			found := &v1alpha1.ClientServer{}
+			Expect(k8sClient.Get(ctx, typeName, found)).To(Succeed())
+			Eventually(func() error {
+				return k8sClient.Delete(context.TODO(), found)
+			}, 2*time.Minute, time.Second).Should(Succeed())
+		})
+
+		It("should successfully reconcile the default ClientServer", func() {
+			By("Checking if the default custom resource was created on startup")
+			Eventually(func() error {
+				return k8sClient.Get(ctx, typeName, &v1alpha1.ClientServer{})
+			}, time.Minute, time.Second).Should(Succeed())
+
+			found := &v1alpha1.ClientServer{}
+			Expect(k8sClient.Get(ctx, typeName, found)).To(Succeed())
+			Expect(found.Spec.Namespace).To(Equal(constants.ClientServerNamespace))
+			Expect(found.Spec.ExternalAccess.Enabled).To(BeTrue())
+
+			By("Status conditions are initialized")
+			Eventually(func() bool {
+				found := &v1alpha1.ClientServer{}
+				Expect(k8sClient.Get(ctx, typeName, found)).Should(Succeed())
+				return meta.IsStatusConditionPresentAndEqual(found.Status.Conditions, constants.Ready, metav1.ConditionFalse)
+			}, time.Minute, time.Second).Should(BeTrue())
+
+			By("Checking if the Namespace was created in the reconciliation")
+			Eventually(func() error {
+				return k8sClient.Get(ctx, types.NamespacedName{Name: constants.ClientServerNamespace}, &corev1.Namespace{})
+			}, time.Minute, time.Second).Should(Succeed())
+
+			By("Checking if the Service and Ingress were created in the reconciliation")
+			Eventually(func() error {
+				return k8sClient.Get(ctx, objectKey, &corev1.Service{})
+			}, time.Minute, time.Second).Should(Succeed())
+			Eventually(func() error {
+				return k8sClient.Get(ctx, objectKey, &networkingv1.Ingress{})
+			}, time.Minute, time.Second).Should(Succeed())
+
+			By("Waiting until ClientServer instance is Initialization")
+			Eventually(func() string {
+				found := &v1alpha1.ClientServer{}
+				Expect(k8sClient.Get(ctx, typeName, found)).Should(Succeed())
+				return meta.FindStatusCondition(found.Status.Conditions, constants.Ready).Reason
+			}, time.Minute, time.Second).Should(Equal(constants.Initialize))
+
+			deployment := &appsv1.Deployment{}
+			By("Checking if Deployment was successfully created in the reconciliation")
+			Eventually(func() error {
+				return k8sClient.Get(ctx, objectKey, deployment)
+			}, time.Minute, time.Second).Should(Succeed())
+			Expect(deployment.Spec.Template.Spec.Containers[0].Image).Should(Equal(constants.ClientServerImage))
+
+			By("Move to Ready phase")
+			// Workaround to succeed condition for Ready phase
+			deployment.Status.Conditions = []appsv1.DeploymentCondition{
+				{Status: corev1.ConditionTrue, Type: appsv1.DeploymentAvailable, Reason: constants.Ready}}
+			Expect(k8sClient.Status().Update(ctx, deployment)).Should(Succeed())
+
+			By("Waiting until ClientServer instance is Ready")
+			Eventually(func() bool {
+				found := &v1alpha1.ClientServer{}
+				Expect(k8sClient.Get(ctx, typeName, found)).Should(Succeed())
+				return meta.IsStatusConditionTrue(found.Status.Conditions, constants.Ready)
+			}, time.Minute, time.Second).Should(BeTrue())
+
+			By("The URL of the Ingress is published")
+			Eventually(func() string {
+				found := &v1alpha1.ClientServer{}
+				Expect(k8sClient.Get(ctx, typeName, found)).Should(Succeed())
+				return found.Status.Url
+			}, time.Minute, time.Second).Should(Equal("http://cli-server.local"))
+
+			By("Disabling the external access")
+			Expect(k8sClient.Get(ctx, typeName, found)).To(Succeed())
+			found.Spec.ExternalAccess.Enabled = false
+			Expect(k8sClient.Update(ctx, found)).To(Succeed())
+
+			By("The Ingress is deleted")
+			Eventually(func() bool {
+				return errors.IsNotFound(k8sClient.Get(ctx, objectKey, &networkingv1.Ingress{}))
+			}, time.Minute, time.Second).Should(BeTrue())
+
+			By("The URL of the Service is published")
+			Eventually(func() string {
+				found := &v1alpha1.ClientServer{}
+				Expect(k8sClient.Get(ctx, typeName, found)).Should(Succeed())
+				return found.Status.Url
+			}, time.Minute, time.Second).Should(Equal("http://cli-server.trusted-artifact-signer.svc:8080"))
+
+			By("Enabling the external access again")
+			Expect(k8sClient.Get(ctx, typeName, found)).To(Succeed())
+			found.Spec.ExternalAccess.Enabled = true
+			found.Spec.ExternalAccess.Host = "clients.example.com"
+			Expect(k8sClient.Update(ctx, found)).To(Succeed())
+
+			By("The Ingress is created again")
+			ingress := &networkingv1.Ingress{}
+			Eventually(func() error {
+				return k8sClient.Get(ctx, objectKey, ingress)
+			}, time.Minute, time.Second).Should(Succeed())
+			Expect(ingress.Spec.Rules[0].Host).To(Equal("clients.example.com"))
+
+			Eventually(func() string {
+				found := &v1alpha1.ClientServer{}
+				Expect(k8sClient.Get(ctx, typeName, found)).Should(Succeed())
+				return found.Status.Url
+			}, time.Minute, time.Second).Should(Equal("http://clients.example.com"))
+		})
+	})
+})
@@ -0,0 +1,56 @@
+package clientserver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+	testAction "github.com/securesign/operator/controllers/common/test/action"
+	"github.com/securesign/operator/controllers/constants"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestCreateDefaultRetries(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.TODO()
+
+	backoff := createDefaultBackoff
+	defer func() { createDefaultBackoff = backoff }()
+	createDefaultBackoff = wait.Backoff{Duration: time.Millisecond, Factor: 2, Steps: 3, Cap: 4 * time.Millisecond}
+
+	failures := 2
+	c := testAction.FakeClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			if failures > 0 {
+				failures--
+				return errors.New("webhook unavailable")
+			}
+			return c.Create(ctx, obj, opts...)
+		},
+	}).Build()
+
+	g.Expect(CreateDefault(c).Start(ctx)).To(Succeed())
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: constants.ClientServerName}, &v1alpha1.ClientServer{})).To(Succeed())
+
+	// an existing ClientServer is kept
+	g.Expect(CreateDefault(c).Start(ctx)).To(Succeed())
+
+	// the attempts continue past the steps of the backoff until the operator stops, without an error
+	attempts := 0
+	c = testAction.FakeClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			attempts++
+			return errors.New("webhook unavailable")
+		},
+	}).Build()
+	stop, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	g.Expect(CreateDefault(c).Start(stop)).To(Succeed())
+	g.Expect(attempts).To(BeNumerically(">", 10))
+}
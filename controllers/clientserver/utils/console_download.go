@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"fmt"
+
+	consolev1 "github.com/openshift/api/console/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Clients are the binaries served by the CLI server along with their description in the OpenShift console.
+var Clients = map[string]string{
+	"cosign":    "cosign is a CLI tool that allows you to manage sigstore artifacts.",
+	"rekor-cli": "rekor-cli is a CLI tool that allows you to interact with rekor server.",
+	"gitsign":   "gitsign is a CLI tool that allows you to digitally sign and verify git commits.",
+	"ec":        "Enterprise Contract CLI. Set of commands to help validate resources with the Enterprise Contract.",
+}
+
+// platforms are the operating systems and architectures the clients are built for, with their display names.
+var platforms = []struct {
+	os, arch, display string
+}{
+	{"linux", "amd64", "Linux x86_64"},
+	{"linux", "arm64", "Linux arm64"},
+	{"linux", "ppc64le", "Linux ppc64le"},
+	{"linux", "s390x", "Linux s390x"},
+	{"darwin", "amd64", "Mac x86_64"},
+	{"darwin", "arm64", "Mac arm64"},
+	{"windows", "amd64", "Windows x86_64"},
+}
+
+// CreateConsoleCLIDownload returns the download links of the client in the OpenShift console.
+func CreateConsoleCLIDownload(name, clientServerUrl, description string, labels map[string]string) *consolev1.ConsoleCLIDownload {
+	links := make([]consolev1.CLIDownloadLink, len(platforms))
+	for i, p := range platforms {
+		links[i] = consolev1.CLIDownloadLink{
+			Href: fmt.Sprintf("%s/clients/%s/%s-%s.gz", clientServerUrl, p.os, name, p.arch),
+			Text: fmt.Sprintf("Download %s for %s", name, p.display),
+		}
+	}
+	return &consolev1.ConsoleCLIDownload{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+		Spec: consolev1.ConsoleCLIDownloadSpec{
+			Description: description,
+			DisplayName: fmt.Sprintf("%s - Command Line Interface (CLI)", name),
+			Links:       links,
+		},
+	}
+}
@@ -0,0 +1,19 @@
+package utils
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestCreateConsoleCLIDownload(t *testing.T) {
+	g := NewWithT(t)
+
+	download := CreateConsoleCLIDownload("cosign", "https://cli-server.example.com", Clients["cosign"], nil)
+	g.Expect(download.Name).To(Equal("cosign"))
+	g.Expect(download.Namespace).To(BeEmpty())
+	g.Expect(download.Spec.Links).To(HaveLen(7))
+	g.Expect(download.Spec.Links[0].Href).To(Equal("https://cli-server.example.com/clients/linux/cosign-amd64.gz"))
+	g.Expect(download.Spec.Links[6].Href).To(Equal("https://cli-server.example.com/clients/windows/cosign-amd64.gz"))
+	g.Expect(download.Spec.Links[6].Text).To(Equal("Download cosign for Windows x86_64"))
+}
@@ -0,0 +1,115 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/utils"
+	"github.com/securesign/operator/controllers/constants"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	sharedVolumeName = "shared-data"
+	cliBinaryPath    = "/opt/app-root/src/clients/*"
+	cliWebServerPath = "/var/www/html/clients/"
+	// client configuration files written by the Securesign controller
+	configVolumeName = "client-config"
+	configPath       = "/var/www/html/config/"
+)
+
+// CreateDeployment returns the HTTP server serving the client binaries copied from the images by the init containers.
+func CreateDeployment(instance *v1alpha1.ClientServer, name string, port int32, labels map[string]string) *apps.Deployment {
+	replicas := instance.Spec.Pod.Replicas
+	if replicas == nil {
+		replicas = utils.Pointer(int32(1))
+	}
+
+	container := core.Container{
+		Name:            name,
+		Image:           constants.ClientServerImage,
+		ImagePullPolicy: core.PullAlways,
+		Ports: []core.ContainerPort{
+			{
+				ContainerPort: port,
+				Protocol:      core.ProtocolTCP,
+			},
+		},
+		VolumeMounts: []core.VolumeMount{
+			{
+				Name:      sharedVolumeName,
+				MountPath: cliWebServerPath,
+			},
+			{
+				Name:      configVolumeName,
+				MountPath: configPath,
+				ReadOnly:  true,
+			},
+		},
+	}
+	if instance.Spec.Pod.Resources != nil {
+		container.Resources = *instance.Spec.Pod.Resources
+	}
+
+	return &apps.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: instance.Spec.Namespace,
+			Labels:    labels,
+		},
+		Spec: apps.DeploymentSpec{
+			Replicas: replicas,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			Template: core.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: core.PodSpec{
+					NodeSelector: instance.Spec.Pod.NodeSelector,
+					Tolerations:  instance.Spec.Pod.Tolerations,
+					Affinity:     instance.Spec.Pod.Affinity,
+					Volumes: []core.Volume{
+						{
+							Name: sharedVolumeName,
+							VolumeSource: core.VolumeSource{
+								EmptyDir: &core.EmptyDirVolumeSource{},
+							},
+						},
+						{
+							Name: configVolumeName,
+							VolumeSource: core.VolumeSource{
+								ConfigMap: &core.ConfigMapVolumeSource{
+									LocalObjectReference: core.LocalObjectReference{Name: constants.ClientConfigMapName},
+									Optional:             utils.Pointer(true),
+								},
+							},
+						},
+					},
+					InitContainers: []core.Container{
+						initContainer("init-shared-data-cg", constants.ClientServerImage_cg),
+						initContainer("init-shared-data-re", constants.ClientServerImage_re),
+					},
+					Containers: []core.Container{container},
+				},
+			},
+		},
+	}
+}
+
+func initContainer(name, image string) core.Container {
+	return core.Container{
+		Name:    name,
+		Image:   image,
+		Command: []string{"sh", "-c", fmt.Sprintf("cp -r %s %s", cliBinaryPath, cliWebServerPath)},
+		VolumeMounts: []core.VolumeMount{
+			{
+				Name:      sharedVolumeName,
+				MountPath: cliWebServerPath,
+			},
+		},
+	}
+}
@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/utils"
+	"github.com/securesign/operator/controllers/constants"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCreateDeployment(t *testing.T) {
+	g := NewWithT(t)
+	instance := &v1alpha1.ClientServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "cli-server"},
+		Spec: v1alpha1.ClientServerSpec{
+			Namespace: "clients",
+			Pod: v1alpha1.ClientServerPod{
+				Replicas: utils.Pointer(int32(2)),
+				Resources: &core.ResourceRequirements{
+					Limits: core.ResourceList{core.ResourceMemory: resource.MustParse("256Mi")},
+				},
+				NodeSelector: map[string]string{"kubernetes.io/arch": "amd64"},
+				Tolerations:  []core.Toleration{{Key: "infra", Operator: core.TolerationOpExists}},
+			},
+		},
+	}
+
+	dp := CreateDeployment(instance, "cli-server", 8080, map[string]string{"app": "cli-server"})
+	g.Expect(dp.Namespace).To(Equal("clients"))
+	g.Expect(*dp.Spec.Replicas).To(Equal(int32(2)))
+	g.Expect(dp.Spec.Template.Spec.NodeSelector).To(HaveKeyWithValue("kubernetes.io/arch", "amd64"))
+	g.Expect(dp.Spec.Template.Spec.Tolerations).To(HaveLen(1))
+	g.Expect(dp.Spec.Template.Spec.InitContainers).To(HaveLen(2))
+	g.Expect(dp.Spec.Template.Spec.Containers).To(HaveLen(1))
+	g.Expect(dp.Spec.Template.Spec.Containers[0].Image).To(Equal(constants.ClientServerImage))
+	g.Expect(dp.Spec.Template.Spec.Containers[0].Resources.Limits.Memory().String()).To(Equal("256Mi"))
+
+	// one replica without overrides
+	instance.Spec.Pod = v1alpha1.ClientServerPod{}
+	dp = CreateDeployment(instance, "cli-server", 8080, map[string]string{"app": "cli-server"})
+	g.Expect(*dp.Spec.Replicas).To(Equal(int32(1)))
+	g.Expect(dp.Spec.Template.Spec.Containers[0].Resources.Limits).To(BeEmpty())
+}
@@ -4,20 +4,50 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strings"
 	"time"
 
 	"github.com/google/trillian"
 	"github.com/google/trillian/client"
 	"github.com/securesign/operator/controllers/common/utils/kubernetes"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"k8s.io/klog/v2"
 )
 
 // reference code https://github.com/sigstore/scaffolding/blob/main/cmd/trillian/createtree/main.go
-func CreateTrillianTree(ctx context.Context, displayName string, trillianURL string) (*trillian.Tree, error) {
-	var err error
+// The connection is secured by TLS when the CA certificate of Trillian is provided.
+func CreateTrillianTree(ctx context.Context, displayName string, trillianURL string, caCert []byte) (*trillian.Tree, error) {
+	req, err := newRequest(displayName)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := dialTrillian(trillianURL, caCert)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	adminClient := trillian.NewTrillianAdminClient(conn)
+	logClient := trillian.NewTrillianLogClient(conn)
+
+	timeout := time.Duration(120 * time.Second)
+	ctx2, cancel := context.WithTimeout(ctx, timeout)
+	tree, err := client.CreateAndInitTree(ctx2, req, adminClient, logClient)
+	defer cancel()
+	if err != nil {
+		return nil, fmt.Errorf("could not create Trillian tree: %w", err)
+	}
+	return tree, err
+}
+
+// dialTrillian opens the gRPC connection to the Trillian Logserver.
+func dialTrillian(trillianURL string, caCert []byte) (*grpc.ClientConn, error) {
+	// verify the certificate against the service name even when connecting through port-forward
+	serverName, _, err := net.SplitHostPort(trillianURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trillian address: %w", err)
+	}
 	inContainer, err := kubernetes.ContainerMode()
 	if err == nil {
 		if !inContainer {
@@ -28,7 +58,8 @@ func CreateTrillianTree(ctx context.Context, displayName string, trillianURL str
 					trillianURL = "localhost:8091"
 					break
 				} else {
-					fmt.Println("Execute `oc port-forward service/trillian-logserver 8091 8091` in your namespace to continue.")
+					service, _, _ := strings.Cut(serverName, ".")
+					fmt.Printf("Execute `oc port-forward service/%s 8091 8091` in your namespace to continue.\n", service)
 					time.Sleep(time.Duration(5) * time.Second)
 				}
 			}
@@ -37,30 +68,15 @@ func CreateTrillianTree(ctx context.Context, displayName string, trillianURL str
 	} else {
 		klog.Info("Can't recognise operator mode - expecting in-container run")
 	}
-	req, err := newRequest(displayName)
+	opts, err := trillianDialOption(caCert, serverName)
 	if err != nil {
 		return nil, err
 	}
-	var opts grpc.DialOption
-	klog.Warning("Using an insecure gRPC connection to Trillian")
-	opts = grpc.WithTransportCredentials(insecure.NewCredentials())
 	conn, err := grpc.Dial(trillianURL, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to dial: %w", err)
 	}
-	defer conn.Close()
-
-	adminClient := trillian.NewTrillianAdminClient(conn)
-	logClient := trillian.NewTrillianLogClient(conn)
-
-	timeout := time.Duration(120 * time.Second)
-	ctx2, cancel := context.WithTimeout(ctx, timeout)
-	tree, err := client.CreateAndInitTree(ctx2, req, adminClient, logClient)
-	defer cancel()
-	if err != nil {
-		return nil, fmt.Errorf("could not create Trillian tree: %w", err)
-	}
-	return tree, err
+	return conn, nil
 }
 
 func rawConnect(host string, port string) bool {
@@ -0,0 +1,27 @@
+package logmonitor
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	treeSize = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "rhtas_log_monitor_tree_size",
+		Help: "Tree size of the last verified checkpoint",
+	}, []string{"kind", "namespace", "name"})
+
+	lastVerified = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "rhtas_log_monitor_last_verified_timestamp_seconds",
+		Help: "Time of the last successful consistency verification",
+	}, []string{"kind", "namespace", "name"})
+
+	failures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "rhtas_log_monitor_failures_total",
+		Help: "Number of failed consistency verifications",
+	}, []string{"kind", "namespace", "name", "reason"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(treeSize, lastVerified, failures)
+}
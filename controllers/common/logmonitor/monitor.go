@@ -0,0 +1,86 @@
+package logmonitor
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/transparency-dev/merkle/proof"
+	"github.com/transparency-dev/merkle/rfc6962"
+)
+
+const (
+	// ConditionType of the condition reporting the transparency log consistency
+	ConditionType = "LogConsistent"
+
+	ReasonConsistent  = "Consistent"
+	ReasonSplitView   = "SplitView"
+	ReasonKeyMismatch = "KeyMismatch"
+	ReasonUnavailable = "Unavailable"
+)
+
+var (
+	ErrKeyMismatch = errors.New("checkpoint signature does not match log public key")
+	ErrSplitView   = errors.New("checkpoint is not consistent with previously verified checkpoint")
+)
+
+// Checkpoint is a signed tree head of the transparency log
+type Checkpoint struct {
+	Size     uint64
+	RootHash []byte
+}
+
+// Log abstracts the transparency log API used for consistency verification
+type Log interface {
+	// Checkpoint returns the latest checkpoint with a signature verified by the log public key.
+	// Returned error wraps ErrKeyMismatch when the signature does not match.
+	Checkpoint(ctx context.Context) (*Checkpoint, error)
+	// ConsistencyProof returns the proof between two tree sizes.
+	ConsistencyProof(ctx context.Context, first, second uint64) ([][]byte, error)
+}
+
+// Verify fetches the latest checkpoint and verifies that it is consistent with the previously verified one.
+// Returned error wraps ErrSplitView when the log presents a view which does not extend the previous checkpoint.
+func Verify(ctx context.Context, log Log, previous *Checkpoint) (*Checkpoint, error) {
+	current, err := log.Checkpoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if previous == nil || previous.Size == 0 {
+		return current, nil
+	}
+
+	switch {
+	case current.Size < previous.Size:
+		return current, fmt.Errorf("%w: tree size decreased from %d to %d", ErrSplitView, previous.Size, current.Size)
+	case current.Size == previous.Size:
+		if !bytes.Equal(current.RootHash, previous.RootHash) {
+			return current, fmt.Errorf("%w: different root hash for tree size %d", ErrSplitView, current.Size)
+		}
+		return current, nil
+	}
+
+	consistency, err := log.ConsistencyProof(ctx, previous.Size, current.Size)
+	if err != nil {
+		return nil, fmt.Errorf("could not get consistency proof: %w", err)
+	}
+	if err = proof.VerifyConsistency(rfc6962.DefaultHasher, previous.Size, current.Size, consistency, previous.RootHash, current.RootHash); err != nil {
+		return current, fmt.Errorf("%w: %w", ErrSplitView, err)
+	}
+	return current, nil
+}
+
+// Reason translates verification error to condition reason
+func Reason(err error) string {
+	switch {
+	case err == nil:
+		return ReasonConsistent
+	case errors.Is(err, ErrSplitView):
+		return ReasonSplitView
+	case errors.Is(err, ErrKeyMismatch):
+		return ReasonKeyMismatch
+	default:
+		return ReasonUnavailable
+	}
+}
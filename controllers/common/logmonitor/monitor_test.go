@@ -0,0 +1,90 @@
+package logmonitor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/transparency-dev/merkle/rfc6962"
+	"github.com/transparency-dev/merkle/testonly"
+)
+
+type fakeLog struct {
+	tree *testonly.Tree
+	err  error
+}
+
+func (f *fakeLog) Checkpoint(context.Context) (*Checkpoint, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &Checkpoint{Size: f.tree.Size(), RootHash: f.tree.Hash()}, nil
+}
+
+func (f *fakeLog) ConsistencyProof(_ context.Context, first, second uint64) ([][]byte, error) {
+	return f.tree.ConsistencyProof(first, second)
+}
+
+func newLog(entries ...string) *fakeLog {
+	tree := testonly.New(rfc6962.DefaultHasher)
+	for _, e := range entries {
+		tree.AppendData([]byte(e))
+	}
+	return &fakeLog{tree: tree}
+}
+
+func TestVerifyFirstCheckpoint(t *testing.T) {
+	g := NewWithT(t)
+
+	cp, err := Verify(context.TODO(), newLog("a", "b"), nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(cp.Size).Should(Equal(uint64(2)))
+}
+
+func TestVerifyConsistent(t *testing.T) {
+	g := NewWithT(t)
+
+	log := newLog("a", "b", "c")
+	previous, err := Verify(context.TODO(), log, nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	log.tree.AppendData([]byte("d"), []byte("e"))
+	cp, err := Verify(context.TODO(), log, previous)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(cp.Size).Should(Equal(uint64(5)))
+	g.Expect(Reason(err)).Should(Equal(ReasonConsistent))
+}
+
+func TestVerifySplitView(t *testing.T) {
+	g := NewWithT(t)
+
+	previous, err := Verify(context.TODO(), newLog("a", "b", "c"), nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	// same size, different content
+	_, err = Verify(context.TODO(), newLog("a", "b", "x"), previous)
+	g.Expect(err).Should(MatchError(ErrSplitView))
+
+	// bigger tree which does not extend the previous one
+	_, err = Verify(context.TODO(), newLog("a", "x", "c", "d"), previous)
+	g.Expect(err).Should(MatchError(ErrSplitView))
+
+	// tree shrinks
+	_, err = Verify(context.TODO(), newLog("a", "b"), previous)
+	g.Expect(err).Should(MatchError(ErrSplitView))
+	g.Expect(Reason(err)).Should(Equal(ReasonSplitView))
+}
+
+func TestVerifyErrors(t *testing.T) {
+	g := NewWithT(t)
+
+	log := newLog("a")
+	log.err = ErrKeyMismatch
+	_, err := Verify(context.TODO(), log, nil)
+	g.Expect(Reason(err)).Should(Equal(ReasonKeyMismatch))
+
+	log.err = errors.New("connection refused")
+	_, err = Verify(context.TODO(), log, nil)
+	g.Expect(Reason(err)).Should(Equal(ReasonUnavailable))
+}
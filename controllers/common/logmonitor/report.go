@@ -0,0 +1,84 @@
+package logmonitor
+
+import (
+	"encoding/base64"
+	"time"
+
+	"github.com/securesign/operator/api/v1alpha1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const DefaultInterval = 10 * time.Minute
+
+// Interval returns the configured interval between two consecutive checks
+func Interval(config v1alpha1.LogMonitor) time.Duration {
+	if config.Interval == nil || config.Interval.Duration <= 0 {
+		return DefaultInterval
+	}
+	return config.Interval.Duration
+}
+
+// Previous returns the last verified checkpoint stored in the status
+func Previous(status *v1alpha1.LogMonitorStatus, treeID *int64) *Checkpoint {
+	if status == nil || status.TreeSize == 0 || !sameTree(status.TreeID, treeID) {
+		return nil
+	}
+	rootHash, err := base64.StdEncoding.DecodeString(status.RootHash)
+	if err != nil {
+		return nil
+	}
+	return &Checkpoint{Size: uint64(status.TreeSize), RootHash: rootHash}
+}
+
+// Report records the verification result in the status condition, metrics and events and returns the updated monitor status.
+// The previously verified checkpoint is kept when the log misbehaves.
+func Report(recorder record.EventRecorder, kind string, instance client.Object, conditions *[]metav1.Condition,
+	previous *v1alpha1.LogMonitorStatus, treeID *int64, checkpoint *Checkpoint, err error) *v1alpha1.LogMonitorStatus {
+	status := &v1alpha1.LogMonitorStatus{}
+	if previous != nil && sameTree(previous.TreeID, treeID) {
+		status = previous.DeepCopy()
+	}
+	status.TreeID = treeID
+	status.LastCheckTime = &metav1.Time{Time: time.Now()}
+
+	reason := Reason(err)
+	switch reason {
+	case ReasonConsistent:
+		status.TreeSize = int64(checkpoint.Size)
+		status.RootHash = base64.StdEncoding.EncodeToString(checkpoint.RootHash)
+		treeSize.WithLabelValues(kind, instance.GetNamespace(), instance.GetName()).Set(float64(checkpoint.Size))
+		lastVerified.WithLabelValues(kind, instance.GetNamespace(), instance.GetName()).SetToCurrentTime()
+		meta.SetStatusCondition(conditions, metav1.Condition{
+			Type:    ConditionType,
+			Status:  metav1.ConditionTrue,
+			Reason:  reason,
+			Message: "Checkpoint verified",
+		})
+	case ReasonUnavailable:
+		failures.WithLabelValues(kind, instance.GetNamespace(), instance.GetName(), reason).Inc()
+		meta.SetStatusCondition(conditions, metav1.Condition{
+			Type:    ConditionType,
+			Status:  metav1.ConditionUnknown,
+			Reason:  reason,
+			Message: err.Error(),
+		})
+	default:
+		failures.WithLabelValues(kind, instance.GetNamespace(), instance.GetName(), reason).Inc()
+		recorder.Event(instance, v1.EventTypeWarning, reason, err.Error())
+		meta.SetStatusCondition(conditions, metav1.Condition{
+			Type:    ConditionType,
+			Status:  metav1.ConditionFalse,
+			Reason:  reason,
+			Message: err.Error(),
+		})
+	}
+	return status
+}
+
+func sameTree(a, b *int64) bool {
+	return a == nil && b == nil || a != nil && b != nil && *a == *b
+}
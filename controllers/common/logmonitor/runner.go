@@ -0,0 +1,61 @@
+package logmonitor
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// DefaultTimeout limits a single verification of the log.
+const DefaultTimeout = time.Minute
+
+// Runner executes verifications in background so that reconciliation never waits for the network.
+type Runner struct {
+	timeout time.Duration
+
+	mu   sync.Mutex
+	jobs map[types.NamespacedName]*job
+}
+
+type job struct {
+	done       bool
+	checkpoint *Checkpoint
+	err        error
+}
+
+func NewRunner(timeout time.Duration) *Runner {
+	return &Runner{
+		timeout: timeout,
+		jobs:    make(map[types.NamespacedName]*job),
+	}
+}
+
+// Run starts the verification when it is not already running for given key.
+// Result of finished verification is returned only once, next call starts a new verification.
+func (r *Runner) Run(ctx context.Context, key types.NamespacedName, verify func(ctx context.Context) (*Checkpoint, error)) (checkpoint *Checkpoint, done bool, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if j, ok := r.jobs[key]; ok {
+		if !j.done {
+			return nil, false, nil
+		}
+		delete(r.jobs, key)
+		return j.checkpoint, true, j.err
+	}
+
+	j := &job{}
+	r.jobs[key] = j
+	go func() {
+		timeoutCtx, cancel := context.WithTimeout(ctx, r.timeout)
+		defer cancel()
+		cp, err := verify(timeoutCtx)
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		j.checkpoint, j.err, j.done = cp, err, true
+	}()
+	return nil, false, nil
+}
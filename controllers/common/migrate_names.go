@@ -0,0 +1,133 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/securesign/operator/controllers/common/action"
+	"github.com/securesign/operator/controllers/common/utils/kubernetes"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// LegacyName is an object created by an earlier version of the operator under a name shared by all instances in the namespace.
+type LegacyName struct {
+	// Object is an empty object of the kind, e.g. &v1.Service{}
+	Object client.Object
+	// Namespace of the object, the namespace of the instance if empty
+	Namespace     string
+	ClusterScoped bool
+	Name          string
+	// Rename is the name the object is copied to before the legacy object is deleted.
+	// Objects with nothing worth keeping are only deleted, the actions create them again under the new name.
+	Rename string
+	// DeleteFirst deletes the legacy object before the copy is created, for objects which can't coexist (e.g. Ingresses with the same host).
+	DeleteFirst bool
+}
+
+// NewMigrateNamesAction returns the action moving the objects from legacy names to the names scoped by the instance.
+// Only the objects managed by the instance are migrated, so the objects of other instances in the namespace are left alone.
+func NewMigrateNamesAction[T any, PT interface {
+	*T
+	client.Object
+}](legacy func(PT) []LegacyName) action.Action[T] {
+	return &migrateNamesAction[T, PT]{legacy: legacy}
+}
+
+type migrateNamesAction[T any, PT interface {
+	*T
+	client.Object
+}] struct {
+	action.BaseAction
+	legacy func(PT) []LegacyName
+}
+
+func (i migrateNamesAction[T, PT]) Name() string {
+	return "migrate names"
+}
+
+func (i migrateNamesAction[T, PT]) CanHandle(_ context.Context, instance *T) bool {
+	return PT(instance).GetUID() != ""
+}
+
+func (i migrateNamesAction[T, PT]) Handle(ctx context.Context, instance *T) *action.Result {
+	owner := PT(instance)
+	migrated := false
+	for _, l := range i.legacy(owner) {
+		obj := l.Object
+		namespace := l.Namespace
+		if namespace == "" && !l.ClusterScoped {
+			namespace = owner.GetNamespace()
+		}
+		if err := i.Client.Get(ctx, types.NamespacedName{Name: l.Name, Namespace: namespace}, obj); err != nil {
+			// the API of optional objects (e.g. monitoring) may not be installed
+			if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+				continue
+			}
+			return i.Failed(err)
+		}
+		if !managedBy(obj, owner) {
+			continue
+		}
+		if l.Rename != "" && !l.DeleteFirst {
+			if err := i.copy(ctx, obj, l.Rename); err != nil {
+				return i.Failed(fmt.Errorf("could not copy %s to %s: %w", l.Name, l.Rename, err))
+			}
+		}
+		if err := i.Client.Delete(ctx, obj, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+			return i.Failed(fmt.Errorf("could not delete %s: %w", l.Name, err))
+		}
+		if l.Rename != "" && l.DeleteFirst {
+			if err := i.copy(ctx, obj, l.Rename); err != nil {
+				return i.Failed(fmt.Errorf("could not copy %s to %s: %w", l.Name, l.Rename, err))
+			}
+		}
+		kind := reflect.TypeOf(obj).Elem().Name()
+		i.Logger.Info("Migrated object", "kind", kind, "name", l.Name, "rename", l.Rename)
+		if i.Recorder != nil {
+			i.Recorder.Eventf(owner, v1.EventTypeNormal, "NameMigrated", "%s %s migrated to the name of the instance", kind, l.Name)
+		}
+		migrated = true
+	}
+	if migrated {
+		// let the informers observe the deleted objects before they are created again
+		return i.Requeue()
+	}
+	return i.Continue()
+}
+
+// copy creates the object under the new name, keeping its content and owner.
+func (i migrateNamesAction[T, PT]) copy(ctx context.Context, obj client.Object, name string) error {
+	c, ok := obj.DeepCopyObject().(client.Object)
+	if !ok {
+		return fmt.Errorf("can't copy %s", obj.GetName())
+	}
+	c.SetName(name)
+	c.SetResourceVersion("")
+	c.SetUID("")
+	c.SetCreationTimestamp(metav1.Time{})
+	c.SetManagedFields(nil)
+	c.SetGeneration(0)
+	c.SetDeletionTimestamp(nil)
+	if err := i.Client.Create(ctx, c); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// managedBy reports whether the object belongs to the owner.
+func managedBy(obj client.Object, owner client.Object) bool {
+	if metav1.IsControlledBy(obj, owner) {
+		return true
+	}
+	// objects outside the namespace of the owner are recognized by labels
+	labels := obj.GetLabels()
+	return metav1.GetControllerOf(obj) == nil &&
+		labels[kubernetes.InstanceLabel] == owner.GetName() &&
+		labels[kubernetes.InstanceNamespaceLabel] == owner.GetNamespace()
+}
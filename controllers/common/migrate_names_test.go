@@ -0,0 +1,67 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+	testAction "github.com/securesign/operator/controllers/common/test/action"
+	"github.com/securesign/operator/controllers/common/utils/kubernetes"
+	"github.com/securesign/operator/controllers/constants"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func TestMigrateNames(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.TODO()
+
+	instance := &v1alpha1.Trillian{ObjectMeta: metav1.ObjectMeta{Name: "first", Namespace: "default", UID: "first-uid"}}
+	other := &v1alpha1.Trillian{ObjectMeta: metav1.ObjectMeta{Name: "second", Namespace: "default", UID: "second-uid"}}
+
+	scheme := testAction.FakeClientBuilder().Build().Scheme()
+	owned := func(obj client.Object, owner client.Object) client.Object {
+		g.Expect(controllerutil.SetControllerReference(owner, obj, scheme)).To(Succeed())
+		return obj
+	}
+
+	c := testAction.FakeClientBuilder().WithObjects(
+		instance,
+		owned(&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "server", Namespace: "default"}}, instance),
+		owned(kubernetes.CreateSecret("ca", "default", map[string][]byte{"key": []byte("data")}, nil), instance),
+		owned(&v1.Service{ObjectMeta: metav1.ObjectMeta{Name: "server", Namespace: "default"}}, other),
+	).Build()
+
+	a := testAction.PrepareAction(c, NewMigrateNamesAction[v1alpha1.Trillian](func(i *v1alpha1.Trillian) []LegacyName {
+		return []LegacyName{
+			{Object: &appsv1.Deployment{}, Name: "server"},
+			{Object: &v1.Service{}, Name: "server"},
+			{Object: &v1.Secret{}, Name: "ca", Rename: constants.InstanceName("ca", i.Name)},
+			{Object: &v1.ConfigMap{}, Name: "missing"},
+		}
+	}))
+	g.Expect(a.CanHandle(ctx, instance)).To(BeTrue())
+	g.Expect(a.Handle(ctx, instance)).ToNot(BeNil())
+
+	err := c.Get(ctx, types.NamespacedName{Name: "server", Namespace: "default"}, &appsv1.Deployment{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	err = c.Get(ctx, types.NamespacedName{Name: "ca", Namespace: "default"}, &v1.Secret{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+	secret := &v1.Secret{}
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: "ca-first", Namespace: "default"}, secret)).To(Succeed())
+	g.Expect(secret.Data).To(HaveKeyWithValue("key", []byte("data")))
+	g.Expect(metav1.IsControlledBy(secret, instance)).To(BeTrue())
+
+	// the objects of the other instance are left alone
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: "server", Namespace: "default"}, &v1.Service{})).To(Succeed())
+
+	// nothing left to migrate
+	g.Expect(a.Handle(ctx, instance)).To(BeNil())
+}
@@ -0,0 +1,69 @@
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/trillian/quota/etcd/quotapb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// ListTrillianQuotas returns the token buckets known to the quota API of the Trillian Logserver.
+// It is a variable so tests running without Trillian can replace it.
+var ListTrillianQuotas = listTrillianQuotas
+
+func listTrillianQuotas(ctx context.Context, trillianURL string, caCert []byte) ([]*quotapb.Config, error) {
+	conn, err := dialTrillian(trillianURL, caCert)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, trillianAdminTimeout)
+	defer cancel()
+	resp, err := quotapb.NewQuotaClient(conn).ListConfigs(ctx, &quotapb.ListConfigsRequest{View: quotapb.ListConfigsRequest_FULL})
+	if err != nil {
+		return nil, fmt.Errorf("could not list Trillian quotas: %w", err)
+	}
+	return resp.Configs, nil
+}
+
+// ApplyTrillianQuotas creates, updates and deletes the token buckets in a single connection to the Logserver.
+// It is a variable so tests running without Trillian can replace it.
+var ApplyTrillianQuotas = applyTrillianQuotas
+
+func applyTrillianQuotas(ctx context.Context, trillianURL string, caCert []byte, create []*quotapb.Config, update []*quotapb.Config, remove []string) error {
+	conn, err := dialTrillian(trillianURL, caCert)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, trillianAdminTimeout)
+	defer cancel()
+	client := quotapb.NewQuotaClient(conn)
+	for _, config := range create {
+		if _, err = client.CreateConfig(ctx, &quotapb.CreateConfigRequest{Name: config.Name, Config: config}); err != nil {
+			return fmt.Errorf("could not create Trillian quota %s: %w", config.Name, err)
+		}
+	}
+	for _, config := range update {
+		strategy := "sequencing_based"
+		if config.GetTimeBased() != nil {
+			strategy = "time_based"
+		}
+		if _, err = client.UpdateConfig(ctx, &quotapb.UpdateConfigRequest{
+			Name:       config.Name,
+			Config:     config,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"state", "max_tokens", strategy}},
+		}); err != nil {
+			return fmt.Errorf("could not update Trillian quota %s: %w", config.Name, err)
+		}
+	}
+	for _, name := range remove {
+		if _, err = client.DeleteConfig(ctx, &quotapb.DeleteConfigRequest{Name: name}); err != nil {
+			return fmt.Errorf("could not delete Trillian quota %s: %w", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package common
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/utils/kubernetes"
+	"github.com/securesign/operator/controllers/constants"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// TrillianCALabel marks the ConfigMap with the CA certificate of Trillian gRPC endpoints.
+	// The label value is the ConfigMap key holding the certificate.
+	TrillianCALabel = constants.LabelNamespace + "/trillian-ca.crt"
+
+	trillianCAVolume    = "trillian-ca"
+	trillianCAMountPath = "/var/run/secrets/trillian-ca"
+)
+
+// TrillianInstance returns the name of the Trillian instance referenced by the log, the name of the log when the reference is unset.
+func TrillianInstance(ref v1alpha1.TrillianService, log string) string {
+	if ref.Name != "" {
+		return ref.Name
+	}
+	return log
+}
+
+// FindTrillianCA finds the ConfigMap with the CA certificate of gRPC endpoints of the Trillian instance.
+// It returns nil when the Trillian does not use TLS.
+func FindTrillianCA(ctx context.Context, c client.Client, namespace string, instance string) (*corev1.ConfigMap, error) {
+	return kubernetes.FindConfigMap(ctx, c, namespace, fmt.Sprintf("%s,%s=%s", TrillianCALabel, kubernetes.InstanceLabel, instance))
+}
+
+// FindTrillianCACert returns the CA certificate of gRPC endpoints of the Trillian instance, nil when the Trillian does not use TLS.
+func FindTrillianCACert(ctx context.Context, c client.Client, namespace string, instance string) ([]byte, error) {
+	cm, err := FindTrillianCA(ctx, c, namespace, instance)
+	if err != nil || cm == nil {
+		return nil, err
+	}
+	return TrillianCACert(cm)
+}
+
+// TrillianCACert returns the PEM encoded CA certificate stored in the ConfigMap.
+func TrillianCACert(cm *corev1.ConfigMap) ([]byte, error) {
+	ca := cm.Data[cm.Labels[TrillianCALabel]]
+	if ca == "" {
+		return nil, errors.New("trillian CA certificate is not populated yet")
+	}
+	return []byte(ca), nil
+}
+
+// MountTrillianCA mounts the CA ConfigMap to the container and returns the path of the CA certificate.
+func MountTrillianCA(pod *corev1.PodSpec, container *corev1.Container, cm *corev1.ConfigMap) string {
+	pod.Volumes = append(pod.Volumes, corev1.Volume{
+		Name: trillianCAVolume,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: cm.Name},
+			},
+		},
+	})
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      trillianCAVolume,
+		MountPath: trillianCAMountPath,
+		ReadOnly:  true,
+	})
+	return filepath.Join(trillianCAMountPath, cm.Labels[TrillianCALabel])
+}
+
+// trillianDialOption returns credentials for the gRPC connection, TLS is used when CA certificate is provided.
+func trillianDialOption(caCert []byte, serverName string) (grpc.DialOption, error) {
+	if caCert == nil {
+		klog.Warning("Using an insecure gRPC connection to Trillian")
+		return grpc.WithTransportCredentials(insecure.NewCredentials()), nil
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caCert) {
+		return nil, errors.New("could not parse trillian CA certificate")
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+		RootCAs:    pool,
+		ServerName: serverName,
+		MinVersion: tls.VersionTLS12,
+	})), nil
+}
@@ -0,0 +1,48 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+	testAction "github.com/securesign/operator/controllers/common/test/action"
+	"github.com/securesign/operator/controllers/common/utils/kubernetes"
+	"github.com/securesign/operator/controllers/constants"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFindReferencedTrillianCA(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.TODO()
+
+	ca := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "trillian-ca-trillian-sample",
+			Namespace: "default",
+			Labels: map[string]string{
+				TrillianCALabel:           "ca.crt",
+				kubernetes.InstanceLabel:  "trillian-sample",
+				kubernetes.ComponentLabel: "trillian",
+			},
+		},
+		Data: map[string]string{"ca.crt": "certificate"},
+	}
+	c := testAction.FakeClientBuilder().WithObjects(ca).Build()
+
+	// a standalone log references the Trillian
+	name := TrillianInstance(v1alpha1.TrillianService{Name: "trillian-sample"}, "rekor-sample")
+	g.Expect(name).To(Equal("trillian-sample"))
+	g.Expect(constants.InstanceName("trillian-logserver", name)).To(Equal("trillian-logserver-trillian-sample"))
+	cert, err := FindTrillianCACert(ctx, c, "default", name)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(cert).To(Equal([]byte("certificate")))
+
+	// the Trillian with the name of the log is used without a reference
+	name = TrillianInstance(v1alpha1.TrillianService{}, "rekor-sample")
+	g.Expect(name).To(Equal("rekor-sample"))
+	cert, err = FindTrillianCACert(ctx, c, "default", name)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(cert).To(BeNil())
+}
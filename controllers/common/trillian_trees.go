@@ -0,0 +1,86 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/trillian"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const trillianAdminTimeout = 30 * time.Second
+
+// ListTrillianTrees returns trees known to the Trillian Logserver, soft-deleted trees are omitted.
+// It is a variable so tests running without Trillian can replace it.
+var ListTrillianTrees = listTrillianTrees
+
+func listTrillianTrees(ctx context.Context, trillianURL string, caCert []byte) ([]*trillian.Tree, error) {
+	conn, err := dialTrillian(trillianURL, caCert)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, trillianAdminTimeout)
+	defer cancel()
+	resp, err := trillian.NewTrillianAdminClient(conn).ListTrees(ctx, &trillian.ListTreesRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list Trillian trees: %w", err)
+	}
+	return resp.Tree, nil
+}
+
+// GetTrillianTree returns the tree with the treeID.
+// It is a variable so tests running without Trillian can replace it.
+var GetTrillianTree = getTrillianTree
+
+func getTrillianTree(ctx context.Context, trillianURL string, caCert []byte, treeID int64) (*trillian.Tree, error) {
+	conn, err := dialTrillian(trillianURL, caCert)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, trillianAdminTimeout)
+	defer cancel()
+	tree, err := trillian.NewTrillianAdminClient(conn).GetTree(ctx, &trillian.GetTreeRequest{TreeId: treeID})
+	if err != nil {
+		return nil, fmt.Errorf("could not get Trillian tree %d: %w", treeID, err)
+	}
+	return tree, nil
+}
+
+// UpdateTrillianTreeState changes the state of the tree, the Logserver rejects transitions which are not allowed.
+func UpdateTrillianTreeState(ctx context.Context, trillianURL string, caCert []byte, treeID int64, state trillian.TreeState) (*trillian.Tree, error) {
+	conn, err := dialTrillian(trillianURL, caCert)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, trillianAdminTimeout)
+	defer cancel()
+	tree, err := trillian.NewTrillianAdminClient(conn).UpdateTree(ctx, &trillian.UpdateTreeRequest{
+		Tree:       &trillian.Tree{TreeId: treeID, TreeState: state},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"tree_state"}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not change state of Trillian tree %d to %s: %w", treeID, state, err)
+	}
+	return tree, nil
+}
+
+// ValidateAdoptedTree checks that an existing tree can be used by a new log.
+func ValidateAdoptedTree(tree *trillian.Tree) error {
+	if tree.Deleted {
+		return fmt.Errorf("trillian tree %d is deleted", tree.TreeId)
+	}
+	if tree.TreeType != trillian.TreeType_LOG && tree.TreeType != trillian.TreeType_PREORDERED_LOG {
+		return fmt.Errorf("trillian tree %d has unsupported type %s", tree.TreeId, tree.TreeType)
+	}
+	if tree.TreeState != trillian.TreeState_ACTIVE {
+		return fmt.Errorf("trillian tree %d is %s, only ACTIVE tree can be used", tree.TreeId, tree.TreeState)
+	}
+	return nil
+}
@@ -18,6 +18,9 @@ const (
 
 	ComponentLabel = "app.kubernetes.io/component"
 	NameLabel      = "app.kubernetes.io/name"
+	InstanceLabel  = "app.kubernetes.io/instance"
+	// InstanceNamespaceLabel marks objects created outside the namespace of the instance, which can't reference it as owner.
+	InstanceNamespaceLabel = "app.kubernetes.io/instance-namespace"
 )
 
 func FilterCommonLabels(labels map[string]string) map[string]string {
@@ -89,3 +92,48 @@ func CalculateHostname(ctx context.Context, client client.Client, svcName, ns st
 	}
 	return svcName + ".local", nil
 }
+
+// EnsureAnnotations sets the annotations on the existing object, other annotations are preserved.
+// It returns true when the object was updated.
+func EnsureAnnotations(ctx context.Context, cli client.Client, obj client.Object, annotations map[string]string) (bool, error) {
+	if err := cli.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+		return false, err
+	}
+	current := obj.GetAnnotations()
+	if current == nil {
+		current = make(map[string]string)
+	}
+	updated := false
+	for k, v := range annotations {
+		if current[k] != v {
+			current[k] = v
+			updated = true
+		}
+	}
+	if !updated {
+		return false, nil
+	}
+	obj.SetAnnotations(current)
+	return true, cli.Update(ctx, obj)
+}
+
+// RemoveAnnotations removes the annotations from the existing object, other annotations are preserved.
+// It returns true when the object was updated.
+func RemoveAnnotations(ctx context.Context, cli client.Client, obj client.Object, keys ...string) (bool, error) {
+	if err := cli.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+		return false, err
+	}
+	current := obj.GetAnnotations()
+	updated := false
+	for _, k := range keys {
+		if _, ok := current[k]; ok {
+			delete(current, k)
+			updated = true
+		}
+	}
+	if !updated {
+		return false, nil
+	}
+	obj.SetAnnotations(current)
+	return true, cli.Update(ctx, obj)
+}
@@ -6,7 +6,9 @@ import (
 	"github.com/securesign/operator/api/v1alpha1"
 	v12 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -24,6 +26,12 @@ func CreateIngress(ctx context.Context, cli client.Client, svc v12.Service, conf
 		}
 	}
 
+	if host == "" {
+		var err error
+		if host, err = existingHost(ctx, cli, svc.Name, svc.Namespace); err != nil {
+			return nil, err
+		}
+	}
 	if host == "" {
 		var err error
 		if host, err = CalculateHostname(ctx, cli, svc.Name, svc.Namespace); err != nil {
@@ -65,3 +73,21 @@ func CreateIngress(ctx context.Context, cli client.Client, svc v12.Service, conf
 		},
 	}, nil
 }
+
+// existingHost returns the host already assigned to the Ingress, so the public URL doesn't change
+// when the calculated hostname does (e.g. after the Ingress was renamed).
+func existingHost(ctx context.Context, cli client.Client, name, namespace string) (string, error) {
+	ingress := &networkingv1.Ingress{}
+	if err := cli.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, ingress); err != nil {
+		if errors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	for _, rule := range ingress.Spec.Rules {
+		if rule.Host != "" {
+			return rule.Host, nil
+		}
+	}
+	return "", nil
+}
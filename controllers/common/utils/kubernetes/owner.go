@@ -0,0 +1,50 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// ErrNoController is returned when the object isn't managed by a controller, so it has no siblings.
+var ErrNoController = errors.New("object is not managed by a controller")
+
+// GetSibling gets the object managed by the same controller as obj into sibling.
+// The components managed by a Securesign share its name, so the sibling is the object named after the controller.
+func GetSibling(ctx context.Context, c client.Client, obj client.Object, sibling client.Object) error {
+	owner := metav1.GetControllerOf(obj)
+	if owner == nil {
+		return ErrNoController
+	}
+	if err := c.Get(ctx, types.NamespacedName{Name: owner.Name, Namespace: obj.GetNamespace()}, sibling); err != nil {
+		return err
+	}
+	if controller := metav1.GetControllerOf(sibling); controller == nil || controller.UID != owner.UID {
+		return fmt.Errorf("%s is not managed by %s %s", sibling.GetName(), owner.Kind, owner.Name)
+	}
+	return nil
+}
+
+// EnqueueSibling enqueues the object managed by the same controller as the changed object.
+func EnqueueSibling() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(_ context.Context, object client.Object) []reconcile.Request {
+		owner := metav1.GetControllerOf(object)
+		if owner == nil {
+			return nil
+		}
+		return []reconcile.Request{
+			{
+				NamespacedName: types.NamespacedName{
+					Namespace: object.GetNamespace(),
+					Name:      owner.Name,
+				},
+			},
+		}
+	})
+}
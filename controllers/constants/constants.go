@@ -3,6 +3,15 @@ package constants
 const (
 	AppName = "trusted-artifact-signer"
 
+	// DefaultCTlogPrefix is the prefix of the CTlog endpoints when the CTlog does not set one
+	DefaultCTlogPrefix = "trusted-artifact-signer"
+
+	// ClientServerNamespace is the default namespace of the server providing the CLI binaries
+	ClientServerNamespace = "trusted-artifact-signer"
+	ClientServerName      = "cli-server"
+	// ClientConfigMapName is the ConfigMap of the CLI server with the client configuration files of all Securesigns
+	ClientConfigMapName = "cli-server-client-config"
+
 	// conditions
 	Ready      = "Ready"
 	Pending    = "Pending"
@@ -26,3 +26,9 @@ func LabelsRHTAS() map[string]string {
 		"app.kubernetes.io/managed-by": "controller-manager",
 	}
 }
+
+// InstanceName returns the name of an object generated for the instance of a component.
+// The names include the instance name, so several instances of a component can run in one namespace.
+func InstanceName(name, instance string) string {
+	return name + "-" + instance
+}
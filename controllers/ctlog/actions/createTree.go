@@ -33,13 +33,9 @@ func (i createTrillianTreeAction) CanHandle(_ context.Context, instance *rhtasv1
 }
 
 func (i createTrillianTreeAction) Handle(ctx context.Context, instance *rhtasv1alpha1.CTlog) *action.Result {
-	if instance.Spec.TreeID != nil && *instance.Spec.TreeID != int64(0) {
-		instance.Status.TreeID = instance.Spec.TreeID
-		return i.StatusUpdate(ctx, instance)
-	}
 	var err error
 
-	trillUrl, err := utils.GetInternalUrl(ctx, i.Client, instance.Namespace, trillian.LogserverDeploymentName)
+	trillUrl, err := utils.GetInternalUrl(ctx, i.Client, instance.Namespace, constants.InstanceName(trillian.LogserverDeploymentName, common.TrillianInstance(instance.Spec.Trillian, instance.Name)))
 	if err != nil {
 		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
 			Type:    constants.Ready,
@@ -49,7 +45,29 @@ func (i createTrillianTreeAction) Handle(ctx context.Context, instance *rhtasv1a
 		})
 		return i.FailedWithStatusUpdate(ctx, fmt.Errorf("could not find trillian instance: %w", err), instance)
 	}
-	tree, err := common.CreateTrillianTree(ctx, "ctlog-tree", trillUrl+":8091")
+	caCert, err := common.FindTrillianCACert(ctx, i.Client, instance.Namespace, common.TrillianInstance(instance.Spec.Trillian, instance.Name))
+	if err != nil {
+		return i.Failed(fmt.Errorf("could not find trillian CA certificate: %w", err))
+	}
+
+	if instance.Spec.TreeID != nil && *instance.Spec.TreeID != int64(0) {
+		tree, err := common.GetTrillianTree(ctx, trillUrl+":8091", caCert, *instance.Spec.TreeID)
+		if err == nil {
+			err = common.ValidateAdoptedTree(tree)
+		}
+		if err != nil {
+			meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+				Type:    constants.Ready,
+				Status:  metav1.ConditionFalse,
+				Reason:  constants.Failure,
+				Message: err.Error(),
+			})
+			return i.FailedWithStatusUpdate(ctx, fmt.Errorf("could not use trillian tree: %w", err), instance)
+		}
+		instance.Status.TreeID = instance.Spec.TreeID
+		return i.StatusUpdate(ctx, instance)
+	}
+	tree, err := common.CreateTrillianTree(ctx, "ctlog-tree", trillUrl+":8091", caCert)
 	if err != nil {
 		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
 			Type:    constants.Ready,
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common"
 	"github.com/securesign/operator/controllers/common/action"
 	"github.com/securesign/operator/controllers/constants"
 	"github.com/securesign/operator/controllers/ctlog/utils"
@@ -38,7 +39,7 @@ func (i deployAction) Handle(ctx context.Context, instance *rhtasv1alpha1.CTlog)
 
 	labels := constants.LabelsFor(ComponentName, DeploymentName, instance.Name)
 
-	dp, err := utils.CreateDeployment(instance, DeploymentName, RBACName, labels)
+	dp, err := utils.CreateDeployment(instance, constants.InstanceName(DeploymentName, instance.Name), constants.InstanceName(RBACName, instance.Name), labels)
 	if err != nil {
 		if err != nil {
 			meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
@@ -51,6 +52,14 @@ func (i deployAction) Handle(ctx context.Context, instance *rhtasv1alpha1.CTlog)
 		}
 	}
 
+	ca, err := common.FindTrillianCA(ctx, i.Client, instance.Namespace, common.TrillianInstance(instance.Spec.Trillian, instance.Name))
+	if err != nil {
+		return i.Failed(fmt.Errorf("could not find trillian CA: %w", err))
+	}
+	if ca != nil {
+		utils.UseTrillianTLS(dp, ca)
+	}
+
 	if err = controllerutil.SetControllerReference(instance, dp, i.Client.Scheme()); err != nil {
 		return i.Failed(fmt.Errorf("could not set controller reference for Deployment: %w", err))
 	}
@@ -2,6 +2,8 @@ package actions
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"github.com/securesign/operator/api/v1alpha1"
 	"github.com/securesign/operator/controllers/common/action"
 	k8sutils "github.com/securesign/operator/controllers/common/utils/kubernetes"
@@ -12,6 +14,7 @@ import (
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"slices"
 )
 
@@ -43,11 +46,8 @@ func (g handleFulcioCert) CanHandle(ctx context.Context, instance *v1alpha1.CTlo
 
 	if len(instance.Spec.RootCertificates) == 0 {
 		// test if autodiscovery find new secret
-		if scr, _ := k8sutils.FindSecret(ctx, g.Client, instance.Namespace, actions.FulcioCALabel); scr != nil {
-			return !slices.Contains(instance.Status.RootCertificates, v1alpha1.SecretKeySelector{
-				LocalObjectReference: v1alpha1.LocalObjectReference{Name: scr.Name},
-				Key:                  scr.Labels[actions.FulcioCALabel],
-			})
+		if ref, _ := g.discoverCert(ctx, instance); ref != nil {
+			return !slices.Contains(instance.Status.RootCertificates, *ref)
 		}
 	}
 
@@ -67,11 +67,11 @@ func (g handleFulcioCert) Handle(ctx context.Context, instance *v1alpha1.CTlog)
 	}
 
 	if len(instance.Spec.RootCertificates) == 0 {
-		scr, err := k8sutils.FindSecret(ctx, g.Client, instance.Namespace, actions.FulcioCALabel)
+		ref, err := g.discoverCert(ctx, instance)
 		if err != nil {
 			return g.Failed(err)
 		}
-		if scr == nil {
+		if ref == nil {
 			meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
 				Type:    CertCondition,
 				Status:  metav1.ConditionFalse,
@@ -81,14 +81,7 @@ func (g handleFulcioCert) Handle(ctx context.Context, instance *v1alpha1.CTlog)
 			g.StatusUpdate(ctx, instance)
 			return g.Requeue()
 		}
-		instance.Status.RootCertificates = []v1alpha1.SecretKeySelector{
-			{
-				LocalObjectReference: v1alpha1.LocalObjectReference{
-					Name: scr.Name,
-				},
-				Key: scr.Labels[actions.FulcioCALabel],
-			},
-		}
+		instance.Status.RootCertificates = []v1alpha1.SecretKeySelector{*ref}
 	} else {
 		instance.Status.RootCertificates = instance.Spec.RootCertificates
 	}
@@ -116,3 +109,31 @@ func (g handleFulcioCert) Handle(ctx context.Context, instance *v1alpha1.CTlog)
 	)
 	return g.StatusUpdate(ctx, instance)
 }
+
+// discoverCert finds the Fulcio root certificate, it returns nil when the certificate isn't created yet.
+func (g handleFulcioCert) discoverCert(ctx context.Context, instance *v1alpha1.CTlog) (*v1alpha1.SecretKeySelector, error) {
+	if instance.Spec.KeyDiscovery == v1alpha1.KeyDiscoveryLabels {
+		scr, err := k8sutils.FindSecret(ctx, g.Client, instance.Namespace, actions.FulcioCALabel)
+		if err != nil || scr == nil {
+			return nil, err
+		}
+		return &v1alpha1.SecretKeySelector{
+			LocalObjectReference: v1alpha1.LocalObjectReference{
+				Name: scr.Name,
+			},
+			Key: scr.Labels[actions.FulcioCALabel],
+		}, nil
+	}
+
+	fulcio := &v1alpha1.Fulcio{}
+	if err := k8sutils.GetSibling(ctx, g.Client, instance, fulcio); err != nil {
+		if errors.Is(err, k8sutils.ErrNoController) {
+			return nil, fmt.Errorf("unable to resolve Fulcio certificate, set rootCertificates or use Labels key discovery: %w", err)
+		}
+		return nil, client.IgnoreNotFound(err)
+	}
+	if fulcio.Status.Certificate == nil || fulcio.Status.Certificate.CARef == nil {
+		return nil, nil
+	}
+	return fulcio.Status.Certificate.CARef.DeepCopy(), nil
+}
@@ -13,6 +13,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"testing"
 )
 
@@ -24,7 +25,7 @@ func Test_HandleFulcioCert_Autodiscover(t *testing.T) {
 			Name:      "auto",
 			Namespace: "default",
 		},
-		Spec: v1alpha1.CTlogSpec{},
+		Spec: v1alpha1.CTlogSpec{KeyDiscovery: v1alpha1.KeyDiscoveryLabels},
 		Status: v1alpha1.CTlogStatus{
 			Conditions: []metav1.Condition{
 				{
@@ -67,7 +68,7 @@ func Test_HandleFulcioCert_Empty(t *testing.T) {
 			Name:      "empty",
 			Namespace: "default",
 		},
-		Spec: v1alpha1.CTlogSpec{},
+		Spec: v1alpha1.CTlogSpec{KeyDiscovery: v1alpha1.KeyDiscoveryLabels},
 		Status: v1alpha1.CTlogStatus{
 			Conditions: []metav1.Condition{
 				{
@@ -96,6 +97,58 @@ func Test_HandleFulcioCert_Empty(t *testing.T) {
 	g.Expect(result).To(Equal(dummyAction.Requeue()))
 }
 
+func Test_HandleFulcioCert_Status(t *testing.T) {
+	g := NewWithT(t)
+
+	c := testAction.FakeClientBuilder().Build()
+	owner := &v1alpha1.Securesign{ObjectMeta: metav1.ObjectMeta{Name: "securesign", Namespace: "default", UID: "securesign-uid"}}
+	fulcio := &v1alpha1.Fulcio{
+		ObjectMeta: metav1.ObjectMeta{Name: "securesign", Namespace: "default"},
+		Status: v1alpha1.FulcioStatus{Certificate: &v1alpha1.FulcioCert{CARef: &v1alpha1.SecretKeySelector{
+			Key:                  "cert",
+			LocalObjectReference: v1alpha1.LocalObjectReference{Name: "fulcio-cert"},
+		}}},
+	}
+	instance := &v1alpha1.CTlog{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "securesign",
+			Namespace: "default",
+		},
+		Spec: v1alpha1.CTlogSpec{KeyDiscovery: v1alpha1.KeyDiscoveryStatus},
+		Status: v1alpha1.CTlogStatus{
+			Conditions: []metav1.Condition{
+				{
+					Type:   constants.Ready,
+					Reason: constants.Creating,
+					Status: metav1.ConditionFalse,
+				},
+			},
+		},
+	}
+	g.Expect(controllerutil.SetControllerReference(owner, fulcio, c.Scheme())).To(Succeed())
+	g.Expect(controllerutil.SetControllerReference(owner, instance, c.Scheme())).To(Succeed())
+	g.Expect(c.Create(context.TODO(), fulcio)).To(Succeed())
+	// certificates of other Fulcio instances are ignored
+	g.Expect(c.Create(context.TODO(), kubernetes.CreateSecret("other", "default",
+		map[string][]byte{"key": nil}, map[string]string{actions.FulcioCALabel: "key"}))).To(Succeed())
+	g.Expect(c.Create(context.TODO(), kubernetes.CreateSecret("another", "default",
+		map[string][]byte{"key": nil}, map[string]string{actions.FulcioCALabel: "key"}))).To(Succeed())
+
+	a := testAction.PrepareAction(c, NewHandleFulcioCertAction())
+	g.Expect(a.CanHandle(context.TODO(), instance)).To(BeTrue())
+
+	_ = a.Handle(context.TODO(), instance)
+	g.Expect(instance.Status.RootCertificates).To(Equal([]v1alpha1.SecretKeySelector{*fulcio.Status.Certificate.CARef}))
+	g.Expect(meta.IsStatusConditionTrue(instance.Status.Conditions, CertCondition)).To(BeTrue())
+	g.Expect(a.CanHandle(context.TODO(), instance)).To(BeFalse())
+
+	// a CTlog without owner must reference the certificate explicitly
+	instance.OwnerReferences = nil
+	instance.Status.RootCertificates = nil
+	result := a.Handle(context.TODO(), instance)
+	g.Expect(result.Err).To(MatchError(ContainSubstring("Labels key discovery")))
+}
+
 func Test_HandleFulcioCert_Configured(t *testing.T) {
 	g := NewWithT(t)
 
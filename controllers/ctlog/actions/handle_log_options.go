@@ -0,0 +1,100 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common"
+	"github.com/securesign/operator/controllers/common/action"
+	k8sutils "github.com/securesign/operator/controllers/common/utils/kubernetes"
+	"github.com/securesign/operator/controllers/constants"
+	"github.com/securesign/operator/controllers/ctlog/utils"
+	trillian "github.com/securesign/operator/controllers/trillian/actions"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewHandleLogOptionsAction() action.Action[v1alpha1.CTlog] {
+	return &handleLogOptions{}
+}
+
+type handleLogOptions struct {
+	action.BaseAction
+}
+
+func (g handleLogOptions) Name() string {
+	return "handle-log-options"
+}
+
+func (g handleLogOptions) CanHandle(_ context.Context, instance *v1alpha1.CTlog) bool {
+	c := meta.FindStatusCondition(instance.Status.Conditions, constants.Ready)
+	if c.Reason != constants.Creating && c.Reason != constants.Ready {
+		return false
+	}
+	if instance.Status.ServerConfigRef == nil {
+		return false
+	}
+	if instance.Status.Url != logUrl(instance) {
+		return true
+	}
+	return !g.upToDate(instance)
+}
+
+// Handle invalidates the server config when the log settings or the Trillian address changed, the config is created again with the new settings.
+func (g handleLogOptions) Handle(ctx context.Context, instance *v1alpha1.CTlog) *action.Result {
+	if g.upToDate(instance) {
+		// the server config is up to date, e.g. created before the URL was reported
+		instance.Status.Url = logUrl(instance)
+		return g.StatusUpdate(ctx, instance)
+	}
+
+	if meta.FindStatusCondition(instance.Status.Conditions, constants.Ready).Reason != constants.Creating {
+		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+			Type:   constants.Ready,
+			Status: metav1.ConditionFalse,
+			Reason: constants.Creating,
+		},
+		)
+		return g.StatusUpdate(ctx, instance)
+	}
+
+	if err := g.Client.Delete(ctx, &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      instance.Status.ServerConfigRef.Name,
+			Namespace: instance.Namespace,
+		},
+	}); client.IgnoreNotFound(err) != nil {
+		return g.Failed(fmt.Errorf("could not delete server config: %w", err))
+	}
+	instance.Status.ServerConfigRef = nil
+	g.Recorder.Event(instance, v1.EventTypeNormal, "CTLogOptionsChanged", "CTLog settings changed, server config will be recreated")
+	return g.StatusUpdate(ctx, instance)
+}
+
+// upToDate reports whether the server config matches the settings and the Trillian Logserver of the instance.
+// Configs created before the names were scoped by the instance point to the Logserver shared by the namespace.
+func (g handleLogOptions) upToDate(instance *v1alpha1.CTlog) bool {
+	secret, err := k8sutils.GetSecret(g.Client, instance.Namespace, instance.Status.ServerConfigRef.Name)
+	if err != nil {
+		return false
+	}
+	options, err := utils.ParseLogOptions(secret.Data[utils.ConfigKey])
+	if err != nil || !options.Equal(utils.LogOptionsFor(instance.Spec)) {
+		return false
+	}
+	backend, err := utils.ParseBackend(secret.Data[utils.ConfigKey])
+	return err == nil && backend == trillianAddress(instance)
+}
+
+// trillianAddress returns the address of the Trillian Logserver as it is written to the server config.
+func trillianAddress(instance *v1alpha1.CTlog) string {
+	return fmt.Sprintf("%s.%s.svc.cluster.local:8091", constants.InstanceName(trillian.LogserverDeploymentName, common.TrillianInstance(instance.Spec.Trillian, instance.Name)), instance.Namespace)
+}
+
+// logUrl returns the internal URL of the log, the path is the prefix of the log endpoints.
+func logUrl(instance *v1alpha1.CTlog) string {
+	return fmt.Sprintf("http://%s.%s.svc/%s", constants.InstanceName(ComponentName, instance.Name), instance.Namespace, utils.Prefix(instance.Spec))
+}
@@ -0,0 +1,26 @@
+package actions
+
+import (
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// LegacyNames lists the objects created before the names were scoped by the instance.
+// They are created again under the new names.
+func LegacyNames(_ *rhtasv1alpha1.CTlog) []common.LegacyName {
+	return []common.LegacyName{
+		{Object: &v1.ServiceAccount{}, Name: RBACName},
+		{Object: &rbacv1.Role{}, Name: RBACName},
+		{Object: &rbacv1.RoleBinding{}, Name: RBACName},
+
+		{Object: &appsv1.Deployment{}, Name: DeploymentName},
+		{Object: &v1.Service{}, Name: ComponentName},
+		{Object: &rbacv1.Role{}, Name: MonitoringRoleName},
+		{Object: &rbacv1.RoleBinding{}, Name: MonitoringRoleName},
+		{Object: &monitoringv1.ServiceMonitor{}, Name: DeploymentName},
+	}
+}
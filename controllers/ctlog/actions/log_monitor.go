@@ -0,0 +1,70 @@
+package actions
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/action"
+	"github.com/securesign/operator/controllers/common/logmonitor"
+	k8sutils "github.com/securesign/operator/controllers/common/utils/kubernetes"
+	"github.com/securesign/operator/controllers/constants"
+	"github.com/securesign/operator/controllers/ctlog/utils"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NewLogMonitorAction verifies the log in background on the runner of the reconciler, its result is picked up by later reconcile
+func NewLogMonitorAction(runner *logmonitor.Runner) action.Action[rhtasv1alpha1.CTlog] {
+	return &logMonitorAction{runner: runner}
+}
+
+type logMonitorAction struct {
+	action.BaseAction
+	runner *logmonitor.Runner
+}
+
+func (i logMonitorAction) Name() string {
+	return "log monitor"
+}
+
+func (i logMonitorAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.CTlog) bool {
+	return meta.IsStatusConditionTrue(instance.Status.Conditions, constants.Ready) && instance.Spec.LogMonitor.Enabled &&
+		instance.Status.TreeID != nil && instance.Status.PublicKeyRef != nil && instance.Status.Url != ""
+}
+
+func (i logMonitorAction) Handle(ctx context.Context, instance *rhtasv1alpha1.CTlog) *action.Result {
+	interval := logmonitor.Interval(instance.Spec.LogMonitor)
+	if instance.Status.LogMonitor != nil && instance.Status.LogMonitor.LastCheckTime != nil {
+		if next := time.Until(instance.Status.LogMonitor.LastCheckTime.Add(interval)); next > 0 {
+			return &action.Result{Result: reconcile.Result{RequeueAfter: next}}
+		}
+	}
+
+	publicKey, err := k8sutils.GetSecretData(i.Client, instance.Namespace, instance.Status.PublicKeyRef)
+	if err != nil {
+		return i.Failed(err)
+	}
+	log, err := utils.NewLog(&http.Client{}, instance.Status.Url, publicKey)
+	if err != nil {
+		return i.Failed(err)
+	}
+	previous := logmonitor.Previous(instance.Status.LogMonitor, instance.Status.TreeID)
+
+	checkpoint, done, err := i.runner.Run(ctx, client.ObjectKeyFromObject(instance), func(ctx context.Context) (*logmonitor.Checkpoint, error) {
+		return logmonitor.Verify(ctx, log, previous)
+	})
+	if !done {
+		return i.Requeue()
+	}
+	if err != nil {
+		i.Logger.Error(err, "log verification failed")
+	}
+
+	instance.Status.LogMonitor = logmonitor.Report(i.Recorder, "CTlog", instance, &instance.Status.Conditions,
+		instance.Status.LogMonitor, instance.Status.TreeID, checkpoint, err)
+	// status update triggers next reconcile which schedules next check
+	return i.StatusUpdate(ctx, instance)
+}
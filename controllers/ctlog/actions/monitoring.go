@@ -41,7 +41,7 @@ func (i monitoringAction) Handle(ctx context.Context, instance *rhtasv1alpha1.CT
 
 	role := kubernetes.CreateRole(
 		instance.Namespace,
-		MonitoringRoleName,
+		constants.InstanceName(MonitoringRoleName, instance.Name),
 		monitoringLabels,
 		[]v1.PolicyRule{
 			{
@@ -74,12 +74,12 @@ func (i monitoringAction) Handle(ctx context.Context, instance *rhtasv1alpha1.CT
 
 	roleBinding := kubernetes.CreateRoleBinding(
 		instance.Namespace,
-		MonitoringRoleName,
+		constants.InstanceName(MonitoringRoleName, instance.Name),
 		monitoringLabels,
 		v1.RoleRef{
 			APIGroup: v1.SchemeGroupVersion.Group,
 			Kind:     "Role",
-			Name:     MonitoringRoleName,
+			Name:     constants.InstanceName(MonitoringRoleName, instance.Name),
 		},
 		[]v1.Subject{
 			{Kind: "ServiceAccount", Name: "prometheus-k8s", Namespace: "openshift-monitoring"},
@@ -107,7 +107,7 @@ func (i monitoringAction) Handle(ctx context.Context, instance *rhtasv1alpha1.CT
 
 	serviceMonitor := kubernetes.CreateServiceMonitor(
 		instance.Namespace,
-		DeploymentName,
+		constants.InstanceName(DeploymentName, instance.Name),
 		monitoringLabels,
 		[]monitoringv1.Endpoint{
 			{
@@ -29,7 +29,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
+	"github.com/securesign/operator/controllers/common"
 	"github.com/securesign/operator/controllers/common/action"
+	k8sutils "github.com/securesign/operator/controllers/common/utils/kubernetes"
 	v1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -39,6 +41,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/logmonitor"
 )
 
 // CTlogReconciler reconciles a CTlog object
@@ -46,6 +49,8 @@ type CTlogReconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
+	// LogMonitor runs the log verifications of the instances, a new runner is created when unset
+	LogMonitor *logmonitor.Runner
 }
 
 //+kubebuilder:rbac:groups=rhtas.redhat.com,resources=ctlogs,verbs=get;list;watch;create;update;patch;delete
@@ -79,11 +84,14 @@ func (r *CTlogReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 	}
 	target := instance.DeepCopy()
 	acs := []action.Action[rhtasv1alpha1.CTlog]{
+		common.NewMigrateNamesAction[rhtasv1alpha1.CTlog](actions.LegacyNames),
+
 		actions.NewPendingAction(),
 
 		actions.NewHandleFulcioCertAction(),
 		actions.NewHandleKeysAction(),
 		actions.NewCreateTrillianTreeAction(),
+		actions.NewHandleLogOptionsAction(),
 		actions.NewServerConfigAction(),
 
 		actions.NewRBACAction(),
@@ -94,6 +102,8 @@ func (r *CTlogReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 		actions.NewToInitializeAction(),
 
 		actions.NewInitializeAction(),
+
+		actions.NewLogMonitorAction(r.LogMonitor),
 	}
 
 	for _, a := range acs {
@@ -115,6 +125,9 @@ func (r *CTlogReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *CTlogReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.LogMonitor == nil {
+		r.LogMonitor = logmonitor.NewRunner(logmonitor.DefaultTimeout)
+	}
 	secretPredicate, err := predicate.LabelSelectorPredicate(metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
 		{
 			Key:      actions2.FulcioCALabel,
@@ -151,5 +164,7 @@ func (r *CTlogReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			return requests
 
 		}), builder.WithPredicates(secretPredicate)).
+		// the Fulcio certificate is published in the status of the Fulcio managed by the same Securesign
+		Watches(&rhtasv1alpha1.Fulcio{}, k8sutils.EnqueueSibling()).
 		Complete(r)
 }
@@ -94,7 +94,8 @@ var _ = Describe("CTlog controller", func() {
 					},
 
 					Spec: v1alpha1.CTlogSpec{
-						TreeID: &ptr,
+						TreeID:       &ptr,
+						KeyDiscovery: v1alpha1.KeyDiscoveryLabels,
 					},
 				}
 				err = k8sClient.Create(ctx, instance)
@@ -123,7 +124,7 @@ var _ = Describe("CTlog controller", func() {
 			}, time.Minute, time.Second).Should(Equal(constants.Pending))
 
 			By("Creating trillian service")
-			Expect(k8sClient.Create(ctx, kubernetes.CreateService(Namespace, trillian.LogserverDeploymentName, 8091, constants.LabelsForComponent(trillian.LogServerComponentName, instance.Name)))).To(Succeed())
+			Expect(k8sClient.Create(ctx, kubernetes.CreateService(Namespace, constants.InstanceName(trillian.LogserverDeploymentName, instance.Name), 8091, constants.LabelsForComponent(trillian.LogServerComponentName, instance.Name)))).To(Succeed())
 			Eventually(func() string {
 				found := &v1alpha1.CTlog{}
 				Expect(k8sClient.Get(ctx, typeNamespaceName, found)).Should(Succeed())
@@ -155,13 +156,13 @@ var _ = Describe("CTlog controller", func() {
 			deployment := &appsv1.Deployment{}
 			By("Checking if Deployment was successfully created in the reconciliation")
 			Eventually(func() error {
-				return k8sClient.Get(ctx, types.NamespacedName{Name: actions.DeploymentName, Namespace: Namespace}, deployment)
+				return k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.DeploymentName, Name), Namespace: Namespace}, deployment)
 			}, time.Minute, time.Second).Should(Succeed())
 
 			By("Checking if Service was successfully created in the reconciliation")
 			service := &corev1.Service{}
 			Eventually(func() error {
-				return k8sClient.Get(ctx, types.NamespacedName{Name: actions.ComponentName, Namespace: Namespace}, service)
+				return k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.ComponentName, Name), Namespace: Namespace}, service)
 			}, time.Minute, time.Second).Should(Succeed())
 			Expect(service.Spec.Ports[0].Port).Should(Equal(int32(6963)))
 			Expect(service.Spec.Ports[1].Port).Should(Equal(int32(80)))
@@ -182,14 +183,14 @@ var _ = Describe("CTlog controller", func() {
 			By("Checking if controller will return deployment to desired state")
 			deployment = &appsv1.Deployment{}
 			Eventually(func() error {
-				return k8sClient.Get(ctx, types.NamespacedName{Name: actions.DeploymentName, Namespace: Namespace}, deployment)
+				return k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.DeploymentName, Name), Namespace: Namespace}, deployment)
 			}, time.Minute, time.Second).Should(Succeed())
 			replicas := int32(99)
 			deployment.Spec.Replicas = &replicas
 			Expect(k8sClient.Status().Update(ctx, deployment)).Should(Succeed())
 			Eventually(func() int32 {
 				deployment = &appsv1.Deployment{}
-				Expect(k8sClient.Get(ctx, types.NamespacedName{Name: actions.DeploymentName, Namespace: Namespace}, deployment)).Should(Succeed())
+				Expect(k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.DeploymentName, Name), Namespace: Namespace}, deployment)).Should(Succeed())
 				return *deployment.Spec.Replicas
 			}, time.Minute, time.Second).Should(Equal(int32(1)))
 		})
@@ -96,7 +96,8 @@ var _ = Describe("CTlog update test", func() {
 					},
 
 					Spec: v1alpha1.CTlogSpec{
-						TreeID: &ptr,
+						TreeID:       &ptr,
+						KeyDiscovery: v1alpha1.KeyDiscoveryLabels,
 					},
 				}
 				err = k8sClient.Create(ctx, instance)
@@ -111,7 +112,7 @@ var _ = Describe("CTlog update test", func() {
 			}, time.Minute, time.Second).Should(Succeed())
 
 			By("Creating trillian service")
-			Expect(k8sClient.Create(ctx, kubernetes.CreateService(Namespace, trillian.LogserverDeploymentName, 8091, constants.LabelsForComponent(trillian.LogServerComponentName, instance.Name)))).To(Succeed())
+			Expect(k8sClient.Create(ctx, kubernetes.CreateService(Namespace, constants.InstanceName(trillian.LogserverDeploymentName, instance.Name), 8091, constants.LabelsForComponent(trillian.LogServerComponentName, instance.Name)))).To(Succeed())
 
 			By("Creating fulcio root cert")
 			fulcioCa := kubernetes.CreateSecret("test", Namespace,
@@ -123,7 +124,7 @@ var _ = Describe("CTlog update test", func() {
 			deployment := &appsv1.Deployment{}
 			By("Checking if Deployment was successfully created in the reconciliation")
 			Eventually(func() error {
-				return k8sClient.Get(ctx, types.NamespacedName{Name: actions.DeploymentName, Namespace: Namespace}, deployment)
+				return k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.DeploymentName, Name), Namespace: Namespace}, deployment)
 			}, time.Minute, time.Second).Should(Succeed())
 
 			By("Move to Ready phase")
@@ -159,7 +160,7 @@ var _ = Describe("CTlog update test", func() {
 			By("CTL deployment is updated")
 			Eventually(func() bool {
 				updated := &appsv1.Deployment{}
-				k8sClient.Get(ctx, types.NamespacedName{Name: actions.DeploymentName, Namespace: Namespace}, updated)
+				k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.DeploymentName, Name), Namespace: Namespace}, updated)
 				return equality.Semantic.DeepDerivative(deployment.Spec.Template.Spec.Volumes, updated.Spec.Template.Spec.Volumes)
 			}, time.Minute, time.Second).Should(BeFalse())
 
@@ -169,7 +170,7 @@ var _ = Describe("CTlog update test", func() {
 			Expect(k8sClient.Create(ctx, kubernetes.CreateSecret("key-secret", Namespace,
 				map[string][]byte{"private": key.PrivateKey}, constants.LabelsFor(actions.ComponentName, Name, instance.Name)))).To(Succeed())
 
-			k8sClient.Get(ctx, types.NamespacedName{Name: actions.DeploymentName, Namespace: Namespace}, deployment)
+			k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.DeploymentName, Name), Namespace: Namespace}, deployment)
 			found := &v1alpha1.CTlog{}
 			Expect(k8sClient.Get(ctx, typeNamespaceName, found)).Should(Succeed())
 			found.Spec.PrivateKeyRef = &v1alpha1.SecretKeySelector{
@@ -190,7 +191,7 @@ var _ = Describe("CTlog update test", func() {
 			By("CTL deployment is updated")
 			Eventually(func() bool {
 				updated := &appsv1.Deployment{}
-				k8sClient.Get(ctx, types.NamespacedName{Name: actions.DeploymentName, Namespace: Namespace}, updated)
+				k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.DeploymentName, Name), Namespace: Namespace}, updated)
 				return equality.Semantic.DeepDerivative(deployment.Spec.Template.Spec.Volumes, updated.Spec.Template.Spec.Volumes)
 			}, 3*time.Minute, time.Second).Should(BeFalse())
 		})
@@ -14,6 +14,7 @@ import (
 	"google.golang.org/protobuf/encoding/prototext"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // reference code https://github.com/sigstore/scaffolding/blob/main/cmd/ctlog/createctconfig/main.go
@@ -50,7 +51,8 @@ type Config struct {
 	PrivKeyPassword []byte
 	PubKey          []byte
 	LogID           int64
-	LogPrefix       string
+
+	LogOptions
 
 	// Address of the gRPC Trillian Admin Server (host:port)
 	TrillianServerAddr string
@@ -102,14 +104,22 @@ func (c *Config) MarshalConfig() ([]byte, error) {
 
 	proto := configpb.LogConfig{
 		LogId:        c.LogID,
-		Prefix:       c.LogPrefix,
+		Prefix:       c.Prefix,
 		RootsPemFile: rootPems,
 		PrivateKey: mustMarshalAny(&keyspb.PEMKeyFile{
 			Path:     privateKeyFile,
 			Password: string(c.PrivKeyPassword)}),
 		PublicKey:      &keyspb.PublicKey{Der: block.Bytes},
 		LogBackendName: "trillian",
-		ExtKeyUsages:   []string{"CodeSigning"},
+		ExtKeyUsages:   c.ExtKeyUsages,
+		RejectExpired:  c.RejectExpired,
+		AcceptOnlyCa:   c.AcceptOnlyCA,
+	}
+	if c.NotAfterStart != nil {
+		proto.NotAfterStart = timestamppb.New(*c.NotAfterStart)
+	}
+	if c.NotAfterLimit != nil {
+		proto.NotAfterLimit = timestamppb.New(*c.NotAfterLimit)
 	}
 
 	multiConfig := configpb.LogMultiConfig{
@@ -168,13 +178,13 @@ func createConfigWithKeys(certConfig *PrivateKeyConfig) (*Config, error) {
 	return config, nil
 }
 
-func CreateCtlogConfig(trillianUrl string, treeID int64, rootCerts []RootCertificate, keyConfig *PrivateKeyConfig) (map[string][]byte, error) {
+func CreateCtlogConfig(trillianUrl string, treeID int64, rootCerts []RootCertificate, keyConfig *PrivateKeyConfig, options LogOptions) (map[string][]byte, error) {
 	ctlogConfig, err := createConfigWithKeys(keyConfig)
 	if err != nil {
 		return nil, err
 	}
 	ctlogConfig.LogID = treeID
-	ctlogConfig.LogPrefix = "trusted-artifact-signer"
+	ctlogConfig.LogOptions = options
 	ctlogConfig.TrillianServerAddr = trillianUrl
 
 	for _, cert := range rootCerts {
@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common"
 	"github.com/securesign/operator/controllers/constants"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -104,3 +105,10 @@ func CreateDeployment(instance *v1alpha1.CTlog, deploymentName string, sa string
 		},
 	}, nil
 }
+
+// UseTrillianTLS configures the CTlog server to verify the Trillian gRPC endpoint by the CA certificate from the ConfigMap.
+func UseTrillianTLS(dp *appsv1.Deployment, ca *corev1.ConfigMap) {
+	container := &dp.Spec.Template.Spec.Containers[0]
+	path := common.MountTrillianCA(&dp.Spec.Template.Spec, container, ca)
+	container.Args = append(container.Args, "--trillian_tls_ca_cert_file="+path)
+}
@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	ct "github.com/google/certificate-transparency-go"
+	"github.com/google/certificate-transparency-go/client"
+	"github.com/google/certificate-transparency-go/jsonclient"
+	"github.com/securesign/operator/controllers/common/logmonitor"
+)
+
+// Log provides access to the signed tree heads and consistency proofs of the CT log.
+type Log struct {
+	client   *client.LogClient
+	verifier *ct.SignatureVerifier
+}
+
+func NewLog(httpClient *http.Client, url string, publicKey []byte) (*Log, error) {
+	lc, err := client.New(url, httpClient, jsonclient.Options{})
+	if err != nil {
+		return nil, err
+	}
+	pub, _, _, err := ct.PublicKeyFromPEM(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse public key: %w", err)
+	}
+	verifier, err := ct.NewSignatureVerifier(pub)
+	if err != nil {
+		return nil, err
+	}
+	return &Log{client: lc, verifier: verifier}, nil
+}
+
+func (l *Log) Checkpoint(ctx context.Context) (*logmonitor.Checkpoint, error) {
+	sth, err := l.client.GetSTH(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get signed tree head: %w", err)
+	}
+	if err = l.verifier.VerifySTHSignature(*sth); err != nil {
+		return nil, fmt.Errorf("%w: %w", logmonitor.ErrKeyMismatch, err)
+	}
+	return &logmonitor.Checkpoint{Size: sth.TreeSize, RootHash: sth.SHA256RootHash[:]}, nil
+}
+
+func (l *Log) ConsistencyProof(ctx context.Context, first, second uint64) ([][]byte, error) {
+	return l.client.GetSTHConsistency(ctx, first, second)
+}
@@ -0,0 +1,112 @@
+package utils
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/certificate-transparency-go/trillian/ctfe/configpb"
+	"github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/constants"
+	"google.golang.org/protobuf/encoding/prototext"
+)
+
+// defaultExtKeyUsages are accepted when the CTlog does not set any, Fulcio issues code signing certificates
+var defaultExtKeyUsages = []string{"CodeSigning"}
+
+// LogOptions are the settings of the log configured on the CTlog resource.
+type LogOptions struct {
+	Prefix        string
+	ExtKeyUsages  []string
+	RejectExpired bool
+	AcceptOnlyCA  bool
+	NotAfterStart *time.Time
+	NotAfterLimit *time.Time
+}
+
+// LogOptionsFor returns the settings of the log, the defaults are used for the fields which are not set.
+func LogOptionsFor(spec v1alpha1.CTlogSpec) LogOptions {
+	options := LogOptions{
+		Prefix:        Prefix(spec),
+		ExtKeyUsages:  slices.Clone(spec.ExtKeyUsages),
+		RejectExpired: spec.RejectExpired,
+		AcceptOnlyCA:  spec.AcceptOnlyCA,
+	}
+	if len(options.ExtKeyUsages) == 0 {
+		options.ExtKeyUsages = slices.Clone(defaultExtKeyUsages)
+	}
+	if spec.NotAfterStart != nil {
+		t := spec.NotAfterStart.UTC()
+		options.NotAfterStart = &t
+	}
+	if spec.NotAfterLimit != nil {
+		t := spec.NotAfterLimit.UTC()
+		options.NotAfterLimit = &t
+	}
+	return options
+}
+
+// Prefix returns the prefix of the log endpoints.
+func Prefix(spec v1alpha1.CTlogSpec) string {
+	if spec.Prefix == "" {
+		return constants.DefaultCTlogPrefix
+	}
+	return spec.Prefix
+}
+
+// ParseLogOptions reads the settings of the log from the marshalled server configuration.
+func ParseLogOptions(config []byte) (LogOptions, error) {
+	multiConfig := &configpb.LogMultiConfig{}
+	if err := prototext.Unmarshal(config, multiConfig); err != nil {
+		return LogOptions{}, fmt.Errorf("could not parse ctlog config: %w", err)
+	}
+	logs := multiConfig.GetLogConfigs().GetConfig()
+	if len(logs) != 1 {
+		return LogOptions{}, fmt.Errorf("unexpected number of logs in ctlog config: %d", len(logs))
+	}
+	log := logs[0]
+	options := LogOptions{
+		Prefix:        log.Prefix,
+		ExtKeyUsages:  log.ExtKeyUsages,
+		RejectExpired: log.RejectExpired,
+		AcceptOnlyCA:  log.AcceptOnlyCa,
+	}
+	if log.NotAfterStart != nil {
+		t := log.NotAfterStart.AsTime()
+		options.NotAfterStart = &t
+	}
+	if log.NotAfterLimit != nil {
+		t := log.NotAfterLimit.AsTime()
+		options.NotAfterLimit = &t
+	}
+	return options, nil
+}
+
+// ParseBackend reads the address of the Trillian Logserver from the marshalled server configuration.
+func ParseBackend(config []byte) (string, error) {
+	multiConfig := &configpb.LogMultiConfig{}
+	if err := prototext.Unmarshal(config, multiConfig); err != nil {
+		return "", fmt.Errorf("could not parse ctlog config: %w", err)
+	}
+	backends := multiConfig.GetBackends().GetBackend()
+	if len(backends) != 1 {
+		return "", fmt.Errorf("unexpected number of backends in ctlog config: %d", len(backends))
+	}
+	return backends[0].BackendSpec, nil
+}
+
+// Equal reports whether the settings are the same.
+func (o LogOptions) Equal(other LogOptions) bool {
+	timeEqual := func(a, b *time.Time) bool {
+		if a == nil || b == nil {
+			return a == b
+		}
+		return a.Equal(*b)
+	}
+	return o.Prefix == other.Prefix &&
+		slices.Equal(o.ExtKeyUsages, other.ExtKeyUsages) &&
+		o.RejectExpired == other.RejectExpired &&
+		o.AcceptOnlyCA == other.AcceptOnlyCA &&
+		timeEqual(o.NotAfterStart, other.NotAfterStart) &&
+		timeEqual(o.NotAfterLimit, other.NotAfterLimit)
+}
@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestLogOptionsDefaults(t *testing.T) {
+	g := NewWithT(t)
+
+	options := LogOptionsFor(v1alpha1.CTlogSpec{})
+	g.Expect(options.Prefix).Should(Equal("trusted-artifact-signer"))
+	g.Expect(options.ExtKeyUsages).Should(Equal([]string{"CodeSigning"}))
+	g.Expect(options.RejectExpired).Should(BeFalse())
+	g.Expect(options.AcceptOnlyCA).Should(BeFalse())
+	g.Expect(options.NotAfterStart).Should(BeNil())
+	g.Expect(options.NotAfterLimit).Should(BeNil())
+}
+
+func TestCtlogConfigOptions(t *testing.T) {
+	g := NewWithT(t)
+
+	keys, err := CreatePrivateKey()
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	limit := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	options := LogOptionsFor(v1alpha1.CTlogSpec{
+		Prefix:        "shard-2024",
+		ExtKeyUsages:  []string{"CodeSigning", "TimeStamping"},
+		RejectExpired: true,
+		AcceptOnlyCA:  true,
+		NotAfterStart: &metav1.Time{Time: start},
+		NotAfterLimit: &metav1.Time{Time: limit},
+	})
+
+	data, err := CreateCtlogConfig("trillian-logserver:8091", 1, []RootCertificate{[]byte("root")}, keys, options)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	parsed, err := ParseLogOptions(data[ConfigKey])
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(parsed.Prefix).Should(Equal("shard-2024"))
+	g.Expect(parsed.ExtKeyUsages).Should(Equal([]string{"CodeSigning", "TimeStamping"}))
+	g.Expect(parsed.RejectExpired).Should(BeTrue())
+	g.Expect(parsed.AcceptOnlyCA).Should(BeTrue())
+	g.Expect(parsed.NotAfterStart.Equal(start)).Should(BeTrue())
+	g.Expect(parsed.NotAfterLimit.Equal(limit)).Should(BeTrue())
+	g.Expect(parsed.Equal(options)).Should(BeTrue())
+
+	backend, err := ParseBackend(data[ConfigKey])
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(backend).Should(Equal("trillian-logserver:8091"))
+
+	g.Expect(parsed.Equal(LogOptionsFor(v1alpha1.CTlogSpec{Prefix: "shard-2024"}))).Should(BeFalse())
+}
+
+func TestParseLogOptionsInvalid(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := ParseLogOptions([]byte("invalid"))
+	g.Expect(err).Should(HaveOccurred())
+	_, err = ParseLogOptions([]byte(""))
+	g.Expect(err).Should(HaveOccurred())
+}
@@ -37,7 +37,7 @@ func (i deployAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Fulcio
 	)
 
 	labels := constants.LabelsFor(ComponentName, DeploymentName, instance.Name)
-	dp, err := futils.CreateDeployment(instance, DeploymentName, RBACName, labels)
+	dp, err := futils.CreateDeployment(instance, constants.InstanceName(DeploymentName, instance.Name), constants.InstanceName(RBACName, instance.Name), labels)
 	if err != nil {
 		if err != nil {
 			meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
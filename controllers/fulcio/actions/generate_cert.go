@@ -208,7 +208,7 @@ func (g handleCert) setupCert(ctx context.Context, instance *v1alpha1.Fulcio) (*
 		}
 		config.RootCert = key
 	} else {
-		rootCert, err := utils.CreateFulcioCA(ctx, g.Client, config, instance, DeploymentName)
+		rootCert, err := utils.CreateFulcioCA(ctx, g.Client, config, instance, constants.InstanceName(DeploymentName, instance.Name))
 		if err != nil {
 			return nil, err
 		}
@@ -56,7 +56,7 @@ func (i initializeAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Fu
 	if instance.Spec.ExternalAccess.Enabled {
 		protocol := "http://"
 		ingress := &v12.Ingress{}
-		err = i.Client.Get(ctx, types.NamespacedName{Name: DeploymentName, Namespace: instance.Namespace}, ingress)
+		err = i.Client.Get(ctx, types.NamespacedName{Name: constants.InstanceName(DeploymentName, instance.Name), Namespace: instance.Namespace}, ingress)
 		if err != nil {
 			return i.Failed(err)
 		}
@@ -65,7 +65,7 @@ func (i initializeAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Fu
 		}
 		instance.Status.Url = protocol + ingress.Spec.Rules[0].Host
 	} else {
-		instance.Status.Url = fmt.Sprintf("http://%s.%s.svc", DeploymentName, instance.Namespace)
+		instance.Status.Url = fmt.Sprintf("http://%s.%s.svc", constants.InstanceName(DeploymentName, instance.Name), instance.Namespace)
 	}
 
 	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{Type: constants.Ready,
@@ -0,0 +1,29 @@
+package actions
+
+import (
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common"
+	"github.com/securesign/operator/controllers/constants"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// LegacyNames lists the objects created before the names were scoped by the instance.
+// The Ingress keeps its host, the other objects are created again under the new names.
+func LegacyNames(instance *rhtasv1alpha1.Fulcio) []common.LegacyName {
+	return []common.LegacyName{
+		{Object: &v1.ServiceAccount{}, Name: RBACName},
+		{Object: &rbacv1.Role{}, Name: RBACName},
+		{Object: &rbacv1.RoleBinding{}, Name: RBACName},
+
+		{Object: &appsv1.Deployment{}, Name: DeploymentName},
+		{Object: &v1.Service{}, Name: DeploymentName},
+		{Object: &networkingv1.Ingress{}, Name: DeploymentName, Rename: constants.InstanceName(DeploymentName, instance.Name), DeleteFirst: true},
+		{Object: &rbacv1.Role{}, Name: MonitoringRoleName},
+		{Object: &rbacv1.RoleBinding{}, Name: MonitoringRoleName},
+		{Object: &monitoringv1.ServiceMonitor{}, Name: DeploymentName},
+	}
+}
@@ -40,7 +40,7 @@ func (i rbacAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Fulcio)
 
 	sa := &v1.ServiceAccount{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      RBACName,
+			Name:      constants.InstanceName(RBACName, instance.Name),
 			Namespace: instance.Namespace,
 			Labels:    labels,
 		},
@@ -65,7 +65,7 @@ func (i rbacAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Fulcio)
 		})
 		return i.FailedWithStatusUpdate(ctx, fmt.Errorf("could not create SA: %w", err), instance)
 	}
-	role := kubernetes.CreateRole(instance.Namespace, RBACName, labels, []rbacv1.PolicyRule{
+	role := kubernetes.CreateRole(instance.Namespace, constants.InstanceName(RBACName, instance.Name), labels, []rbacv1.PolicyRule{
 		{
 			APIGroups: []string{""},
 			Resources: []string{"configmaps"},
@@ -91,13 +91,13 @@ func (i rbacAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Fulcio)
 		})
 		return i.FailedWithStatusUpdate(ctx, fmt.Errorf("could not create Role: %w", err), instance)
 	}
-	rb := kubernetes.CreateRoleBinding(instance.Namespace, RBACName, labels, rbacv1.RoleRef{
+	rb := kubernetes.CreateRoleBinding(instance.Namespace, constants.InstanceName(RBACName, instance.Name), labels, rbacv1.RoleRef{
 		APIGroup: v1.SchemeGroupVersion.Group,
 		Kind:     "Role",
-		Name:     RBACName,
+		Name:     constants.InstanceName(RBACName, instance.Name),
 	},
 		[]rbacv1.Subject{
-			{Kind: "ServiceAccount", Name: RBACName, Namespace: instance.Namespace},
+			{Kind: "ServiceAccount", Name: constants.InstanceName(RBACName, instance.Name), Namespace: instance.Namespace},
 		})
 
 	if err = ctrl.SetControllerReference(instance, rb, i.Client.Scheme()); err != nil {
@@ -25,6 +25,7 @@ import (
 	v13 "k8s.io/api/networking/v1"
 	"k8s.io/client-go/tools/record"
 
+	"github.com/securesign/operator/controllers/common"
 	"github.com/securesign/operator/controllers/common/action"
 
 	v1 "k8s.io/api/apps/v1"
@@ -85,6 +86,8 @@ func (r *FulcioReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 
 	target := instance.DeepCopy()
 	acs := []action.Action[rhtasv1alpha1.Fulcio]{
+		common.NewMigrateNamesAction[rhtasv1alpha1.Fulcio](actions.LegacyNames),
+
 		actions.NewToPendingPhaseAction(),
 		actions.NewHandleCertAction(),
 		actions.NewRBACAction(),
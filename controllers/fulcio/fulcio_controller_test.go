@@ -185,7 +185,7 @@ var _ = Describe("Fulcio controller", func() {
 			deployment := &appsv1.Deployment{}
 			By("Checking if Deployment was successfully created in the reconciliation")
 			Eventually(func() error {
-				return k8sClient.Get(ctx, types.NamespacedName{Name: actions.DeploymentName, Namespace: Namespace}, deployment)
+				return k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.DeploymentName, Name), Namespace: Namespace}, deployment)
 			}, time.Minute, time.Second).Should(Succeed())
 
 			By("Move to Ready phase")
@@ -204,7 +204,7 @@ var _ = Describe("Fulcio controller", func() {
 			By("Checking if Service was successfully created in the reconciliation")
 			service := &corev1.Service{}
 			Eventually(func() error {
-				return k8sClient.Get(ctx, types.NamespacedName{Name: actions.DeploymentName, Namespace: Namespace}, service)
+				return k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.DeploymentName, Name), Namespace: Namespace}, service)
 			}, time.Minute, time.Second).Should(Succeed())
 			Expect(service.Spec.Ports[0].Port).Should(Equal(int32(2112)))
 			Expect(service.Spec.Ports[1].Port).Should(Equal(int32(5554)))
@@ -213,7 +213,7 @@ var _ = Describe("Fulcio controller", func() {
 			By("Checking if Ingress was successfully created in the reconciliation")
 			ingress := &v1.Ingress{}
 			Eventually(func() error {
-				return k8sClient.Get(ctx, types.NamespacedName{Name: actions.DeploymentName, Namespace: Namespace}, ingress)
+				return k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.DeploymentName, Name), Namespace: Namespace}, ingress)
 			}, time.Minute, time.Second).Should(Succeed())
 			Expect(ingress.Spec.Rules[0].Host).Should(Equal("fulcio.localhost"))
 			Expect(ingress.Spec.Rules[0].IngressRuleValue.HTTP.Paths[0].Backend.Service.Name).Should(Equal(service.Name))
@@ -222,14 +222,14 @@ var _ = Describe("Fulcio controller", func() {
 			By("Checking if controller will return deployment to desired state")
 			deployment = &appsv1.Deployment{}
 			Eventually(func() error {
-				return k8sClient.Get(ctx, types.NamespacedName{Name: actions.DeploymentName, Namespace: Namespace}, deployment)
+				return k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.DeploymentName, Name), Namespace: Namespace}, deployment)
 			}, time.Minute, time.Second).Should(Succeed())
 			replicas := int32(99)
 			deployment.Spec.Replicas = &replicas
 			Expect(k8sClient.Status().Update(ctx, deployment)).Should(Succeed())
 			Eventually(func() int32 {
 				deployment = &appsv1.Deployment{}
-				Expect(k8sClient.Get(ctx, types.NamespacedName{Name: actions.DeploymentName, Namespace: Namespace}, deployment)).Should(Succeed())
+				Expect(k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.DeploymentName, Name), Namespace: Namespace}, deployment)).Should(Succeed())
 				return *deployment.Spec.Replicas
 			}, time.Minute, time.Second).Should(Equal(int32(1)))
 		})
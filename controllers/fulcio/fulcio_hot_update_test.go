@@ -125,7 +125,7 @@ var _ = Describe("Fulcio hot update", func() {
 			deployment := &appsv1.Deployment{}
 			By("Checking if Deployment was successfully created in the reconciliation")
 			Eventually(func() error {
-				return k8sClient.Get(ctx, types.NamespacedName{Name: actions.DeploymentName, Namespace: Namespace}, deployment)
+				return k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.DeploymentName, Name), Namespace: Namespace}, deployment)
 			}, time.Minute, time.Second).Should(Succeed())
 
 			By("Move to Ready phase")
@@ -179,14 +179,14 @@ var _ = Describe("Fulcio hot update", func() {
 			By("Fulcio deployment is updated")
 			Eventually(func() bool {
 				updated := &appsv1.Deployment{}
-				k8sClient.Get(ctx, types.NamespacedName{Name: actions.DeploymentName, Namespace: Namespace}, updated)
+				k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.DeploymentName, Name), Namespace: Namespace}, updated)
 				return equality.Semantic.DeepDerivative(deployment.Spec.Template.Spec.Volumes, updated.Spec.Template.Spec.Volumes)
 			}, time.Minute, time.Second).Should(BeFalse())
 
 			time.Sleep(10 * time.Second)
 
 			By("Config update")
-			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: actions.DeploymentName, Namespace: Namespace}, deployment)).To(Succeed())
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.DeploymentName, Name), Namespace: Namespace}, deployment)).To(Succeed())
 
 			By("Update OIDC")
 			Expect(k8sClient.Get(ctx, typeNamespaceName, found)).Should(Succeed())
@@ -201,7 +201,7 @@ var _ = Describe("Fulcio hot update", func() {
 			By("Fulcio deployment is updated")
 			Eventually(func() bool {
 				updated := &appsv1.Deployment{}
-				k8sClient.Get(ctx, types.NamespacedName{Name: actions.DeploymentName, Namespace: Namespace}, updated)
+				k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.DeploymentName, Name), Namespace: Namespace}, updated)
 				return equality.Semantic.DeepDerivative(deployment.Spec.Template.Spec.Volumes, updated.Spec.Template.Spec.Volumes)
 			}, time.Minute, time.Second).Should(BeFalse())
 		})
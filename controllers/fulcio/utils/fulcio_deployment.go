@@ -37,7 +37,7 @@ func CreateDeployment(instance *v1alpha1.Fulcio, deploymentName string, sa strin
 		"/var/run/fulcio-secrets/key.pem",
 		"--fileca-cert",
 		"/var/run/fulcio-secrets/cert.pem",
-		fmt.Sprintf("--ct-log-url=http://ctlog.%s.svc/trusted-artifact-signer", instance.Namespace)}
+		"--ct-log-url=" + ctlogUrl(instance)}
 
 	env := make([]corev1.EnvVar, 0)
 	env = append(env, corev1.EnvVar{
@@ -230,3 +230,20 @@ func CreateDeployment(instance *v1alpha1.Fulcio, deploymentName string, sa strin
 		},
 	}, nil
 }
+
+// ctlogUrl returns the URL of the CTlog the certificates are submitted to.
+func ctlogUrl(instance *v1alpha1.Fulcio) string {
+	address := instance.Spec.Ctlog.Address
+	if address == "" {
+		// the CTlog of the same Securesign shares the instance name
+		address = fmt.Sprintf("%s.%s.svc", constants.InstanceName("ctlog", instance.Name), instance.Namespace)
+	}
+	if instance.Spec.Ctlog.Port != nil {
+		address = fmt.Sprintf("%s:%d", address, *instance.Spec.Ctlog.Port)
+	}
+	prefix := instance.Spec.Ctlog.Prefix
+	if prefix == "" {
+		prefix = constants.DefaultCTlogPrefix
+	}
+	return fmt.Sprintf("http://%s/%s", address, prefix)
+}
@@ -4,6 +4,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gstruct"
 	"github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/utils"
 	"github.com/securesign/operator/controllers/constants"
 	v12 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -83,6 +84,27 @@ func TestTrustedCA(t *testing.T) {
 	g.Expect(oidcVolume.VolumeSource.Projected.Sources[1].ConfigMap.Name).Should(Equal("trusted"))
 }
 
+func TestCtlogUrl(t *testing.T) {
+	g := NewWithT(t)
+
+	instance := createInstance()
+	labels := constants.LabelsFor(componentName, deploymentName, instance.Name)
+	deployment, err := CreateDeployment(instance, deploymentName, rbacName, labels)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(deployment.Spec.Template.Spec.Containers[0].Args).Should(ContainElement("--ct-log-url=http://ctlog-name.default.svc/trusted-artifact-signer"))
+
+	instance.Spec.Ctlog.Prefix = "shard-2024"
+	deployment, err = CreateDeployment(instance, deploymentName, rbacName, labels)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(deployment.Spec.Template.Spec.Containers[0].Args).Should(ContainElement("--ct-log-url=http://ctlog-name.default.svc/shard-2024"))
+
+	instance.Spec.Ctlog.Address = "ctlog.example.com"
+	instance.Spec.Ctlog.Port = utils.Pointer(int32(6962))
+	deployment, err = CreateDeployment(instance, deploymentName, rbacName, labels)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(deployment.Spec.Template.Spec.Containers[0].Args).Should(ContainElement("--ct-log-url=http://ctlog.example.com:6962/shard-2024"))
+}
+
 func TestMissingPrivateKey(t *testing.T) {
 	g := NewWithT(t)
 
@@ -50,7 +50,7 @@ func (i backfillRedisCronJob) Handle(ctx context.Context, instance *rhtasv1alpha
 	labels := constants.LabelsFor(actions.BackfillRedisCronJobName, actions.BackfillRedisCronJobName, instance.Name)
 	backfillRedisCronJob := &batchv1.CronJob{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      actions.BackfillRedisCronJobName,
+			Name:      constants.InstanceName(actions.BackfillRedisCronJobName, instance.Name),
 			Namespace: instance.Namespace,
 			Labels:    labels,
 		},
@@ -60,7 +60,7 @@ func (i backfillRedisCronJob) Handle(ctx context.Context, instance *rhtasv1alpha
 				Spec: batchv1.JobSpec{
 					Template: corev1.PodTemplateSpec{
 						Spec: corev1.PodSpec{
-							ServiceAccountName: actions.RBACName,
+							ServiceAccountName: constants.InstanceName(actions.RBACName, instance.Name),
 							RestartPolicy:      "OnFailure",
 							Containers: []corev1.Container{
 								{
@@ -68,7 +68,10 @@ func (i backfillRedisCronJob) Handle(ctx context.Context, instance *rhtasv1alpha
 									Image:   constants.BackfillRedisImage,
 									Command: []string{"/bin/sh", "-c"},
 									Args: []string{
-										fmt.Sprintf(`endIndex=$(curl -sS http://%s/api/v1/log | sed -E 's/.*"treeSize":([0-9]+).*/\1/'); endIndex=$((endIndex-1)); if [ $endIndex -lt 0 ]; then echo "info: no rekor entries found"; exit 0; fi; backfill-redis --hostname=rekor-redis --port=6379 --rekor-address=http://%s --start=0 --end=$endIndex`, actions.ServerComponentName, actions.ServerComponentName),
+										fmt.Sprintf(`endIndex=$(curl -sS http://%s/api/v1/log | sed -E 's/.*"treeSize":([0-9]+).*/\1/'); endIndex=$((endIndex-1)); if [ $endIndex -lt 0 ]; then echo "info: no rekor entries found"; exit 0; fi; backfill-redis --hostname=%s --port=6379 --rekor-address=http://%s --start=0 --end=$endIndex`,
+											constants.InstanceName(actions.ServerDeploymentName, instance.Name),
+											constants.InstanceName(actions.RedisDeploymentName, instance.Name),
+											constants.InstanceName(actions.ServerDeploymentName, instance.Name)),
 									},
 								},
 							},
@@ -4,6 +4,7 @@ const (
 	ServerDeploymentName     = "rekor-server"
 	RedisDeploymentName      = "rekor-redis"
 	SearchUiDeploymentName   = "rekor-search-ui"
+	SearchUiRBACName         = "rekor-search-ui"
 	RBACName                 = "rekor"
 	MonitoringRoleName       = "prometheus-k8s-rekor"
 	ServerComponentName      = "rekor-server"
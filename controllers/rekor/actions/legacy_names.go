@@ -0,0 +1,40 @@
+package actions
+
+import (
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common"
+	"github.com/securesign/operator/controllers/constants"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// LegacyNames lists the objects created before the names were scoped by the instance.
+// The Ingresses keep their hosts and the OAuth proxy keeps its sessions, the other objects are created again under the new names.
+func LegacyNames(instance *rhtasv1alpha1.Rekor) []common.LegacyName {
+	return []common.LegacyName{
+		{Object: &v1.ServiceAccount{}, Name: RBACName},
+		{Object: &rbacv1.Role{}, Name: RBACName},
+		{Object: &rbacv1.RoleBinding{}, Name: RBACName},
+
+		{Object: &appsv1.Deployment{}, Name: ServerDeploymentName},
+		{Object: &v1.Service{}, Name: ServerDeploymentName},
+		{Object: &networkingv1.Ingress{}, Name: ServerDeploymentName, Rename: constants.InstanceName(ServerDeploymentName, instance.Name), DeleteFirst: true},
+		{Object: &rbacv1.Role{}, Name: MonitoringRoleName},
+		{Object: &rbacv1.RoleBinding{}, Name: MonitoringRoleName},
+		{Object: &monitoringv1.ServiceMonitor{}, Name: ServerDeploymentName},
+
+		{Object: &appsv1.Deployment{}, Name: RedisDeploymentName},
+		{Object: &v1.Service{}, Name: RedisDeploymentName},
+		{Object: &batchv1.CronJob{}, Name: BackfillRedisCronJobName},
+
+		{Object: &v1.ServiceAccount{}, Name: SearchUiRBACName},
+		{Object: &appsv1.Deployment{}, Name: SearchUiDeploymentName},
+		{Object: &v1.Service{}, Name: SearchUiDeploymentName},
+		{Object: &networkingv1.Ingress{}, Name: SearchUiDeploymentName, Rename: constants.InstanceName(SearchUiDeploymentName, instance.Name), DeleteFirst: true},
+		{Object: &v1.Secret{}, Name: SearchUiDeploymentName + "-oauth", Rename: constants.InstanceName(SearchUiDeploymentName, instance.Name) + "-oauth"},
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common"
 	"github.com/securesign/operator/controllers/common/action"
 	utils "github.com/securesign/operator/controllers/common/utils/kubernetes"
 	"github.com/securesign/operator/controllers/constants"
@@ -31,7 +32,7 @@ func (i pendingAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.Reko
 
 func (i pendingAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Rekor) *action.Result {
 	var err error
-	_, err = utils.GetInternalUrl(ctx, i.Client, instance.Namespace, trillian.LogserverDeploymentName)
+	_, err = utils.GetInternalUrl(ctx, i.Client, instance.Namespace, constants.InstanceName(trillian.LogserverDeploymentName, common.TrillianInstance(instance.Spec.Trillian, instance.Name)))
 	if err != nil {
 		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
 			Type:    constants.Ready,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/securesign/operator/controllers/common"
 	"github.com/securesign/operator/controllers/common/action"
 	"github.com/securesign/operator/controllers/constants"
 	"github.com/securesign/operator/controllers/rekor/actions"
@@ -38,7 +39,7 @@ func (i deployAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Rekor)
 		updated bool
 	)
 	labels := constants.LabelsFor(actions.ServerComponentName, actions.ServerDeploymentName, instance.Name)
-	dp, err := utils.CreateRekorDeployment(instance, actions.ServerDeploymentName, actions.RBACName, labels)
+	dp, err := utils.CreateRekorDeployment(instance, constants.InstanceName(actions.ServerDeploymentName, instance.Name), constants.InstanceName(actions.RBACName, instance.Name), labels)
 	if err != nil {
 		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
 			Type:    actions.ServerCondition,
@@ -54,6 +55,13 @@ func (i deployAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Rekor)
 		})
 		return i.FailedWithStatusUpdate(ctx, fmt.Errorf("could create server Deployment: %w", err), instance)
 	}
+	ca, err := common.FindTrillianCA(ctx, i.Client, instance.Namespace, common.TrillianInstance(instance.Spec.Trillian, instance.Name))
+	if err != nil {
+		return i.Failed(fmt.Errorf("could not find trillian CA: %w", err))
+	}
+	if ca != nil {
+		utils.UseTrillianTLS(dp, ca)
+	}
 	if err = controllerutil.SetControllerReference(instance, dp, i.Client.Scheme()); err != nil {
 		return i.Failed(fmt.Errorf("could not set controller reference for Deployment: %w", err))
 	}
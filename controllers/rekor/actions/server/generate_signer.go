@@ -155,6 +155,16 @@ func (g generateSigner) Handle(ctx context.Context, instance *v1alpha1.Rekor) *a
 			},
 		}
 	}
+	// the public key of a provided signer key is resolved once the server runs
+	instance.Status.PublicKeyRef = nil
+	if _, ok := secret.Data["public"]; ok {
+		instance.Status.PublicKeyRef = &v1alpha1.SecretKeySelector{
+			Key: "public",
+			LocalObjectReference: v1alpha1.LocalObjectReference{
+				Name: secret.Name,
+			},
+		}
+	}
 	if _, ok := secret.Data["password"]; instance.Spec.Signer.PasswordRef == nil && ok {
 		instance.Status.Signer.PasswordRef = &v1alpha1.SecretKeySelector{
 			Key: "password",
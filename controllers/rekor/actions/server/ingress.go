@@ -9,10 +9,13 @@ import (
 	"github.com/securesign/operator/controllers/common/utils/kubernetes"
 	"github.com/securesign/operator/controllers/constants"
 	"github.com/securesign/operator/controllers/rekor/actions"
+	"github.com/securesign/operator/controllers/rekor/utils"
 	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
@@ -36,7 +39,7 @@ func (i ingressAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.Reko
 
 func (i ingressAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Rekor) *action.Result {
 	var updated bool
-	ok := types.NamespacedName{Name: actions.ServerDeploymentName, Namespace: instance.Namespace}
+	ok := types.NamespacedName{Name: constants.InstanceName(actions.ServerDeploymentName, instance.Name), Namespace: instance.Namespace}
 	labels := constants.LabelsFor(actions.ServerComponentName, actions.ServerDeploymentName, instance.Name)
 
 	svc := &v1.Service{}
@@ -49,6 +52,13 @@ func (i ingressAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Rekor
 		return i.Failed(fmt.Errorf("could not create ingress object: %w", err))
 	}
 
+	for k, v := range utils.RateLimitAnnotations(instance.Spec.Server.RateLimit) {
+		if ingress.Annotations == nil {
+			ingress.Annotations = map[string]string{}
+		}
+		ingress.Annotations[k] = v
+	}
+
 	if err = controllerutil.SetControllerReference(instance, ingress, i.Client.Scheme()); err != nil {
 		return i.Failed(fmt.Errorf("could not set controller reference for Ingress: %w", err))
 	}
@@ -77,7 +87,39 @@ func (i ingressAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Rekor
 			Message: "Ingress created",
 		})
 		return i.StatusUpdate(ctx, instance)
-	} else {
-		return i.Continue()
 	}
+
+	if err = i.ensureRateLimit(ctx, ingress); err != nil {
+		return i.Failed(fmt.Errorf("could not update rate limits of ingress: %w", err))
+	}
+	return i.Continue()
+}
+
+// ensureRateLimit updates the rate limit annotations of the existing Ingress, Ensure updates the spec only.
+func (i ingressAction) ensureRateLimit(ctx context.Context, expected *networkingv1.Ingress) error {
+	current := &networkingv1.Ingress{}
+	if err := i.Client.Get(ctx, client.ObjectKeyFromObject(expected), current); err != nil {
+		return err
+	}
+	changed := false
+	for _, k := range utils.RateLimitAnnotationKeys {
+		v, ok := expected.Annotations[k]
+		if old, found := current.Annotations[k]; found == ok && old == v {
+			continue
+		}
+		changed = true
+		if !ok {
+			delete(current.Annotations, k)
+			continue
+		}
+		if current.Annotations == nil {
+			current.Annotations = map[string]string{}
+		}
+		current.Annotations[k] = v
+	}
+	if !changed {
+		return nil
+	}
+	i.Logger.Info("Updating rate limits of ingress", "name", current.Name)
+	return i.Client.Update(ctx, current)
 }
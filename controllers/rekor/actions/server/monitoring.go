@@ -42,7 +42,7 @@ func (i monitoringAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Re
 
 	role := kubernetes.CreateRole(
 		instance.Namespace,
-		actions.MonitoringRoleName,
+		constants.InstanceName(actions.MonitoringRoleName, instance.Name),
 		monitoringLabels,
 		[]v1.PolicyRule{
 			{
@@ -75,12 +75,12 @@ func (i monitoringAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Re
 
 	roleBinding := kubernetes.CreateRoleBinding(
 		instance.Namespace,
-		actions.MonitoringRoleName,
+		constants.InstanceName(actions.MonitoringRoleName, instance.Name),
 		monitoringLabels,
 		v1.RoleRef{
 			APIGroup: v1.SchemeGroupVersion.Group,
 			Kind:     "Role",
-			Name:     actions.MonitoringRoleName,
+			Name:     constants.InstanceName(actions.MonitoringRoleName, instance.Name),
 		},
 		[]v1.Subject{
 			{Kind: "ServiceAccount", Name: "prometheus-k8s", Namespace: "openshift-monitoring"},
@@ -108,7 +108,7 @@ func (i monitoringAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Re
 
 	serviceMonitor := kubernetes.CreateServiceMonitor(
 		instance.Namespace,
-		actions.ServerDeploymentName,
+		constants.InstanceName(actions.ServerDeploymentName, instance.Name),
 		monitoringLabels,
 		[]monitoringv1.Endpoint{
 			{
@@ -3,15 +3,16 @@ package server
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
-	"io"
-	"net/http"
 	"time"
 
 	"github.com/securesign/operator/controllers/common/action"
 	k8sutils "github.com/securesign/operator/controllers/common/utils/kubernetes"
 	"github.com/securesign/operator/controllers/constants"
+	ctlogUtils "github.com/securesign/operator/controllers/ctlog/utils"
 	"github.com/securesign/operator/controllers/rekor/actions"
+	"github.com/securesign/operator/controllers/rekor/utils"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -23,12 +24,16 @@ import (
 
 const pubSecretNameFormat = "rekor-public-%s-"
 
+// shared between reconciliations - the fetch runs in background and its result is picked up by later reconcile
+var publicKeyFetcher = utils.NewPublicKeyFetcher(5*time.Second, 30*time.Second)
+
 func NewResolvePubKeyAction() action.Action[rhtasv1alpha1.Rekor] {
-	return &resolvePubKeyAction{}
+	return &resolvePubKeyAction{fetcher: publicKeyFetcher}
 }
 
 type resolvePubKeyAction struct {
 	action.BaseAction
+	fetcher *utils.PublicKeyFetcher
 }
 
 func (i resolvePubKeyAction) Name() string {
@@ -41,20 +46,23 @@ func (i resolvePubKeyAction) CanHandle(ctx context.Context, instance *rhtasv1alp
 		return false
 	}
 
-	if scr, err := k8sutils.GetSecret(i.Client, instance.Namespace, instance.Status.Signer.KeyRef.Name); err == nil {
-		if _, ok := scr.Labels[RekorPubLabel]; ok {
-			return false
-		}
+	if instance.Status.PublicKeyRef == nil {
+		return true
+	}
+	if instance.Status.Signer.KeyRef != nil && instance.Status.PublicKeyRef.Name == instance.Status.Signer.KeyRef.Name {
+		// the public key is generated with the signer key
+		return false
 	}
 
-	if scr, _ := k8sutils.FindSecret(ctx, i.Client, instance.Namespace, RekorPubLabel); scr != nil {
-		if expected, err := i.resolvePubKey(*instance); err == nil {
-			return !bytes.Equal(scr.Data[scr.Labels[RekorPubLabel]], expected)
-		}
-	} else {
+	current, err := k8sutils.GetSecretData(i.Client, instance.Namespace, instance.Status.PublicKeyRef)
+	if err != nil {
 		return true
 	}
-	return false
+	expected, done, err := i.resolvePubKey(ctx, *instance)
+	if err != nil || !done {
+		return true
+	}
+	return !bytes.Equal(current, expected)
 }
 
 func (i resolvePubKeyAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Rekor) *action.Result {
@@ -62,9 +70,32 @@ func (i resolvePubKeyAction) Handle(ctx context.Context, instance *rhtasv1alpha1
 		err error
 	)
 
-	key, err := i.resolvePubKey(*instance)
+	if instance.Status.Signer.KeyRef != nil {
+		if scr, err := k8sutils.GetSecret(i.Client, instance.Namespace, instance.Status.Signer.KeyRef.Name); err == nil {
+			if keyName, ok := scr.Labels[RekorPubLabel]; ok {
+				instance.Status.PublicKeyRef = &rhtasv1alpha1.SecretKeySelector{
+					LocalObjectReference: rhtasv1alpha1.LocalObjectReference{Name: scr.Name},
+					Key:                  keyName,
+				}
+				return i.StatusUpdate(ctx, instance)
+			}
+		}
+	}
+
+	key, done, err := i.resolvePubKey(ctx, *instance)
 	if err != nil {
-		return i.Failed(err)
+		i.Recorder.Event(instance, v1.EventTypeWarning, "PublicKeyResolutionFailed", err.Error())
+		return i.Failed(fmt.Errorf("could not resolve public key: %w", err))
+	}
+	if !done {
+		i.Logger.V(1).Info("Waiting for public key")
+		return i.Requeue()
+	}
+
+	if instance.Status.PublicKeyRef != nil {
+		if current, err := k8sutils.GetSecretData(i.Client, instance.Namespace, instance.Status.PublicKeyRef); err == nil && bytes.Equal(current, key) {
+			return i.Continue()
+		}
 	}
 
 	keyName := "public"
@@ -102,28 +133,45 @@ func (i resolvePubKeyAction) Handle(ctx context.Context, instance *rhtasv1alpha1
 	}
 
 	i.Recorder.Event(instance, v1.EventTypeNormal, "PublicKeySecretCreated", "New Rekor public key created: "+newConfig.Name)
+	instance.Status.PublicKeyRef = &rhtasv1alpha1.SecretKeySelector{
+		LocalObjectReference: rhtasv1alpha1.LocalObjectReference{Name: newConfig.Name},
+		Key:                  keyName,
+	}
 	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{Type: constants.Ready,
 		Status: metav1.ConditionFalse, Reason: constants.Creating, Message: "Server config created"})
 	return i.StatusUpdate(ctx, instance)
 }
 
-func (i resolvePubKeyAction) resolvePubKey(instance rhtasv1alpha1.Rekor) ([]byte, error) {
-	var (
-		pubKeyResponse *http.Response
-		err            error
-	)
-	for retry := 1; retry < 5; retry++ {
-		time.Sleep(time.Duration(retry) * time.Second)
-		pubKeyResponse, err = http.Get(fmt.Sprintf("http://%s.%s.svc", actions.ServerDeploymentName, instance.Namespace) + "/api/v1/log/publicKey")
-		if err == nil && pubKeyResponse.StatusCode == http.StatusOK {
-			continue
+// resolvePubKey returns the public key of the Rekor signer. The key is derived from the private key Secret for `secret` signers,
+// for other signers it is fetched from the running server in background and done is false until the fetch finishes.
+func (i resolvePubKeyAction) resolvePubKey(ctx context.Context, instance rhtasv1alpha1.Rekor) (key []byte, done bool, err error) {
+	if instance.Status.Signer.KMS == "secret" || instance.Status.Signer.KMS == "" {
+		if instance.Status.Signer.KeyRef == nil {
+			return nil, true, errors.New("signer key ref not specified")
+		}
+		config := &ctlogUtils.PrivateKeyConfig{}
+		if config.PrivateKey, err = k8sutils.GetSecretData(i.Client, instance.Namespace, instance.Status.Signer.KeyRef); err != nil {
+			return nil, true, err
+		}
+		if config.PrivateKeyPass, err = k8sutils.GetSecretData(i.Client, instance.Namespace, instance.Status.Signer.PasswordRef); err != nil {
+			return nil, true, err
 		}
-		i.Logger.Info("retrying to get rekor public key")
+		if config, err = ctlogUtils.GeneratePublicKey(config); err != nil {
+			return nil, true, err
+		}
+		return config.PublicKey, true, nil
 	}
 
-	if err != nil || pubKeyResponse.StatusCode != http.StatusOK {
-		return nil, err
+	if instance.Status.TreeID == nil {
+		return nil, true, errors.New("reference to trillian TreeID not set")
 	}
-	return io.ReadAll(pubKeyResponse.Body)
+	return i.fetcher.Fetch(ctx, client.ObjectKeyFromObject(&instance), serverUrl(instance), *instance.Status.TreeID)
+}
 
+// serverUrl prefers cluster internal service, operator running outside the cluster must use externally accessible URL
+func serverUrl(instance rhtasv1alpha1.Rekor) string {
+	if inContainer, err := k8sutils.ContainerMode(); err == nil && !inContainer && instance.Status.Url != "" {
+		return instance.Status.Url
+	}
+	return fmt.Sprintf("http://%s.%s.svc", constants.InstanceName(actions.ServerDeploymentName, instance.Name), instance.Namespace)
 }
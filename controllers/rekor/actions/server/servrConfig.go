@@ -44,7 +44,7 @@ func (i serverConfig) Handle(ctx context.Context, instance *rhtasv1alpha1.Rekor)
 	if err != nil {
 		return i.FailedWithStatusUpdate(ctx, err, instance)
 	}
-	newConfig := kubernetes.CreateImmutableConfigmap(fmt.Sprintf("rekor-server-config-%s", instance.Namespace), instance.Namespace, labels, map[string]string{"sharding-config.yaml": ""})
+	newConfig := kubernetes.CreateImmutableConfigmap(constants.InstanceName("rekor-server-config", instance.Name), instance.Namespace, labels, map[string]string{"sharding-config.yaml": ""})
 	if err = controllerutil.SetControllerReference(instance, newConfig, i.Client.Scheme()); err != nil {
 		return i.Failed(fmt.Errorf("could not set controller reference for ConfigMap: %w", err))
 	}
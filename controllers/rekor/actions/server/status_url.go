@@ -35,7 +35,7 @@ func (i statusUrlAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Rek
 	if instance.Spec.ExternalAccess.Enabled {
 		protocol := "http://"
 		ingress := &v12.Ingress{}
-		err := i.Client.Get(ctx, types.NamespacedName{Name: actions.ServerDeploymentName, Namespace: instance.Namespace}, ingress)
+		err := i.Client.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.ServerDeploymentName, instance.Name), Namespace: instance.Namespace}, ingress)
 		if err != nil {
 			return i.Failed(err)
 		}
@@ -44,7 +44,7 @@ func (i statusUrlAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Rek
 		}
 		url = protocol + ingress.Spec.Rules[0].Host
 	} else {
-		url = fmt.Sprintf("http://%s.%s.svc", actions.ServerDeploymentName, instance.Namespace)
+		url = fmt.Sprintf("http://%s.%s.svc", constants.InstanceName(actions.ServerDeploymentName, instance.Name), instance.Namespace)
 	}
 
 	if url == instance.Status.Url {
@@ -42,7 +42,11 @@ func (i deployAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Rekor)
 		updated bool
 	)
 	labels := constants.LabelsFor(actions.UIComponentName, actions.SearchUiDeploymentName, instance.Name)
-	dp := utils.CreateRekorSearchUiDeployment(instance, actions.SearchUiDeploymentName, actions.RBACName, labels)
+	sa := constants.InstanceName(actions.RBACName, instance.Name)
+	if instance.Spec.RekorSearchUI.OAuthProxy.Enabled {
+		sa = constants.InstanceName(actions.SearchUiRBACName, instance.Name)
+	}
+	dp := utils.CreateRekorSearchUiDeployment(instance, constants.InstanceName(actions.SearchUiDeploymentName, instance.Name), sa, labels)
 	if err = controllerutil.SetControllerReference(instance, dp, i.Client.Scheme()); err != nil {
 		return i.Failed(fmt.Errorf("could not set controller reference for Deployment: %w", err))
 	}
@@ -3,6 +3,7 @@ package ui
 import (
 	"context"
 	"fmt"
+	"maps"
 
 	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
 	"github.com/securesign/operator/controllers/common/action"
@@ -40,7 +41,7 @@ func (i ingressAction) CanHandle(ctx context.Context, instance *rhtasv1alpha1.Re
 
 func (i ingressAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Rekor) *action.Result {
 	var updated bool
-	ok := types.NamespacedName{Name: actions.SearchUiDeploymentName, Namespace: instance.Namespace}
+	ok := types.NamespacedName{Name: constants.InstanceName(actions.SearchUiDeploymentName, instance.Name), Namespace: instance.Namespace}
 	labels := constants.LabelsFor(actions.UIComponentName, actions.SearchUiDeploymentName, instance.Name)
 
 	svc := &v1.Service{}
@@ -53,6 +54,16 @@ func (i ingressAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Rekor
 		return i.Failed(fmt.Errorf("could not create ingress object: %w", err))
 	}
 
+	termination := "edge"
+	if instance.Spec.RekorSearchUI.OAuthProxy.Enabled {
+		// OAuth proxy terminates TLS with the certificate issued by OpenShift service CA
+		termination = "reencrypt"
+	}
+	// route annotations are present only on OpenShift
+	if ingress.Annotations != nil {
+		ingress.Annotations["route.openshift.io/termination"] = termination
+	}
+
 	if err = controllerutil.SetControllerReference(instance, ingress, i.Client.Scheme()); err != nil {
 		return i.Failed(fmt.Errorf("could not set controller reference for Ingress: %w", err))
 	}
@@ -73,6 +84,14 @@ func (i ingressAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Rekor
 		return i.Failed(fmt.Errorf("could not create Ingress: %w", err))
 	}
 
+	if ingress.Annotations != nil {
+		annotated, err := kubernetes.EnsureAnnotations(ctx, i.Client, ingress, maps.Clone(ingress.Annotations))
+		if err != nil {
+			return i.Failed(fmt.Errorf("could not annotate Ingress: %w", err))
+		}
+		updated = updated || annotated
+	}
+
 	if updated {
 		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
 			Type:    actions.UICondition,
@@ -7,6 +7,7 @@ import (
 	"github.com/securesign/operator/controllers/common/utils"
 	"github.com/securesign/operator/controllers/constants"
 	"github.com/securesign/operator/controllers/rekor/actions"
+	rekorutils "github.com/securesign/operator/controllers/rekor/utils"
 	v12 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -58,7 +59,7 @@ func (i initializeAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Re
 
 	protocol := "http://"
 	ingress := &v12.Ingress{}
-	err = i.Client.Get(ctx, types.NamespacedName{Name: actions.SearchUiDeploymentName, Namespace: instance.Namespace}, ingress)
+	err = i.Client.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.SearchUiDeploymentName, instance.Name), Namespace: instance.Namespace}, ingress)
 	if err != nil {
 		// condition error
 		return i.FailedWithStatusUpdate(ctx, err, instance)
@@ -68,8 +69,18 @@ func (i initializeAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Re
 	}
 
 	instance.Status.RekorSearchUIUrl = protocol + ingress.Spec.Rules[0].Host
+	instance.Status.RekorSearchUI = &rhtasv1alpha1.RekorSearchUIStatus{
+		RekorUrl:  rekorutils.SearchUiRekorUrl(instance),
+		Protected: instance.Spec.RekorSearchUI.OAuthProxy.Enabled,
+	}
+	message := "Search UI is browsing " + instance.Status.RekorSearchUI.RekorUrl
+	if instance.Status.RekorSearchUI.Protected {
+		message += ", access is protected by OAuth proxy"
+	} else {
+		message += ", access is not protected"
+	}
 	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{Type: actions.UICondition,
-		Status: metav1.ConditionTrue, Reason: constants.Ready})
+		Status: metav1.ConditionTrue, Reason: constants.Ready, Message: message})
 
 	return i.StatusUpdate(ctx, instance)
 }
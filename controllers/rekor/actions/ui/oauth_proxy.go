@@ -0,0 +1,147 @@
+package ui
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/action"
+	"github.com/securesign/operator/controllers/common/utils"
+	"github.com/securesign/operator/controllers/common/utils/kubernetes"
+	"github.com/securesign/operator/controllers/constants"
+	"github.com/securesign/operator/controllers/rekor/actions"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// oauthRedirectReference points the OAuth server back to the route exposing the UI
+func oauthRedirectReference(instance *rhtasv1alpha1.Rekor) string {
+	return `{"kind":"OAuthRedirectReference","apiVersion":"v1","reference":{"kind":"Route","name":"` +
+		constants.InstanceName(actions.SearchUiDeploymentName, instance.Name) + `"}}`
+}
+
+func NewOAuthProxyAction() action.Action[rhtasv1alpha1.Rekor] {
+	return &oauthProxyAction{}
+}
+
+type oauthProxyAction struct {
+	action.BaseAction
+}
+
+func (i oauthProxyAction) Name() string {
+	return "oauth proxy"
+}
+
+func (i oauthProxyAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.Rekor) bool {
+	c := meta.FindStatusCondition(instance.Status.Conditions, constants.Ready)
+	if c == nil {
+		return false
+	}
+	return (c.Reason == constants.Creating || c.Reason == constants.Ready) && utils.IsEnabled(instance.Spec.RekorSearchUI.Enabled)
+}
+
+func (i oauthProxyAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Rekor) *action.Result {
+	var (
+		err     error
+		updated bool
+	)
+	if !instance.Spec.RekorSearchUI.OAuthProxy.Enabled {
+		return i.cleanup(ctx, instance)
+	}
+	if !kubernetes.IsOpenShift(i.Client) {
+		return i.fail(ctx, instance, errors.New("OAuth proxy for Rekor Search UI is supported only on OpenShift"))
+	}
+
+	labels := constants.LabelsFor(actions.UIComponentName, actions.SearchUiDeploymentName, instance.Name)
+	sa := &v1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      constants.InstanceName(actions.SearchUiRBACName, instance.Name),
+			Namespace: instance.Namespace,
+			Labels:    labels,
+			Annotations: map[string]string{
+				"serviceaccounts.openshift.io/oauth-redirectreference.primary": oauthRedirectReference(instance),
+			},
+		},
+	}
+	if err = controllerutil.SetControllerReference(instance, sa, i.Client.Scheme()); err != nil {
+		return i.Failed(fmt.Errorf("could not set controller reference for SA: %w", err))
+	}
+	if _, err = i.Ensure(ctx, sa); err != nil {
+		return i.fail(ctx, instance, fmt.Errorf("could not create SA: %w", err))
+	}
+
+	cookie := make([]byte, 32)
+	if _, err = rand.Read(cookie); err != nil {
+		return i.Failed(err)
+	}
+	// the session secret is created only once, Ensure does not update objects without spec
+	secret := kubernetes.CreateSecret(constants.InstanceName(actions.SearchUiDeploymentName, instance.Name)+"-oauth", instance.Namespace,
+		map[string][]byte{"session_secret": []byte(base64.StdEncoding.EncodeToString(cookie))}, labels)
+	if err = controllerutil.SetControllerReference(instance, secret, i.Client.Scheme()); err != nil {
+		return i.Failed(fmt.Errorf("could not set controller reference for Secret: %w", err))
+	}
+	if updated, err = i.Ensure(ctx, secret); err != nil {
+		return i.fail(ctx, instance, fmt.Errorf("could not create OAuth proxy session secret: %w", err))
+	}
+
+	if updated {
+		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+			Type:    actions.UICondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  constants.Creating,
+			Message: "OAuth proxy configured",
+		})
+		return i.StatusUpdate(ctx, instance)
+	}
+	return i.Continue()
+}
+
+// cleanup deletes the ServiceAccount and the session secret left behind when the OAuth proxy is turned off
+func (i oauthProxyAction) cleanup(ctx context.Context, instance *rhtasv1alpha1.Rekor) *action.Result {
+	deleted := false
+	for _, obj := range []client.Object{
+		&v1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: constants.InstanceName(actions.SearchUiRBACName, instance.Name), Namespace: instance.Namespace}},
+		&v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: constants.InstanceName(actions.SearchUiDeploymentName, instance.Name) + "-oauth", Namespace: instance.Namespace}},
+	} {
+		if err := i.Client.Delete(ctx, obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return i.Failed(fmt.Errorf("could not delete OAuth proxy resources: %w", err))
+		}
+		deleted = true
+	}
+	if !deleted {
+		return i.Continue()
+	}
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:    actions.UICondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  constants.Creating,
+		Message: "OAuth proxy removed",
+	})
+	return i.StatusUpdate(ctx, instance)
+}
+
+func (i oauthProxyAction) fail(ctx context.Context, instance *rhtasv1alpha1.Rekor, err error) *action.Result {
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:    actions.UICondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  constants.Failure,
+		Message: err.Error(),
+	})
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:    constants.Ready,
+		Status:  metav1.ConditionFalse,
+		Reason:  constants.Failure,
+		Message: err.Error(),
+	})
+	return i.FailedWithStatusUpdate(ctx, err, instance)
+}
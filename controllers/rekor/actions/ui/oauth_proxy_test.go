@@ -0,0 +1,60 @@
+package ui
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+	testAction "github.com/securesign/operator/controllers/common/test/action"
+	"github.com/securesign/operator/controllers/common/utils"
+	"github.com/securesign/operator/controllers/constants"
+	"github.com/securesign/operator/controllers/rekor/actions"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestOAuthProxyCleanup(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.TODO()
+
+	instance := &v1alpha1.Rekor{
+		ObjectMeta: metav1.ObjectMeta{Name: "rekor", Namespace: "default"},
+		Spec: v1alpha1.RekorSpec{
+			RekorSearchUI: v1alpha1.RekorSearchUI{Enabled: utils.Pointer(true)},
+		},
+	}
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{Type: constants.Ready, Status: metav1.ConditionTrue, Reason: constants.Ready})
+	name := constants.InstanceName(actions.SearchUiDeploymentName, instance.Name)
+	svc := &v1.Service{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default",
+		Annotations: map[string]string{servingCertAnnotation: name + "-tls"}}}
+	c := testAction.FakeClientBuilder().
+		WithStatusSubresource(instance).
+		WithObjects(instance, svc,
+			&v1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: constants.InstanceName(actions.SearchUiRBACName, instance.Name), Namespace: "default"}},
+			&v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name + "-oauth", Namespace: "default"}},
+			&v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name + "-tls", Namespace: "default"}},
+		).
+		Build()
+	exists := func(obj client.Object, name string) bool {
+		return !apierrors.IsNotFound(c.Get(ctx, types.NamespacedName{Name: name, Namespace: "default"}, obj))
+	}
+
+	a := testAction.PrepareAction(c, NewOAuthProxyAction())
+	g.Expect(a.CanHandle(ctx, instance)).To(BeTrue())
+	g.Expect(a.Handle(ctx, instance)).ToNot(BeNil())
+	g.Expect(exists(&v1.ServiceAccount{}, constants.InstanceName(actions.SearchUiRBACName, instance.Name))).To(BeFalse())
+	g.Expect(exists(&v1.Secret{}, name+"-oauth")).To(BeFalse())
+	// nothing left to remove
+	g.Expect(a.Handle(ctx, instance)).To(BeNil())
+
+	s := testAction.PrepareAction(c, NewCreateServiceAction())
+	g.Expect(s.Handle(ctx, instance)).ToNot(BeNil())
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: name, Namespace: "default"}, svc)).To(Succeed())
+	g.Expect(svc.Annotations).ToNot(HaveKey(servingCertAnnotation))
+	g.Expect(exists(&v1.Secret{}, name+"-tls")).To(BeFalse())
+}
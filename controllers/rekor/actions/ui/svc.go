@@ -9,13 +9,19 @@ import (
 	k8sutils "github.com/securesign/operator/controllers/common/utils/kubernetes"
 	"github.com/securesign/operator/controllers/constants"
 	"github.com/securesign/operator/controllers/rekor/actions"
+	rekorutils "github.com/securesign/operator/controllers/rekor/utils"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
 )
 
+const servingCertAnnotation = "service.beta.openshift.io/serving-cert-secret-name"
+
 func NewCreateServiceAction() action.Action[rhtasv1alpha1.Rekor] {
 	return &createServiceAction{}
 }
@@ -41,8 +47,13 @@ func (i createServiceAction) Handle(ctx context.Context, instance *rhtasv1alpha1
 	)
 
 	labels := constants.LabelsFor(actions.UIComponentName, actions.SearchUiDeploymentName, instance.Name)
-	svc := k8sutils.CreateService(instance.Namespace, actions.SearchUiDeploymentName, 3000, labels)
+	svc := k8sutils.CreateService(instance.Namespace, constants.InstanceName(actions.SearchUiDeploymentName, instance.Name), rekorutils.SearchUiPort, labels)
 	svc.Spec.Ports[0].Port = 80
+	if instance.Spec.RekorSearchUI.OAuthProxy.Enabled {
+		// UI is reachable only through the OAuth proxy
+		svc.Spec.Ports[0].Port = 443
+		svc.Spec.Ports[0].TargetPort = intstr.FromInt(rekorutils.OAuthProxyPort)
+	}
 
 	if err = controllerutil.SetControllerReference(instance, svc, i.Client.Scheme()); err != nil {
 		return i.Failed(fmt.Errorf("could not set controller reference for service: %w", err))
@@ -64,6 +75,31 @@ func (i createServiceAction) Handle(ctx context.Context, instance *rhtasv1alpha1
 		return i.FailedWithStatusUpdate(ctx, fmt.Errorf("could not create service: %w", err), instance)
 	}
 
+	tlsSecret := constants.InstanceName(actions.SearchUiDeploymentName, instance.Name) + "-tls"
+	if instance.Spec.RekorSearchUI.OAuthProxy.Enabled {
+		// OpenShift service CA issues the serving certificate for the OAuth proxy
+		annotated, err := k8sutils.EnsureAnnotations(ctx, i.Client, svc, map[string]string{
+			servingCertAnnotation: tlsSecret,
+		})
+		if err != nil {
+			return i.Failed(fmt.Errorf("could not annotate service: %w", err))
+		}
+		updated = updated || annotated
+	} else {
+		// the serving certificate is not needed once the OAuth proxy is turned off
+		removed, err := k8sutils.RemoveAnnotations(ctx, i.Client, svc, servingCertAnnotation)
+		if err != nil {
+			return i.Failed(fmt.Errorf("could not annotate service: %w", err))
+		}
+		if removed {
+			err = i.Client.Delete(ctx, &v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: tlsSecret, Namespace: instance.Namespace}})
+			if err != nil && !apierrors.IsNotFound(err) {
+				return i.Failed(fmt.Errorf("could not delete serving certificate: %w", err))
+			}
+		}
+		updated = updated || removed
+	}
+
 	if updated {
 		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
 			Type:    actions.UICondition,
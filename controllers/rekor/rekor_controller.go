@@ -28,6 +28,7 @@ import (
 	v1 "k8s.io/api/networking/v1"
 	"k8s.io/client-go/tools/record"
 
+	"github.com/securesign/operator/controllers/common"
 	"github.com/securesign/operator/controllers/common/action"
 	v12 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -38,6 +39,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/logmonitor"
 	batchv1 "k8s.io/api/batch/v1"
 )
 
@@ -46,6 +48,8 @@ type RekorReconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
+	// LogMonitor runs the log verifications of the instances, a new runner is created when unset
+	LogMonitor *logmonitor.Runner
 }
 
 //+kubebuilder:rbac:groups=rhtas.redhat.com,resources=rekors,verbs=get;list;watch;create;update;patch;delete
@@ -85,6 +89,8 @@ func (r *RekorReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 	}
 	target := instance.DeepCopy()
 	actions := []action.Action[rhtasv1alpha1.Rekor]{
+		common.NewMigrateNamesAction[rhtasv1alpha1.Rekor](actions2.LegacyNames),
+
 		// NONE -> PENDING
 		actions2.NewInitializeConditions(),
 
@@ -107,6 +113,7 @@ func (r *RekorReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 		redis.NewDeployAction(),
 		redis.NewCreateServiceAction(),
 
+		ui.NewOAuthProxyAction(),
 		ui.NewDeployAction(),
 		ui.NewCreateServiceAction(),
 		ui.NewIngressAction(),
@@ -124,6 +131,9 @@ func (r *RekorReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 
 		// INITIALIZE -> READY
 		actions2.NewInitializeAction(),
+
+		// READY
+		server.NewLogMonitorAction(r.LogMonitor),
 	}
 
 	for _, a := range actions {
@@ -144,6 +154,9 @@ func (r *RekorReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *RekorReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.LogMonitor == nil {
+		r.LogMonitor = logmonitor.NewRunner(logmonitor.DefaultTimeout)
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&rhtasv1alpha1.Rekor{}).
 		Owns(&v12.Deployment{}).
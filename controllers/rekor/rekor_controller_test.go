@@ -135,7 +135,7 @@ var _ = Describe("Rekor controller", func() {
 			}, time.Minute, time.Second).Should(Equal(constants.Pending))
 
 			By("Move to CreatingPhase by creating trillian service")
-			Expect(k8sClient.Create(ctx, kubernetes.CreateService(Namespace, trillian.LogserverDeploymentName, 8091, constants.LabelsForComponent(trillian.LogServerComponentName, instance.Name)))).To(Succeed())
+			Expect(k8sClient.Create(ctx, kubernetes.CreateService(Namespace, constants.InstanceName(trillian.LogserverDeploymentName, instance.Name), 8091, constants.LabelsForComponent(trillian.LogServerComponentName, instance.Name)))).To(Succeed())
 
 			By("Rekor signer created")
 			found := &v1alpha1.Rekor{}
@@ -151,6 +151,10 @@ var _ = Describe("Rekor controller", func() {
 				Expect(k8sClient.List(ctx, scr, runtimeClient.InNamespace(Namespace), runtimeClient.MatchingLabels{server.RekorPubLabel: "public"})).Should(Succeed())
 				return scr.Items
 			}, time.Minute, time.Second).Should(Not(BeEmpty()))
+			Eventually(func() *v1alpha1.SecretKeySelector {
+				Expect(k8sClient.Get(ctx, typeNamespaceName, found)).To(Succeed())
+				return found.Status.PublicKeyRef
+			}, time.Minute, time.Second).Should(Not(BeNil()))
 
 			By("Rekor server PVC created")
 			Eventually(func() string {
@@ -161,37 +165,37 @@ var _ = Describe("Rekor controller", func() {
 
 			By("Rekor server SVC created")
 			Eventually(func() error {
-				return k8sClient.Get(ctx, types.NamespacedName{Name: actions.ServerDeploymentName, Namespace: Namespace}, &corev1.Service{})
+				return k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.ServerDeploymentName, Name), Namespace: Namespace}, &corev1.Service{})
 			}, time.Minute, time.Second).Should(Succeed())
 
 			By("Rekor server deployment created")
 			Eventually(func() error {
-				return k8sClient.Get(ctx, types.NamespacedName{Name: actions.ServerDeploymentName, Namespace: Namespace}, &appsv1.Deployment{})
+				return k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.ServerDeploymentName, Name), Namespace: Namespace}, &appsv1.Deployment{})
 			}, time.Minute, time.Second).Should(Succeed())
 
 			By("Redis Deployment created")
 			Eventually(func() error {
-				return k8sClient.Get(ctx, types.NamespacedName{Name: actions.RedisDeploymentName, Namespace: Namespace}, &appsv1.Deployment{})
+				return k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.RedisDeploymentName, Name), Namespace: Namespace}, &appsv1.Deployment{})
 			}, time.Minute, time.Second).Should(Succeed())
 
 			By("Redis svc created")
 			Eventually(func() error {
-				return k8sClient.Get(ctx, types.NamespacedName{Name: actions.RedisDeploymentName, Namespace: Namespace}, &corev1.Service{})
+				return k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.RedisDeploymentName, Name), Namespace: Namespace}, &corev1.Service{})
 			}, time.Minute, time.Second).Should(Succeed())
 
 			By("UI Deployment created")
 			Eventually(func() error {
-				return k8sClient.Get(ctx, types.NamespacedName{Name: actions.SearchUiDeploymentName, Namespace: Namespace}, &appsv1.Deployment{})
+				return k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.SearchUiDeploymentName, Name), Namespace: Namespace}, &appsv1.Deployment{})
 			}, time.Minute, time.Second).Should(Succeed())
 
 			By("UI svc created")
 			Eventually(func() error {
-				return k8sClient.Get(ctx, types.NamespacedName{Name: actions.SearchUiDeploymentName, Namespace: Namespace}, &corev1.Service{})
+				return k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.SearchUiDeploymentName, Name), Namespace: Namespace}, &corev1.Service{})
 			}, time.Minute, time.Second).Should(Succeed())
 
 			By("Backfill Redis Cronjob Created")
 			Eventually(func() error {
-				return k8sClient.Get(ctx, types.NamespacedName{Name: actions.BackfillRedisCronJobName, Namespace: Namespace}, &batchv1.CronJob{})
+				return k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.BackfillRedisCronJobName, Name), Namespace: Namespace}, &batchv1.CronJob{})
 			}, time.Minute, time.Second).Should(Succeed())
 
 			By("Waiting until Rekor instance is Initialization")
@@ -221,14 +225,14 @@ var _ = Describe("Rekor controller", func() {
 			By("Checking if controller will return deployment to desired state")
 			deployment := &appsv1.Deployment{}
 			Eventually(func() error {
-				return k8sClient.Get(ctx, types.NamespacedName{Name: actions.ServerDeploymentName, Namespace: Namespace}, deployment)
+				return k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.ServerDeploymentName, Name), Namespace: Namespace}, deployment)
 			}, time.Minute, time.Second).Should(Succeed())
 			replicas := int32(99)
 			deployment.Spec.Replicas = &replicas
 			Expect(k8sClient.Status().Update(ctx, deployment)).Should(Succeed())
 			Eventually(func() int32 {
 				deployment = &appsv1.Deployment{}
-				Expect(k8sClient.Get(ctx, types.NamespacedName{Name: actions.ServerDeploymentName, Namespace: Namespace}, deployment)).Should(Succeed())
+				Expect(k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.ServerDeploymentName, Name), Namespace: Namespace}, deployment)).Should(Succeed())
 				return *deployment.Spec.Replicas
 			}, time.Minute, time.Second).Should(Equal(int32(1)))
 		})
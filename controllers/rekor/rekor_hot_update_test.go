@@ -118,7 +118,7 @@ var _ = Describe("Rekor hot update test", func() {
 			}, time.Minute, time.Second).Should(Succeed())
 
 			By("Move to CreatingPhase by creating trillian service")
-			Expect(k8sClient.Create(ctx, kubernetes.CreateService(Namespace, trillian.LogserverDeploymentName, 8091, constants.LabelsForComponent(trillian.LogServerComponentName, instance.Name)))).To(Succeed())
+			Expect(k8sClient.Create(ctx, kubernetes.CreateService(Namespace, constants.InstanceName(trillian.LogserverDeploymentName, instance.Name), 8091, constants.LabelsForComponent(trillian.LogServerComponentName, instance.Name)))).To(Succeed())
 
 			By("Waiting until Rekor instance is Initialization")
 			Eventually(func() string {
@@ -147,7 +147,7 @@ var _ = Describe("Rekor hot update test", func() {
 
 			By("Save the Deployment configuration")
 			deployment := &appsv1.Deployment{}
-			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: actions.ServerDeploymentName, Namespace: Namespace}, deployment)).Should(Succeed())
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.ServerDeploymentName, Name), Namespace: Namespace}, deployment)).Should(Succeed())
 
 			By("Patch the signer key")
 			Expect(k8sClient.Get(ctx, typeNamespaceName, found)).Should(Succeed())
@@ -174,7 +174,7 @@ var _ = Describe("Rekor hot update test", func() {
 			By("Rekor deployment is updated")
 			Eventually(func() bool {
 				updated := &appsv1.Deployment{}
-				k8sClient.Get(ctx, types.NamespacedName{Name: actions.ServerDeploymentName, Namespace: Namespace}, updated)
+				k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.ServerDeploymentName, Name), Namespace: Namespace}, updated)
 				return equality.Semantic.DeepDerivative(deployment.Spec.Template.Spec.Volumes, updated.Spec.Template.Spec.Volumes)
 			}, time.Minute, time.Second).Should(BeFalse())
 		})
@@ -23,9 +23,11 @@ import (
 	"runtime"
 	"testing"
 
+	"github.com/google/trillian"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common"
 	"go.uber.org/zap/zapcore"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
@@ -85,6 +87,11 @@ var _ = BeforeSuite(func() {
 
 	//+kubebuilder:scaffold:scheme
 
+	// there is no Trillian in the test environment, trees adopted by the tests are always active
+	common.GetTrillianTree = func(_ context.Context, _ string, _ []byte, treeID int64) (*trillian.Tree, error) {
+		return &trillian.Tree{TreeId: treeID, TreeType: trillian.TreeType_LOG, TreeState: trillian.TreeState_ACTIVE}, nil
+	}
+
 	k8sClient, err = client.New(cfg, client.Options{Scheme: scheme.Scheme})
 	Expect(err).NotTo(HaveOccurred())
 	Expect(k8sClient).NotTo(BeNil())
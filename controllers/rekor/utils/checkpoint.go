@@ -0,0 +1,128 @@
+package utils
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+)
+
+// Checkpoint is a signed tree head in the signed note format served by Rekor on /api/v1/log
+// reference code https://github.com/sigstore/rekor/blob/main/pkg/util/signed_note.go
+type Checkpoint struct {
+	Origin   string
+	Size     uint64
+	RootHash []byte
+
+	note       string
+	signatures []noteSignature
+}
+
+type noteSignature struct {
+	name      string
+	keyHint   uint32
+	signature []byte
+}
+
+// ParseCheckpoint parses text representation of the signed checkpoint.
+func ParseCheckpoint(data string) (*Checkpoint, error) {
+	split := strings.LastIndex(data, "\n\n")
+	if split < 0 {
+		return nil, errors.New("malformed checkpoint: missing signature block")
+	}
+	cp := &Checkpoint{note: data[:split+1]}
+
+	lines := strings.Split(strings.TrimSuffix(cp.note, "\n"), "\n")
+	if len(lines) < 3 {
+		return nil, errors.New("malformed checkpoint: note too short")
+	}
+	cp.Origin = lines[0]
+	size, err := strconv.ParseUint(lines[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("malformed checkpoint size: %w", err)
+	}
+	cp.Size = size
+	if cp.RootHash, err = base64.StdEncoding.DecodeString(lines[2]); err != nil {
+		return nil, fmt.Errorf("malformed checkpoint root hash: %w", err)
+	}
+
+	for _, line := range strings.Split(strings.TrimSuffix(data[split+2:], "\n"), "\n") {
+		if !strings.HasPrefix(line, "— ") {
+			return nil, fmt.Errorf("malformed checkpoint signature line: %q", line)
+		}
+		fields := strings.Fields(strings.TrimPrefix(line, "— "))
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("malformed checkpoint signature line: %q", line)
+		}
+		raw, err := base64.StdEncoding.DecodeString(fields[1])
+		if err != nil || len(raw) <= 4 {
+			return nil, fmt.Errorf("malformed checkpoint signature: %q", fields[1])
+		}
+		cp.signatures = append(cp.signatures, noteSignature{
+			name:      fields[0],
+			keyHint:   binary.BigEndian.Uint32(raw[:4]),
+			signature: raw[4:],
+		})
+	}
+	if len(cp.signatures) == 0 {
+		return nil, errors.New("checkpoint is not signed")
+	}
+	return cp, nil
+}
+
+// TreeID returns the Trillian tree ID encoded in the checkpoint origin ("<hostname> - <treeID>").
+func (c *Checkpoint) TreeID() (int64, error) {
+	i := strings.LastIndex(c.Origin, " - ")
+	if i < 0 {
+		return 0, fmt.Errorf("checkpoint origin %q does not contain tree ID", c.Origin)
+	}
+	return strconv.ParseInt(c.Origin[i+3:], 10, 64)
+}
+
+// Verify checks that the checkpoint is signed by the PEM encoded public key.
+func (c *Checkpoint) Verify(pemPublicKey []byte) error {
+	pub, err := cryptoutils.UnmarshalPEMToPublicKey(pemPublicKey)
+	if err != nil {
+		return fmt.Errorf("could not parse public key: %w", err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return err
+	}
+	keyHash := sha256.Sum256(der)
+	keyHint := binary.BigEndian.Uint32(keyHash[:4])
+
+	digest := sha256.Sum256([]byte(c.note))
+	for _, s := range c.signatures {
+		if s.keyHint != keyHint {
+			continue
+		}
+		if verifySignature(pub, digest[:], []byte(c.note), s.signature) {
+			return nil
+		}
+	}
+	return errors.New("checkpoint signature does not match public key")
+}
+
+func verifySignature(pub crypto.PublicKey, digest, message, sig []byte) bool {
+	switch key := pub.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(key, digest, sig)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, digest, sig) == nil
+	case ed25519.PublicKey:
+		return ed25519.Verify(key, message, sig)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,140 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestCheckpointVerify(t *testing.T) {
+	g := NewWithT(t)
+
+	key, pub := generateKey(g)
+	checkpoint, err := ParseCheckpoint(signCheckpoint(g, key, "rekor.local - 123"))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(checkpoint.Origin).Should(Equal("rekor.local - 123"))
+	g.Expect(checkpoint.Size).Should(Equal(uint64(10)))
+	g.Expect(checkpoint.TreeID()).Should(Equal(int64(123)))
+	g.Expect(checkpoint.Verify(pub)).Should(Succeed())
+
+	_, otherPub := generateKey(g)
+	g.Expect(checkpoint.Verify(otherPub)).ShouldNot(Succeed())
+}
+
+func TestCheckpointMalformed(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := ParseCheckpoint("rekor.local - 123\n10\nAAAA\n")
+	g.Expect(err).Should(HaveOccurred())
+
+	_, err = ParseCheckpoint("rekor.local - 123\n10\nAAAA\n\nno signature\n")
+	g.Expect(err).Should(HaveOccurred())
+}
+
+func TestPublicKeyFetcher(t *testing.T) {
+	g := NewWithT(t)
+
+	key, pub := generateKey(g)
+	server := fakeRekor(g, key, pub, "123")
+	defer server.Close()
+
+	fetcher := NewPublicKeyFetcher(time.Second, time.Minute)
+	nn := types.NamespacedName{Namespace: "default", Name: "rekor"}
+
+	_, done, err := fetcher.Fetch(context.TODO(), nn, server.URL, 123)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(done).Should(BeFalse())
+
+	g.Eventually(func() []byte {
+		result, _, _ := fetcher.Fetch(context.TODO(), nn, server.URL, 123)
+		return result
+	}).Should(Equal(pub))
+}
+
+func TestPublicKeyFetcherTreeMismatch(t *testing.T) {
+	g := NewWithT(t)
+
+	key, pub := generateKey(g)
+	server := fakeRekor(g, key, pub, "123")
+	defer server.Close()
+
+	fetcher := NewPublicKeyFetcher(time.Second, time.Minute)
+	nn := types.NamespacedName{Namespace: "default", Name: "rekor"}
+
+	g.Eventually(func() error {
+		_, done, err := fetcher.Fetch(context.TODO(), nn, server.URL, 456)
+		if !done {
+			return nil
+		}
+		return err
+	}).Should(HaveOccurred())
+}
+
+func TestLogCheckpointTreeMismatch(t *testing.T) {
+	g := NewWithT(t)
+
+	key, pub := generateKey(g)
+	server := fakeRekor(g, key, pub, "123")
+	defer server.Close()
+
+	checkpoint, err := NewLog(http.DefaultClient, server.URL, 123, pub).Checkpoint(context.TODO())
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(checkpoint.Size).Should(Equal(uint64(10)))
+
+	_, err = NewLog(http.DefaultClient, server.URL, 456, pub).Checkpoint(context.TODO())
+	g.Expect(err).Should(MatchError(ContainSubstring("unexpected tree ID 123, expected 456")))
+}
+
+func generateKey(g Gomega) (*ecdsa.PrivateKey, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	g.Expect(err).ShouldNot(HaveOccurred())
+	return key, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func signCheckpoint(g Gomega, key *ecdsa.PrivateKey, origin string) string {
+	note := fmt.Sprintf("%s\n10\n%s\n", origin, base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32)))
+	digest := sha256.Sum256([]byte(note))
+	sig, err := key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	g.Expect(err).ShouldNot(HaveOccurred())
+	keyHash := sha256.Sum256(der)
+	hint := make([]byte, 4)
+	binary.BigEndian.PutUint32(hint, binary.BigEndian.Uint32(keyHash[:4]))
+
+	return note + "\n— rekor.local " + base64.StdEncoding.EncodeToString(append(hint, sig...)) + "\n"
+}
+
+func fakeRekor(g Gomega, key *ecdsa.PrivateKey, pub []byte, treeID string) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc(publicKeyPath, func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(pub)
+	})
+	mux.HandleFunc(logInfoPath, func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"treeID":         treeID,
+			"signedTreeHead": signCheckpoint(g, key, "rekor.local - "+treeID),
+		})
+	})
+	return httptest.NewServer(mux)
+}
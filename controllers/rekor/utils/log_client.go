@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/securesign/operator/controllers/common/logmonitor"
+)
+
+// Log provides access to the checkpoints and consistency proofs of the Rekor server.
+type Log struct {
+	client    *http.Client
+	url       string
+	treeID    int64
+	publicKey []byte
+}
+
+func NewLog(client *http.Client, url string, treeID int64, publicKey []byte) *Log {
+	return &Log{client: client, url: url, treeID: treeID, publicKey: publicKey}
+}
+
+func (l *Log) Checkpoint(ctx context.Context) (*logmonitor.Checkpoint, error) {
+	body, err := get(ctx, l.client, l.url+logInfoPath)
+	if err != nil {
+		return nil, fmt.Errorf("could not get log info: %w", err)
+	}
+	info := logInfo{}
+	if err = json.Unmarshal(body, &info); err != nil {
+		return nil, fmt.Errorf("could not parse log info: %w", err)
+	}
+	if info.TreeID != strconv.FormatInt(l.treeID, 10) {
+		return nil, fmt.Errorf("unexpected tree ID %s, expected %d", info.TreeID, l.treeID)
+	}
+	checkpoint, err := ParseCheckpoint(info.SignedTreeHead)
+	if err != nil {
+		return nil, err
+	}
+	if id, err := checkpoint.TreeID(); err != nil || id != l.treeID {
+		return nil, fmt.Errorf("checkpoint origin %q does not match tree ID %d", checkpoint.Origin, l.treeID)
+	}
+	if err = checkpoint.Verify(l.publicKey); err != nil {
+		return nil, fmt.Errorf("%w: %w", logmonitor.ErrKeyMismatch, err)
+	}
+	return &logmonitor.Checkpoint{Size: checkpoint.Size, RootHash: checkpoint.RootHash}, nil
+}
+
+func (l *Log) ConsistencyProof(ctx context.Context, first, second uint64) ([][]byte, error) {
+	url := fmt.Sprintf("%s%s?firstSize=%d&lastSize=%d&treeID=%s", l.url, proofPath, first, second, strconv.FormatInt(l.treeID, 10))
+	body, err := get(ctx, l.client, url)
+	if err != nil {
+		return nil, err
+	}
+	response := struct {
+		Hashes []string `json:"hashes"`
+	}{}
+	if err = json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("could not parse consistency proof: %w", err)
+	}
+	hashes := make([][]byte, len(response.Hashes))
+	for i, h := range response.Hashes {
+		if hashes[i], err = hex.DecodeString(h); err != nil {
+			return nil, fmt.Errorf("could not decode consistency proof: %w", err)
+		}
+	}
+	return hashes, nil
+}
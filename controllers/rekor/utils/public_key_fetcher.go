@@ -0,0 +1,123 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	publicKeyPath = "/api/v1/log/publicKey"
+	logInfoPath   = "/api/v1/log"
+	proofPath     = "/api/v1/log/proof"
+
+	// maximal size of the response body accepted from Rekor server
+	maxResponseSize = 1 << 20
+)
+
+// PublicKeyFetcher retrieves the public key from a running Rekor server in background.
+// It never blocks the caller: the first call starts the fetch and subsequent calls return its result once finished.
+// Fetched key is accepted only when it verifies the signed checkpoint of the expected Trillian tree.
+type PublicKeyFetcher struct {
+	client     *http.Client
+	retryAfter time.Duration
+
+	mu      sync.Mutex
+	fetches map[types.NamespacedName]*publicKeyFetch
+}
+
+type logInfo struct {
+	SignedTreeHead string `json:"signedTreeHead"`
+	TreeID         string `json:"treeID"`
+}
+
+type publicKeyFetch struct {
+	url      string
+	treeID   int64
+	done     bool
+	key      []byte
+	err      error
+	finished time.Time
+}
+
+func NewPublicKeyFetcher(timeout, retryAfter time.Duration) *PublicKeyFetcher {
+	return &PublicKeyFetcher{
+		client:     &http.Client{Timeout: timeout},
+		retryAfter: retryAfter,
+		fetches:    make(map[types.NamespacedName]*publicKeyFetch),
+	}
+}
+
+// Fetch returns the public key of the Rekor server available on url.
+// When no result is available yet, it starts the background fetch (unless one is already running) and returns done == false.
+// Failed fetches are retried after the retryAfter period.
+func (f *PublicKeyFetcher) Fetch(ctx context.Context, key types.NamespacedName, url string, treeID int64) (pub []byte, done bool, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	current, ok := f.fetches[key]
+	if ok && current.url == url && current.treeID == treeID {
+		if !current.done {
+			return nil, false, nil
+		}
+		if current.err == nil || time.Since(current.finished) < f.retryAfter {
+			return current.key, true, current.err
+		}
+	}
+
+	fetch := &publicKeyFetch{url: url, treeID: treeID}
+	f.fetches[key] = fetch
+	go f.run(ctx, fetch)
+	return nil, false, nil
+}
+
+// Forget drops the cached result so the next Fetch call contacts the server again.
+func (f *PublicKeyFetcher) Forget(key types.NamespacedName) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.fetches, key)
+}
+
+func (f *PublicKeyFetcher) run(ctx context.Context, fetch *publicKeyFetch) {
+	pub, err := f.fetch(ctx, fetch.url, fetch.treeID)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	fetch.key, fetch.err, fetch.done, fetch.finished = pub, err, true, time.Now()
+}
+
+func (f *PublicKeyFetcher) fetch(ctx context.Context, url string, treeID int64) ([]byte, error) {
+	pub, err := get(ctx, f.client, url+publicKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("could not get public key: %w", err)
+	}
+
+	// the key is trusted only when it verifies the checkpoint of the expected tree
+	if _, err = NewLog(f.client, url, treeID, pub).Checkpoint(ctx); err != nil {
+		return nil, err
+	}
+	return pub, nil
+}
+
+func get(ctx context.Context, client *http.Client, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New("unexpected response status " + resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+}
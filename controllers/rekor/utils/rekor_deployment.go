@@ -3,8 +3,11 @@ package utils
 import (
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common"
 	"github.com/securesign/operator/controllers/constants"
 	apps "k8s.io/api/apps/v1"
 	core "k8s.io/api/core/v1"
@@ -21,10 +24,10 @@ func CreateRekorDeployment(instance *v1alpha1.Rekor, dpName string, sa string, l
 	env := make([]core.EnvVar, 0)
 	appArgs := []string{
 		"serve",
-		"--trillian_log_server.address=trillian-logserver." + instance.Namespace + ".svc",
+		"--trillian_log_server.address=" + constants.InstanceName("trillian-logserver", common.TrillianInstance(instance.Spec.Trillian, instance.Name)) + "." + instance.Namespace + ".svc",
 		"--trillian_log_server.port=8091",
 		"--trillian_log_server.sharding_config=/sharding/sharding-config.yaml",
-		"--redis_server.address=rekor-redis",
+		"--redis_server.address=" + constants.InstanceName("rekor-redis", instance.Name),
 		"--redis_server.port=6379",
 		"--rekor_server.address=0.0.0.0",
 		"--enable_retrieve_api=true",
@@ -32,6 +35,11 @@ func CreateRekorDeployment(instance *v1alpha1.Rekor, dpName string, sa string, l
 		"--enable_attestation_storage",
 		"--attestation_storage_bucket=file:///var/run/attestations",
 	}
+	serverArgs, err := serverTuningArgs(instance.Spec.Server)
+	if err != nil {
+		return nil, err
+	}
+	appArgs = append(appArgs, serverArgs...)
 	volumes := []core.Volume{
 		{
 			Name: "rekor-sharding-config",
@@ -171,3 +179,73 @@ func CreateRekorDeployment(instance *v1alpha1.Rekor, dpName string, sa string, l
 		},
 	}, nil
 }
+
+const (
+	routeRateLimit            = "haproxy.router.openshift.io/rate-limit-connections"
+	routeRateLimitHTTP        = "haproxy.router.openshift.io/rate-limit-connections.rate-http"
+	routeRateLimitTCP         = "haproxy.router.openshift.io/rate-limit-connections.concurrent-tcp"
+	nginxRateLimitRPS         = "nginx.ingress.kubernetes.io/limit-rps"
+	nginxRateLimitConnections = "nginx.ingress.kubernetes.io/limit-connections"
+)
+
+// RateLimitAnnotationKeys are the annotations managed by RateLimitAnnotations
+var RateLimitAnnotationKeys = []string{routeRateLimit, routeRateLimitHTTP, routeRateLimitTCP, nginxRateLimitRPS, nginxRateLimitConnections}
+
+func serverTuningArgs(server v1alpha1.RekorServer) ([]string, error) {
+	var args []string
+	if server.MaxRequestBodySize != nil {
+		if server.MaxRequestBodySize.Sign() < 0 {
+			return nil, errors.New("maxRequestBodySize must not be negative")
+		}
+		args = append(args, fmt.Sprintf("--max_request_body_size=%d", server.MaxRequestBodySize.Value()))
+	}
+	if server.MaxAttestationSize != nil {
+		if server.MaxAttestationSize.Sign() < 0 {
+			return nil, errors.New("maxAttestationSize must not be negative")
+		}
+		args = append(args, fmt.Sprintf("--max_attestation_size=%d", server.MaxAttestationSize.Value()))
+	}
+	if len(server.EnabledAPIEndpoints) > 0 {
+		endpoints := make([]string, 0, len(server.EnabledAPIEndpoints))
+		for _, e := range server.EnabledAPIEndpoints {
+			endpoints = append(endpoints, string(e))
+		}
+		args = append(args, "--enabled_api_endpoints="+strings.Join(endpoints, ","))
+	}
+	if len(server.EnabledEntryTypes) > 0 {
+		kinds := make([]string, 0, len(server.EnabledEntryTypes))
+		for _, k := range server.EnabledEntryTypes {
+			kinds = append(kinds, string(k))
+		}
+		args = append(args, "--enabled_entry_types="+strings.Join(kinds, ","))
+	}
+	return args, nil
+}
+
+// RateLimitAnnotations returns the annotations limiting the requests of a client on the Ingress of the server.
+// Both the OpenShift router and the NGINX ingress controller annotations are set, the OpenShift router counts
+// the requests in a 3 seconds window.
+func RateLimitAnnotations(limit *v1alpha1.RekorRateLimit) map[string]string {
+	annotations := make(map[string]string, len(RateLimitAnnotationKeys))
+	if limit == nil {
+		return annotations
+	}
+	annotations[routeRateLimit] = "true"
+	annotations[routeRateLimitHTTP] = strconv.Itoa(int(limit.RequestsPerSecond) * 3)
+	annotations[nginxRateLimitRPS] = strconv.Itoa(int(limit.RequestsPerSecond))
+	if limit.Connections != nil {
+		annotations[routeRateLimitTCP] = strconv.Itoa(int(*limit.Connections))
+		annotations[nginxRateLimitConnections] = strconv.Itoa(int(*limit.Connections))
+	}
+	return annotations
+}
+
+// UseTrillianTLS configures the Rekor server to verify the Trillian gRPC endpoint by the CA certificate from the ConfigMap.
+func UseTrillianTLS(dp *apps.Deployment, ca *core.ConfigMap) {
+	container := &dp.Spec.Template.Spec.Containers[0]
+	path := common.MountTrillianCA(&dp.Spec.Template.Spec, container, ca)
+	container.Args = append(container.Args,
+		"--trillian_log_server.tls=true",
+		"--trillian_log_server.tls_ca_cert="+path,
+	)
+}
@@ -0,0 +1,147 @@
+package utils
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common"
+	"github.com/securesign/operator/controllers/common/utils"
+	"github.com/securesign/operator/controllers/constants"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestServerTuningArgs(t *testing.T) {
+	g := NewWithT(t)
+
+	instance := createRekorInstance()
+	dp, err := CreateRekorDeployment(instance, "rekor-server", "sa", constants.LabelsFor("rekor", "rekor-server", instance.Name))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(dp.Spec.Template.Spec.Containers[0].Args).ShouldNot(ContainElement(HavePrefix("--max_request_body_size")))
+	g.Expect(dp.Spec.Template.Spec.Containers[0].Args).ShouldNot(ContainElement(HavePrefix("--max_attestation_size")))
+
+	instance.Spec.Server = v1alpha1.RekorServer{
+		MaxRequestBodySize: utils.Pointer(resource.MustParse("1Mi")),
+		MaxAttestationSize: utils.Pointer(resource.MustParse("200k")),
+	}
+	dp, err = CreateRekorDeployment(instance, "rekor-server", "sa", constants.LabelsFor("rekor", "rekor-server", instance.Name))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(dp.Spec.Template.Spec.Containers[0].Args).Should(ContainElements(
+		"--max_request_body_size=1048576",
+		"--max_attestation_size=200000",
+	))
+
+	instance.Spec.Server.EnabledAPIEndpoints = []v1alpha1.RekorAPIEndpoint{"getLogInfo", "getLogEntryByIndex"}
+	dp, err = CreateRekorDeployment(instance, "rekor-server", "sa", constants.LabelsFor("rekor", "rekor-server", instance.Name))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(dp.Spec.Template.Spec.Containers[0].Args).Should(ContainElement("--enabled_api_endpoints=getLogInfo,getLogEntryByIndex"))
+	g.Expect(dp.Spec.Template.Spec.Containers[0].Args).ShouldNot(ContainElement(HavePrefix("--enabled_entry_types")))
+
+	instance.Spec.Server.EnabledEntryTypes = []v1alpha1.RekorEntryType{"hashedrekord", "dsse"}
+	dp, err = CreateRekorDeployment(instance, "rekor-server", "sa", constants.LabelsFor("rekor", "rekor-server", instance.Name))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(dp.Spec.Template.Spec.Containers[0].Args).Should(ContainElement("--enabled_entry_types=hashedrekord,dsse"))
+
+	instance.Spec.Server.MaxAttestationSize = utils.Pointer(resource.MustParse("-1"))
+	_, err = CreateRekorDeployment(instance, "rekor-server", "sa", constants.LabelsFor("rekor", "rekor-server", instance.Name))
+	g.Expect(err).Should(HaveOccurred())
+}
+
+func TestRateLimitAnnotations(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(RateLimitAnnotations(nil)).Should(BeEmpty())
+
+	annotations := RateLimitAnnotations(&v1alpha1.RekorRateLimit{RequestsPerSecond: 10})
+	g.Expect(annotations).Should(Equal(map[string]string{
+		"haproxy.router.openshift.io/rate-limit-connections":           "true",
+		"haproxy.router.openshift.io/rate-limit-connections.rate-http": "30",
+		"nginx.ingress.kubernetes.io/limit-rps":                        "10",
+	}))
+
+	annotations = RateLimitAnnotations(&v1alpha1.RekorRateLimit{RequestsPerSecond: 10, Connections: utils.Pointer(int32(5))})
+	g.Expect(annotations).Should(HaveKeyWithValue("haproxy.router.openshift.io/rate-limit-connections.concurrent-tcp", "5"))
+	g.Expect(annotations).Should(HaveKeyWithValue("nginx.ingress.kubernetes.io/limit-connections", "5"))
+	for k := range annotations {
+		g.Expect(RateLimitAnnotationKeys).Should(ContainElement(k))
+	}
+}
+
+func TestTrillianAddress(t *testing.T) {
+	g := NewWithT(t)
+
+	instance := createRekorInstance()
+	dp, err := CreateRekorDeployment(instance, "rekor-server", "sa", constants.LabelsFor("rekor", "rekor-server", instance.Name))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(dp.Spec.Template.Spec.Containers[0].Args).Should(ContainElement("--trillian_log_server.address=trillian-logserver-rekor.default.svc"))
+
+	instance.Spec.Trillian.Name = "trillian-sample"
+	dp, err = CreateRekorDeployment(instance, "rekor-server", "sa", constants.LabelsFor("rekor", "rekor-server", instance.Name))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(dp.Spec.Template.Spec.Containers[0].Args).Should(ContainElement("--trillian_log_server.address=trillian-logserver-trillian-sample.default.svc"))
+}
+
+func createRekorInstance() *v1alpha1.Rekor {
+	return &v1alpha1.Rekor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rekor",
+			Namespace: "default",
+		},
+		Spec: v1alpha1.RekorSpec{
+			Signer: v1alpha1.RekorSigner{KMS: "memory"},
+		},
+		Status: v1alpha1.RekorStatus{
+			ServerConfigRef: &v1alpha1.LocalObjectReference{Name: "config"},
+			TreeID:          utils.Pointer(int64(1)),
+			PvcName:         "pvc",
+		},
+	}
+}
+
+func TestSearchUiDeployment(t *testing.T) {
+	g := NewWithT(t)
+
+	instance := createRekorInstance()
+	instance.Status.Url = "https://rekor.local"
+	labels := constants.LabelsFor("rekor-ui", "rekor-search-ui", instance.Name)
+
+	dp := CreateRekorSearchUiDeployment(instance, "rekor-search-ui", "rekor", labels)
+	g.Expect(dp.Spec.Template.Spec.Containers).Should(HaveLen(1))
+	g.Expect(dp.Spec.Template.Spec.Containers[0].Env[0].Value).Should(Equal("https://rekor.local"))
+
+	instance.Spec.RekorSearchUI = v1alpha1.RekorSearchUI{
+		Enabled:    utils.Pointer(true),
+		RekorUrl:   "https://rekor.sigstore.dev",
+		OAuthProxy: v1alpha1.OAuthProxy{Enabled: true},
+	}
+	dp = CreateRekorSearchUiDeployment(instance, "rekor-search-ui", "rekor-search-ui", labels)
+	g.Expect(dp.Spec.Template.Spec.Containers[0].Env[0].Value).Should(Equal("https://rekor.sigstore.dev"))
+	g.Expect(dp.Spec.Template.Spec.Containers).Should(HaveLen(2))
+	g.Expect(dp.Spec.Template.Spec.Containers[1].Args).Should(ContainElements(
+		"--openshift-service-account=rekor-search-ui",
+		`--openshift-sar={"namespace":"default","resource":"rekors","resourceName":"rekor","group":"rhtas.redhat.com","verb":"get"}`,
+	))
+	g.Expect(dp.Spec.Template.Spec.Volumes).Should(HaveLen(2))
+}
+
+func TestUseTrillianTLS(t *testing.T) {
+	g := NewWithT(t)
+
+	instance := createRekorInstance()
+	dp, err := CreateRekorDeployment(instance, "rekor-server", "sa", constants.LabelsFor("rekor", "rekor-server", instance.Name))
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	UseTrillianTLS(dp, &core.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "trillian-ca",
+			Labels: map[string]string{common.TrillianCALabel: "service-ca.crt"},
+		},
+	})
+	g.Expect(dp.Spec.Template.Spec.Containers[0].Args).Should(ContainElements(
+		"--trillian_log_server.tls=true",
+		"--trillian_log_server.tls_ca_cert=/var/run/secrets/trillian-ca/service-ca.crt",
+	))
+	g.Expect(dp.Spec.Template.Spec.Volumes).Should(ContainElement(HaveField("ConfigMap.Name", "trillian-ca")))
+}
@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"fmt"
+
 	"github.com/securesign/operator/api/v1alpha1"
 	"github.com/securesign/operator/controllers/constants"
 	apps "k8s.io/api/apps/v1"
@@ -8,9 +10,104 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	SearchUiPort       = 3000
+	OAuthProxyPort     = 8443
+	OAuthProxyPortName = "oauth-proxy"
+)
+
+// SearchUiRekorUrl returns the URL of the Rekor server queried by the search UI.
+func SearchUiRekorUrl(instance *v1alpha1.Rekor) string {
+	if instance.Spec.RekorSearchUI.RekorUrl != "" {
+		return instance.Spec.RekorSearchUI.RekorUrl
+	}
+	return instance.Status.Url
+}
+
 func CreateRekorSearchUiDeployment(instance *v1alpha1.Rekor, dpName string, sa string, labels map[string]string) *apps.Deployment {
 	replicas := int32(1)
 
+	containers := []core.Container{
+		{
+			Name: dpName,
+			Env: []core.EnvVar{
+				{
+					Name:  "NEXT_PUBLIC_REKOR_DEFAULT_DOMAIN",
+					Value: SearchUiRekorUrl(instance),
+				},
+			},
+			Image: constants.RekorSearchUiImage,
+			Ports: []core.ContainerPort{
+				{
+					ContainerPort: SearchUiPort,
+					Name:          "3000-tcp",
+					Protocol:      "TCP",
+				},
+			},
+		},
+	}
+	var volumes []core.Volume
+
+	if instance.Spec.RekorSearchUI.OAuthProxy.Enabled {
+		verb := instance.Spec.RekorSearchUI.OAuthProxy.Verb
+		if verb == "" {
+			verb = "get"
+		}
+		containers = append(containers, core.Container{
+			Name:  "oauth-proxy",
+			Image: constants.OAuthProxyImage,
+			Args: []string{
+				"--provider=openshift",
+				fmt.Sprintf("--https-address=:%d", OAuthProxyPort),
+				"--http-address=",
+				fmt.Sprintf("--upstream=http://localhost:%d", SearchUiPort),
+				"--tls-cert=/etc/tls/private/tls.crt",
+				"--tls-key=/etc/tls/private/tls.key",
+				"--cookie-secret-file=/etc/proxy/secrets/session_secret",
+				"--openshift-service-account=" + sa,
+				fmt.Sprintf(`--openshift-sar={"namespace":"%s","resource":"rekors","resourceName":"%s","group":"rhtas.redhat.com","verb":"%s"}`,
+					instance.Namespace, instance.Name, verb),
+			},
+			Ports: []core.ContainerPort{
+				{
+					ContainerPort: OAuthProxyPort,
+					Name:          OAuthProxyPortName,
+					Protocol:      "TCP",
+				},
+			},
+			VolumeMounts: []core.VolumeMount{
+				{
+					Name:      "oauth-tls",
+					MountPath: "/etc/tls/private",
+					ReadOnly:  true,
+				},
+				{
+					Name:      "oauth-session",
+					MountPath: "/etc/proxy/secrets",
+					ReadOnly:  true,
+				},
+			},
+		})
+		volumes = []core.Volume{
+			{
+				Name: "oauth-tls",
+				VolumeSource: core.VolumeSource{
+					Secret: &core.SecretVolumeSource{
+						SecretName: dpName + "-tls",
+					},
+				},
+			},
+			{
+				Name: "oauth-session",
+				VolumeSource: core.VolumeSource{
+					Secret: &core.SecretVolumeSource{
+						SecretName: dpName + "-oauth",
+					},
+				},
+			},
+		}
+	}
+
 	return &apps.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      dpName,
@@ -28,25 +125,8 @@ func CreateRekorSearchUiDeployment(instance *v1alpha1.Rekor, dpName string, sa s
 				},
 				Spec: core.PodSpec{
 					ServiceAccountName: sa,
-					Containers: []core.Container{
-						{
-							Name: dpName,
-							Env: []core.EnvVar{
-								{
-									Name:  "NEXT_PUBLIC_REKOR_DEFAULT_DOMAIN",
-									Value: instance.Status.Url,
-								},
-							},
-							Image: constants.RekorSearchUiImage,
-							Ports: []core.ContainerPort{
-								{
-									ContainerPort: 3000,
-									Name:          "3000-tcp",
-									Protocol:      "TCP",
-								},
-							},
-						},
-					},
+					Containers:         containers,
+					Volumes:            volumes,
 				},
 			},
 		},
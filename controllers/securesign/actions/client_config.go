@@ -0,0 +1,162 @@
+package actions
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/action"
+	"github.com/securesign/operator/controllers/common/utils/kubernetes"
+	"github.com/securesign/operator/controllers/constants"
+	"github.com/securesign/operator/controllers/securesign/utils"
+	tufactions "github.com/securesign/operator/controllers/tuf/actions"
+	tufutils "github.com/securesign/operator/controllers/tuf/utils"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func NewClientConfigAction() action.Action[rhtasv1alpha1.Securesign] {
+	return &clientConfigAction{}
+}
+
+type clientConfigAction struct {
+	action.BaseAction
+}
+
+func (i clientConfigAction) Name() string {
+	return "client config"
+}
+
+func (i clientConfigAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.Securesign) bool {
+	return meta.FindStatusCondition(instance.Status.Conditions, constants.Ready) != nil && instance.Status.TufStatus.Url != ""
+}
+
+// Handle generates the client settings from the statuses of the components.
+// They are stored in a ConfigMap of the Securesign and as a shell file in the ConfigMap served by the CLI server.
+func (i clientConfigAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Securesign) *action.Result {
+	config, err := i.clientConfig(ctx, instance)
+	if err != nil {
+		return i.Failed(fmt.Errorf("could not resolve client configuration: %w", err))
+	}
+
+	name := fmt.Sprintf(ClientConfigNameFormat, instance.Name)
+	cm := &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: instance.Namespace}}
+	if _, err = controllerutil.CreateOrUpdate(ctx, i.Client, cm, func() error {
+		cm.Labels = constants.LabelsFor(ClientConfigComponentName, name, instance.Name)
+		cm.Data = config.Data()
+		return controllerutil.SetControllerReference(instance, cm, i.Client.Scheme())
+	}); err != nil {
+		return i.Failed(fmt.Errorf("could not store client configuration: %w", err))
+	}
+
+	status := &rhtasv1alpha1.SecuresignClientConfigStatus{ConfigMapRef: &rhtasv1alpha1.LocalObjectReference{Name: cm.Name}}
+	if status.Url, err = i.serve(ctx, instance, config); err != nil {
+		return i.Failed(fmt.Errorf("could not publish client configuration: %w", err))
+	}
+
+	if equality.Semantic.DeepEqual(instance.Status.ClientConfig, status) {
+		return i.Continue()
+	}
+	instance.Status.ClientConfig = status
+	return i.StatusUpdate(ctx, instance)
+}
+
+func (i clientConfigAction) clientConfig(ctx context.Context, instance *rhtasv1alpha1.Securesign) (utils.ClientConfig, error) {
+	config := utils.ClientConfig{
+		TufURL:    instance.Status.TufStatus.Url,
+		FulcioURL: instance.Status.FulcioStatus.Url,
+		RekorURL:  instance.Status.RekorStatus.Url,
+		CTlogURL:  instance.Status.CTlogStatus.Url,
+	}
+	if issuers := instance.Spec.Fulcio.Config.OIDCIssuers; instance.Spec.Fulcio.Mode != rhtasv1alpha1.ComponentDisabled && len(issuers) > 0 {
+		config.OIDCIssuerURL = issuers[0].IssuerURL
+		if config.OIDCIssuerURL == "" {
+			config.OIDCIssuerURL = issuers[0].Issuer
+		}
+		config.OIDCClientID = issuers[0].ClientID
+	}
+	if managed(instance.Spec.Tuf.Mode) {
+		repository := &v1.ConfigMap{}
+		err := i.Client.Get(ctx, types.NamespacedName{Name: constants.InstanceName(tufactions.RepositoryName, instance.Name), Namespace: instance.Namespace}, repository)
+		if client.IgnoreNotFound(err) != nil {
+			return config, err
+		}
+		if root, ok := repository.Data[tufutils.RootRole+".json"]; ok {
+			sum := sha256.Sum256([]byte(root))
+			config.TufRootChecksum = hex.EncodeToString(sum[:])
+		}
+	}
+	return config, nil
+}
+
+// serve stores the shell file in the ConfigMap served by the CLI server and returns its URL.
+// The URL is empty when the ClientServer doesn't exist or isn't ready yet.
+func (i clientConfigAction) serve(ctx context.Context, instance *rhtasv1alpha1.Securesign, config utils.ClientConfig) (string, error) {
+	server, err := clientServer(ctx, i.Client)
+	if server == nil || err != nil {
+		return "", err
+	}
+	cm := &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: constants.ClientConfigMapName, Namespace: server.Spec.Namespace}}
+	if _, err = controllerutil.CreateOrUpdate(ctx, i.Client, cm, func() error {
+		if cm.Labels == nil {
+			cm.Labels = map[string]string{}
+		}
+		cm.Labels["app.kubernetes.io/part-of"] = constants.AppName
+		cm.Labels[kubernetes.ComponentLabel] = ClientConfigComponentName
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		cm.Data[clientConfigFile(instance)] = string(config.Env(instance.Namespace + "/" + instance.Name))
+		return nil
+	}); err != nil {
+		// the namespace of the CLI server isn't created yet
+		if apierrors.IsNotFound(err) {
+			i.Logger.V(1).Info("CLI server namespace doesn't exist, the client configuration isn't served")
+			return "", nil
+		}
+		return "", err
+	}
+	if server.Status.Url == "" {
+		return "", nil
+	}
+	return server.Status.Url + "/config/" + clientConfigFile(instance), nil
+}
+
+// clientServer returns the ClientServer serving the clients, nil when it doesn't exist.
+func clientServer(ctx context.Context, c client.Client) (*rhtasv1alpha1.ClientServer, error) {
+	server := &rhtasv1alpha1.ClientServer{}
+	if err := c.Get(ctx, types.NamespacedName{Name: constants.ClientServerName}, server); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	return server, nil
+}
+
+// clientConfigFile is the name of the shell file of the Securesign served by the CLI server.
+func clientConfigFile(instance *rhtasv1alpha1.Securesign) string {
+	return instance.Namespace + "." + instance.Name + ".env"
+}
+
+// RemoveClientConfig removes the shell file of the Securesign from the ConfigMap served by the CLI server.
+func RemoveClientConfig(ctx context.Context, c client.Client, instance *rhtasv1alpha1.Securesign) error {
+	server, err := clientServer(ctx, c)
+	if server == nil || err != nil {
+		return err
+	}
+	cm := &v1.ConfigMap{}
+	if err = c.Get(ctx, types.NamespacedName{Name: constants.ClientConfigMapName, Namespace: server.Spec.Namespace}, cm); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if _, ok := cm.Data[clientConfigFile(instance)]; !ok {
+		return nil
+	}
+	delete(cm.Data, clientConfigFile(instance))
+	return c.Update(ctx, cm)
+}
@@ -0,0 +1,83 @@
+package actions
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+	testAction "github.com/securesign/operator/controllers/common/test/action"
+	"github.com/securesign/operator/controllers/constants"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestClientConfigAction(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.TODO()
+
+	instance := &v1alpha1.Securesign{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample", Namespace: "default", UID: "uid"},
+		Spec: v1alpha1.SecuresignSpec{
+			Fulcio: v1alpha1.SecuresignFulcioSpec{FulcioSpec: v1alpha1.FulcioSpec{
+				Config: v1alpha1.FulcioConfig{OIDCIssuers: []v1alpha1.OIDCIssuer{{Issuer: "https://oidc.example.com", ClientID: "trusted-artifact-signer"}}},
+			}},
+		},
+		Status: v1alpha1.SecuresignStatus{
+			TufStatus:    v1alpha1.SecuresignTufStatus{Url: "https://tuf.example.com"},
+			FulcioStatus: v1alpha1.SecuresignFulcioStatus{Url: "https://fulcio.example.com"},
+			RekorStatus:  v1alpha1.SecuresignRekorStatus{Url: "https://rekor.example.com"},
+		},
+	}
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{Type: constants.Ready, Status: metav1.ConditionTrue, Reason: constants.Ready})
+
+	repository := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "tuf-repository-sample", Namespace: "default"},
+		Data:       map[string]string{"root.json": "{}"},
+	}
+	server := &v1alpha1.ClientServer{
+		ObjectMeta: metav1.ObjectMeta{Name: constants.ClientServerName},
+		Spec:       v1alpha1.ClientServerSpec{Namespace: "cli"},
+		Status:     v1alpha1.ClientServerStatus{Url: "https://cli-server.example.com"},
+	}
+	c := testAction.FakeClientBuilder().WithStatusSubresource(instance, server).WithObjects(instance, repository, server).Build()
+
+	a := testAction.PrepareAction(c, NewClientConfigAction())
+	g.Expect(a.CanHandle(ctx, instance)).To(BeTrue())
+	g.Expect(a.Handle(ctx, instance)).ToNot(BeNil())
+
+	sum := sha256.Sum256([]byte("{}"))
+	cm := &v1.ConfigMap{}
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: "sample-client-config", Namespace: "default"}, cm)).To(Succeed())
+	g.Expect(metav1.IsControlledBy(cm, instance)).To(BeTrue())
+	g.Expect(cm.Data).To(HaveKeyWithValue("TUF_URL", "https://tuf.example.com"))
+	g.Expect(cm.Data).To(HaveKeyWithValue("TUF_ROOT_CHECKSUM", hex.EncodeToString(sum[:])))
+	g.Expect(cm.Data).To(HaveKeyWithValue("OIDC_ISSUER_URL", "https://oidc.example.com"))
+	g.Expect(cm.Data).To(HaveKeyWithValue("OIDC_CLIENT_ID", "trusted-artifact-signer"))
+	g.Expect(cm.Data).ToNot(HaveKey("CTLOG_URL"))
+
+	served := &v1.ConfigMap{}
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: constants.ClientConfigMapName, Namespace: "cli"}, served)).To(Succeed())
+	g.Expect(served.Data).To(HaveKeyWithValue("default.sample.env", ContainSubstring("export REKOR_URL='https://rekor.example.com'")))
+
+	g.Expect(instance.Status.ClientConfig.ConfigMapRef.Name).To(Equal("sample-client-config"))
+	g.Expect(instance.Status.ClientConfig.Url).To(Equal("https://cli-server.example.com/config/default.sample.env"))
+
+	// nothing left to change
+	g.Expect(a.Handle(ctx, instance)).To(BeNil())
+
+	// the URL follows the ClientServer
+	server.Status.Url = "https://cli.apps.example.com"
+	g.Expect(c.Status().Update(ctx, server)).To(Succeed())
+	g.Expect(a.Handle(ctx, instance)).ToNot(BeNil())
+	g.Expect(instance.Status.ClientConfig.Url).To(Equal("https://cli.apps.example.com/config/default.sample.env"))
+
+	// the file is removed with the Securesign
+	g.Expect(RemoveClientConfig(ctx, c, instance)).To(Succeed())
+	g.Expect(c.Get(ctx, types.NamespacedName{Name: constants.ClientConfigMapName, Namespace: "cli"}, served)).To(Succeed())
+	g.Expect(served.Data).ToNot(HaveKey("default.sample.env"))
+}
@@ -0,0 +1,46 @@
+package actions
+
+import (
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/constants"
+	"k8s.io/apimachinery/pkg/api/meta"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// copyCondition copies the Ready condition of the component to the condition of the Securesign.
+// It returns true when the condition changed.
+func copyCondition(instance *rhtasv1alpha1.Securesign, conditionType string, ready *v1.Condition) bool {
+	current := meta.FindStatusCondition(instance.Status.Conditions, conditionType)
+	if current != nil && current.Status == ready.Status && current.Reason == ready.Reason && current.Message == ready.Message {
+		return false
+	}
+	meta.SetStatusCondition(&instance.Status.Conditions, v1.Condition{
+		Type:    conditionType,
+		Status:  ready.Status,
+		Reason:  ready.Reason,
+		Message: ready.Message,
+	})
+	return true
+}
+
+// componentStatus summarizes the managed component.
+// The last failure is kept until the component is ready again.
+func componentStatus(object client.Object, conditions []v1.Condition, previous rhtasv1alpha1.SecuresignComponentStatus, image string) rhtasv1alpha1.SecuresignComponentStatus {
+	status := rhtasv1alpha1.SecuresignComponentStatus{
+		Mode:               rhtasv1alpha1.ComponentManaged,
+		Image:              image,
+		LastError:          previous.LastError,
+		ObservedGeneration: object.GetGeneration(),
+	}
+	if meta.IsStatusConditionTrue(conditions, constants.Ready) {
+		status.LastError = ""
+	}
+	for _, c := range conditions {
+		if c.Reason == constants.Failure && c.Message != "" {
+			status.LastError = c.Message
+			break
+		}
+	}
+	return status
+}
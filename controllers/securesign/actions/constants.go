@@ -1,14 +1,19 @@
 package actions
 
 const (
-	TufCondition             = "TufAvailable"
-	FulcioCondition          = "FulcioAvailable"
-	RekorCondition           = "RekorAvailable"
-	TrillianCondition        = "TrillianAvailable"
-	CTlogCondition           = "CTlogAvailable"
-	SegmentBackupCronJobName = "segment-backup-nightly-metrics"
-	SegmentBackupJobName     = "segment-backup-installation"
-	SegmentRBACName          = "rhtas-segment-backup-job"
-	MetricsCondition         = "MetricsAvailable"
-	AnalyiticsCronSchedule   = " 0 0 * * *"
+	TufCondition              = "TufAvailable"
+	FulcioCondition           = "FulcioAvailable"
+	RekorCondition            = "RekorAvailable"
+	TrillianCondition         = "TrillianAvailable"
+	CTlogCondition            = "CTlogAvailable"
+	SegmentBackupCronJobName  = "segment-backup-nightly-metrics"
+	SegmentBackupJobName      = "segment-backup-installation"
+	SegmentRBACName           = "rhtas-segment-backup-job"
+	MetricsCondition          = "MetricsAvailable"
+	AnalyiticsCronSchedule    = " 0 0 * * *"
+	TrustedRootComponentName  = "trusted-root"
+	TrustedRootNameFormat     = "%s-trusted-root"
+	ClientConfigComponentName = "client-config"
+	ClientConfigNameFormat    = "%s-client-config"
+	TSATargetName             = "tsa.certchain.pem"
 )
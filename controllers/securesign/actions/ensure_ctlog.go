@@ -7,6 +7,7 @@ import (
 	"github.com/securesign/operator/controllers/common/action"
 	"github.com/securesign/operator/controllers/constants"
 	"github.com/securesign/operator/controllers/ctlog/actions"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/meta"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -25,8 +26,8 @@ func (i ctlogAction) Name() string {
 	return "create ctlog"
 }
 
-func (i ctlogAction) CanHandle(context.Context, *rhtasv1alpha1.Securesign) bool {
-	return true
+func (i ctlogAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.Securesign) bool {
+	return managed(instance.Spec.Ctlog.Mode)
 }
 
 func (i ctlogAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Securesign) *action.Result {
@@ -40,7 +41,16 @@ func (i ctlogAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Secures
 	ctlog.Namespace = instance.Namespace
 	ctlog.Labels = constants.LabelsFor(actions.ComponentName, ctlog.Name, instance.Name)
 
-	ctlog.Spec = instance.Spec.Ctlog
+	ctlog.Spec = instance.Spec.Ctlog.CTlogSpec
+	if ctlog.Spec.Trillian.Name == "" {
+		// the Trillian of the Securesign
+		ctlog.Spec.Trillian.Name = instance.Name
+	}
+	if external := instance.Spec.Fulcio.External; instance.Spec.Fulcio.Mode == rhtasv1alpha1.ComponentExternal &&
+		len(ctlog.Spec.RootCertificates) == 0 && external != nil && external.KeyRef != nil {
+		// accept the certificates of the external Fulcio
+		ctlog.Spec.RootCertificates = []rhtasv1alpha1.SecretKeySelector{*external.KeyRef}
+	}
 
 	if err = controllerutil.SetControllerReference(instance, ctlog, i.Client.Scheme()); err != nil {
 		return i.Failed(err)
@@ -79,12 +89,15 @@ func (i ctlogAction) CopyStatus(ctx context.Context, ok client.ObjectKey, instan
 		// not initialized yet, wait for update
 		return i.Continue()
 	}
-	if !meta.IsStatusConditionPresentAndEqual(instance.Status.Conditions, CTlogCondition, objectStatus.Status) {
-		meta.SetStatusCondition(&instance.Status.Conditions, v1.Condition{
-			Type:   CTlogCondition,
-			Status: objectStatus.Status,
-			Reason: objectStatus.Reason,
-		})
+	status := rhtasv1alpha1.SecuresignCTlogStatus{
+		SecuresignComponentStatus: componentStatus(ctl, ctl.Status.Conditions, instance.Status.CTlogStatus.SecuresignComponentStatus, constants.CTLogImage),
+		// the URL changes with the prefix while the CTlog stays ready
+		Url:          ctl.Status.Url,
+		TreeID:       ctl.Status.TreeID,
+		PublicKeyRef: ctl.Status.PublicKeyRef,
+	}
+	if copyCondition(instance, CTlogCondition, objectStatus) || !equality.Semantic.DeepEqual(status, instance.Status.CTlogStatus) {
+		instance.Status.CTlogStatus = status
 		return i.StatusUpdate(ctx, instance)
 	}
 	return i.Continue()
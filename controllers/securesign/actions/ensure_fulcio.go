@@ -2,11 +2,16 @@ package actions
 
 import (
 	"context"
+	"fmt"
+	"reflect"
 
 	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
 	"github.com/securesign/operator/controllers/common/action"
+	k8sutils "github.com/securesign/operator/controllers/common/utils/kubernetes"
 	"github.com/securesign/operator/controllers/constants"
 	"github.com/securesign/operator/controllers/fulcio/actions"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/meta"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -25,8 +30,8 @@ func (i fulcioAction) Name() string {
 	return "create fulcio"
 }
 
-func (i fulcioAction) CanHandle(context.Context, *rhtasv1alpha1.Securesign) bool {
-	return true
+func (i fulcioAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.Securesign) bool {
+	return managed(instance.Spec.Fulcio.Mode)
 }
 
 func (i fulcioAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Securesign) *action.Result {
@@ -40,7 +45,11 @@ func (i fulcioAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Secure
 	fulcio.Namespace = instance.Namespace
 	fulcio.Labels = constants.LabelsFor(actions.ComponentName, fulcio.Name, instance.Name)
 
-	fulcio.Spec = instance.Spec.Fulcio
+	fulcio.Spec = instance.Spec.Fulcio.FulcioSpec
+	if fulcio.Spec.Ctlog.Address == "" && fulcio.Spec.Ctlog.Prefix == "" {
+		// submit the certificates to the managed CTlog
+		fulcio.Spec.Ctlog.Prefix = instance.Spec.Ctlog.Prefix
+	}
 
 	if err = controllerutil.SetControllerReference(instance, fulcio, i.Client.Scheme()); err != nil {
 		return i.Failed(err)
@@ -79,16 +88,41 @@ func (i fulcioAction) CopyStatus(ctx context.Context, ok client.ObjectKey, insta
 		// not initialized yet, wait for update
 		return i.Continue()
 	}
-	if !meta.IsStatusConditionPresentAndEqual(instance.Status.Conditions, FulcioCondition, objectStatus.Status) {
-		meta.SetStatusCondition(&instance.Status.Conditions, v1.Condition{
-			Type:   FulcioCondition,
-			Status: objectStatus.Status,
-			Reason: objectStatus.Reason,
-		})
-		if objectStatus.Status == v1.ConditionTrue {
-			instance.Status.FulcioStatus.Url = object.Status.Url
+	status := rhtasv1alpha1.SecuresignFulcioStatus{
+		SecuresignComponentStatus: componentStatus(object, object.Status.Conditions, instance.Status.FulcioStatus.SecuresignComponentStatus, constants.FulcioServerImage),
+		Url:                       object.Status.Url,
+	}
+	if object.Status.Certificate != nil && object.Status.Certificate.CARef != nil {
+		status.CARef = object.Status.Certificate.CARef
+		status.CANotAfter = instance.Status.FulcioStatus.CANotAfter
+		if !reflect.DeepEqual(status.CARef, instance.Status.FulcioStatus.CARef) || status.CANotAfter == nil {
+			notAfter, err := i.caNotAfter(instance.Namespace, status.CARef)
+			if err != nil {
+				i.Logger.Error(err, "could not read the Fulcio CA certificate")
+			}
+			status.CANotAfter = notAfter
 		}
+	}
+	if copyCondition(instance, FulcioCondition, objectStatus) || !equality.Semantic.DeepEqual(status, instance.Status.FulcioStatus) {
+		instance.Status.FulcioStatus = status
 		return i.StatusUpdate(ctx, instance)
 	}
 	return i.Continue()
 }
+
+// caNotAfter returns the expiration of the first certificate of the Fulcio CA chain.
+func (i fulcioAction) caNotAfter(namespace string, ref *rhtasv1alpha1.SecretKeySelector) (*v1.Time, error) {
+	data, err := k8sutils.GetSecretData(i.Client, namespace, ref)
+	if err != nil {
+		return nil, err
+	}
+	certs, err := cryptoutils.UnmarshalCertificatesFromPEM(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no certificate found in secret %s", ref.Name)
+	}
+	notAfter := v1.NewTime(certs[0].NotAfter)
+	return &notAfter, nil
+}
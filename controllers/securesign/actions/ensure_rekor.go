@@ -6,6 +6,7 @@ import (
 	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
 	"github.com/securesign/operator/controllers/common/action"
 	"github.com/securesign/operator/controllers/constants"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/meta"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -24,8 +25,8 @@ func (i rekorAction) Name() string {
 	return "create rekor"
 }
 
-func (i rekorAction) CanHandle(context.Context, *rhtasv1alpha1.Securesign) bool {
-	return true
+func (i rekorAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.Securesign) bool {
+	return managed(instance.Spec.Rekor.Mode)
 }
 
 func (i rekorAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Securesign) *action.Result {
@@ -39,7 +40,11 @@ func (i rekorAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Secures
 	rekor.Namespace = instance.Namespace
 	rekor.Labels = constants.LabelsFor("rekor", rekor.Name, instance.Name)
 
-	rekor.Spec = instance.Spec.Rekor
+	rekor.Spec = instance.Spec.Rekor.RekorSpec
+	if rekor.Spec.Trillian.Name == "" {
+		// the Trillian of the Securesign
+		rekor.Spec.Trillian.Name = instance.Name
+	}
 
 	if err = controllerutil.SetControllerReference(instance, rekor, i.Client.Scheme()); err != nil {
 		return i.Failed(err)
@@ -78,15 +83,15 @@ func (i rekorAction) CopyStatus(ctx context.Context, ok client.ObjectKey, instan
 		// not initialized yet, wait for update
 		return i.Continue()
 	}
-	if !meta.IsStatusConditionPresentAndEqual(instance.Status.Conditions, RekorCondition, objectStatus.Status) {
-		meta.SetStatusCondition(&instance.Status.Conditions, v1.Condition{
-			Type:   RekorCondition,
-			Status: objectStatus.Status,
-			Reason: objectStatus.Reason,
-		})
-		if objectStatus.Status == v1.ConditionTrue {
-			instance.Status.RekorStatus.Url = object.Status.Url
-		}
+	status := rhtasv1alpha1.SecuresignRekorStatus{
+		SecuresignComponentStatus: componentStatus(object, object.Status.Conditions, instance.Status.RekorStatus.SecuresignComponentStatus, constants.RekorServerImage),
+		Url:                       object.Status.Url,
+		RekorSearchUIUrl:          object.Status.RekorSearchUIUrl,
+		TreeID:                    object.Status.TreeID,
+		PublicKeyRef:              object.Status.PublicKeyRef,
+	}
+	if copyCondition(instance, RekorCondition, objectStatus) || !equality.Semantic.DeepEqual(status, instance.Status.RekorStatus) {
+		instance.Status.RekorStatus = status
 		return i.StatusUpdate(ctx, instance)
 	}
 	return i.Continue()
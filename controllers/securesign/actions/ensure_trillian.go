@@ -6,6 +6,7 @@ import (
 	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
 	"github.com/securesign/operator/controllers/common/action"
 	"github.com/securesign/operator/controllers/constants"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/meta"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -24,8 +25,8 @@ func (i trillianAction) Name() string {
 	return "create trillian"
 }
 
-func (i trillianAction) CanHandle(context.Context, *rhtasv1alpha1.Securesign) bool {
-	return true
+func (i trillianAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.Securesign) bool {
+	return managed(instance.Spec.Trillian.Mode)
 }
 
 func (i trillianAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Securesign) *action.Result {
@@ -39,7 +40,7 @@ func (i trillianAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Secu
 	trillian.Namespace = instance.Namespace
 	trillian.Labels = constants.LabelsFor("trillian", trillian.Name, instance.Name)
 
-	trillian.Spec = instance.Spec.Trillian
+	trillian.Spec = instance.Spec.Trillian.TrillianSpec
 
 	if err = controllerutil.SetControllerReference(instance, trillian, i.Client.Scheme()); err != nil {
 		return i.Failed(err)
@@ -78,12 +79,12 @@ func (i trillianAction) CopyStatus(ctx context.Context, ok client.ObjectKey, ins
 		// not initialized yet, wait for update
 		return i.Continue()
 	}
-	if meta.FindStatusCondition(instance.Status.Conditions, TrillianCondition).Reason != objectStatus.Reason {
-		meta.SetStatusCondition(&instance.Status.Conditions, v1.Condition{
-			Type:   TrillianCondition,
-			Status: objectStatus.Status,
-			Reason: objectStatus.Reason,
-		})
+	status := rhtasv1alpha1.SecuresignTrillianStatus{
+		SecuresignComponentStatus: componentStatus(object, object.Status.Conditions, instance.Status.TrillianStatus.SecuresignComponentStatus, constants.TrillianServerImage),
+		SchemaVersion:             object.Status.SchemaVersion,
+	}
+	if copyCondition(instance, TrillianCondition, objectStatus) || !equality.Semantic.DeepEqual(status, instance.Status.TrillianStatus) {
+		instance.Status.TrillianStatus = status
 		return i.StatusUpdate(ctx, instance)
 	}
 	return i.Continue()
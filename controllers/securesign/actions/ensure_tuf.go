@@ -2,11 +2,14 @@ package actions
 
 import (
 	"context"
+	"slices"
 
 	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
 	"github.com/securesign/operator/controllers/common/action"
 	"github.com/securesign/operator/controllers/constants"
+	"github.com/securesign/operator/controllers/securesign/utils"
 	"github.com/securesign/operator/controllers/tuf/actions"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/meta"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -25,8 +28,8 @@ func (i tufAction) Name() string {
 	return "create tuf"
 }
 
-func (i tufAction) CanHandle(context.Context, *rhtasv1alpha1.Securesign) bool {
-	return true
+func (i tufAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.Securesign) bool {
+	return managed(instance.Spec.Tuf.Mode)
 }
 
 func (i tufAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Securesign) *action.Result {
@@ -40,7 +43,14 @@ func (i tufAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Securesig
 	tuf.Namespace = instance.Namespace
 	tuf.Labels = constants.LabelsFor(actions.ComponentName, tuf.Name, instance.Name)
 
-	tuf.Spec = instance.Spec.Tuf
+	tuf.Spec = instance.Spec.Tuf.TufSpec
+	tuf.Spec.Keys = componentTargets(tuf.Spec.Keys, instance)
+	if instance.Spec.Tsa != nil {
+		tuf.Spec.Keys = referenceTarget(tuf.Spec.Keys, TSATargetName, instance.Spec.Tsa.CertChainRef.DeepCopy())
+	}
+	if instance.Status.TrustedRoot != nil && instance.Status.TrustedRoot.SecretRef != nil {
+		tuf.Spec.Keys = trustedRootTargets(tuf.Spec.Keys, instance.Status.TrustedRoot.SecretRef.Name)
+	}
 
 	if err = controllerutil.SetControllerReference(instance, tuf, i.Client.Scheme()); err != nil {
 		return i.Failed(err)
@@ -79,16 +89,71 @@ func (i tufAction) CopyStatus(ctx context.Context, ok client.ObjectKey, instance
 		// not initialized yet, wait for update
 		return i.Continue()
 	}
-	if !meta.IsStatusConditionPresentAndEqual(instance.Status.Conditions, TufCondition, objectStatus.Status) {
-		meta.SetStatusCondition(&instance.Status.Conditions, v1.Condition{
-			Type:   TufCondition,
-			Status: objectStatus.Status,
-			Reason: objectStatus.Reason,
-		})
-		if objectStatus.Status == v1.ConditionTrue {
-			instance.Status.TufStatus.Url = object.Status.Url
-		}
+	status := rhtasv1alpha1.SecuresignTufStatus{
+		SecuresignComponentStatus: componentStatus(object, object.Status.Conditions, instance.Status.TufStatus.SecuresignComponentStatus, constants.TufImage),
+		Url:                       object.Status.Url,
+	}
+	if object.Status.Root != nil {
+		status.RootVersion = object.Status.Root.PublishedVersion
+	}
+	if copyCondition(instance, TufCondition, objectStatus) || !equality.Semantic.DeepEqual(status, instance.Status.TufStatus) {
+		instance.Status.TufStatus = status
 		return i.StatusUpdate(ctx, instance)
 	}
 	return i.Continue()
 }
+
+// componentTargets references the keys of the external components and removes the keys of the disabled components.
+// The keys of the managed components are resolved by the TUF.
+func componentTargets(keys []rhtasv1alpha1.TufKey, instance *rhtasv1alpha1.Securesign) []rhtasv1alpha1.TufKey {
+	modes := map[string]struct {
+		mode     rhtasv1alpha1.ComponentMode
+		external *rhtasv1alpha1.ExternalService
+	}{
+		"fulcio_v1.crt.pem": {instance.Spec.Fulcio.Mode, instance.Spec.Fulcio.External},
+		"ctfe.pub":          {instance.Spec.Ctlog.Mode, instance.Spec.Ctlog.External},
+		"rekor.pub":         {instance.Spec.Rekor.Mode, instance.Spec.Rekor.External},
+	}
+	targets := make([]rhtasv1alpha1.TufKey, 0, len(keys))
+	for _, k := range keys {
+		c, ok := modes[k.Name]
+		if ok && k.SecretRef == nil {
+			switch c.mode {
+			case rhtasv1alpha1.ComponentDisabled:
+				continue
+			case rhtasv1alpha1.ComponentExternal:
+				if c.external != nil && c.external.KeyRef != nil {
+					k.SecretRef = c.external.KeyRef.DeepCopy()
+				}
+			}
+		}
+		targets = append(targets, k)
+	}
+	return targets
+}
+
+// trustedRootTargets adds the generated trusted root and signing configuration to the TUF targets.
+// The targets set explicitly in the spec are kept.
+func trustedRootTargets(keys []rhtasv1alpha1.TufKey, secret string) []rhtasv1alpha1.TufKey {
+	targets := keys
+	for _, name := range []string{utils.TrustedRootFile, utils.SigningConfigFile} {
+		targets = referenceTarget(targets, name, &rhtasv1alpha1.SecretKeySelector{
+			LocalObjectReference: rhtasv1alpha1.LocalObjectReference{Name: secret},
+			Key:                  name,
+		})
+	}
+	return targets
+}
+
+// referenceTarget adds the target with the Secret reference, a target listed in the spec without a reference gets it.
+func referenceTarget(keys []rhtasv1alpha1.TufKey, name string, ref *rhtasv1alpha1.SecretKeySelector) []rhtasv1alpha1.TufKey {
+	targets := slices.Clone(keys)
+	index := slices.IndexFunc(targets, func(k rhtasv1alpha1.TufKey) bool { return k.Name == name })
+	switch {
+	case index < 0:
+		targets = append(targets, rhtasv1alpha1.TufKey{Name: name, SecretRef: ref})
+	case targets[index].SecretRef == nil:
+		targets[index].SecretRef = ref
+	}
+	return targets
+}
@@ -0,0 +1,28 @@
+package actions
+
+import (
+	"fmt"
+
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// LegacyNames lists the objects created before the names were scoped by the instance.
+// They are created again under the new names.
+func LegacyNames(instance *rhtasv1alpha1.Securesign) []common.LegacyName {
+	namespaced := fmt.Sprintf(SegmentRBACName+"-%s", instance.Namespace)
+	return []common.LegacyName{
+		{Object: &v1.ServiceAccount{}, Name: SegmentRBACName},
+		{Object: &rbacv1.Role{}, Namespace: OpenshiftMonitoringNS, Name: namespaced},
+		{Object: &rbacv1.RoleBinding{}, Namespace: OpenshiftMonitoringNS, Name: namespaced},
+		{Object: &rbacv1.ClusterRoleBinding{}, ClusterScoped: true, Name: namespaced + "-clusterMonitoringRoleBinding"},
+		{Object: &rbacv1.ClusterRole{}, ClusterScoped: true, Name: namespaced + "-clusterRole"},
+		{Object: &rbacv1.ClusterRoleBinding{}, ClusterScoped: true, Name: namespaced + "-clusterRoleBinding"},
+
+		{Object: &batchv1.Job{}, Name: SegmentBackupJobName},
+		{Object: &batchv1.CronJob{}, Name: SegmentBackupCronJobName},
+	}
+}
@@ -17,8 +17,8 @@ import (
 )
 
 const (
-	namespacedNamePattern  = SegmentRBACName + "-%s"
-	clusterWideNamePattern = SegmentRBACName + "-%s" + "-%s"
+	namespacedNamePattern  = SegmentRBACName + "-%s-%s"
+	clusterWideNamePattern = SegmentRBACName + "-%s-%s" + "-%s"
 	OpenshiftMonitoringNS  = "openshift-monitoring"
 )
 
@@ -49,11 +49,11 @@ func (i rbacAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Securesi
 	var err error
 
 	labels := constants.LabelsFor(SegmentBackupJobName, SegmentBackupCronJobName, instance.Name)
-	labels["app.kubernetes.io/instance-namespace"] = instance.Namespace
+	labels[kubernetes.InstanceNamespaceLabel] = instance.Namespace
 
 	serviceAccount := &v1.ServiceAccount{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      SegmentRBACName,
+			Name:      constants.InstanceName(SegmentRBACName, instance.Name),
 			Namespace: instance.Namespace,
 			Labels:    labels,
 		},
@@ -79,7 +79,7 @@ func (i rbacAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Securesi
 
 	openshiftMonitoringSBJRole := kubernetes.CreateRole(
 		OpenshiftMonitoringNS,
-		fmt.Sprintf(namespacedNamePattern, instance.Namespace),
+		fmt.Sprintf(namespacedNamePattern, instance.Namespace, instance.Name),
 		labels,
 		[]rbacv1.PolicyRule{
 			{
@@ -112,15 +112,15 @@ func (i rbacAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Securesi
 
 	openshiftMonitoringSBJRoleBinding := kubernetes.CreateRoleBinding(
 		OpenshiftMonitoringNS,
-		fmt.Sprintf(namespacedNamePattern, instance.Namespace),
+		fmt.Sprintf(namespacedNamePattern, instance.Namespace, instance.Name),
 		labels,
 		rbacv1.RoleRef{
 			APIGroup: v1.SchemeGroupVersion.Group,
 			Kind:     "Role",
-			Name:     fmt.Sprintf(namespacedNamePattern, instance.Namespace),
+			Name:     fmt.Sprintf(namespacedNamePattern, instance.Namespace, instance.Name),
 		},
 		[]rbacv1.Subject{
-			{Kind: "ServiceAccount", Name: SegmentRBACName, Namespace: instance.Namespace},
+			{Kind: "ServiceAccount", Name: constants.InstanceName(SegmentRBACName, instance.Name), Namespace: instance.Namespace},
 		})
 	if _, err = i.Ensure(ctx, openshiftMonitoringSBJRoleBinding); err != nil {
 		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
@@ -139,7 +139,7 @@ func (i rbacAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Securesi
 	}
 
 	openshiftMonitoringClusterRoleBinding := kubernetes.CreateClusterRoleBinding(
-		fmt.Sprintf(clusterWideNamePattern, instance.Namespace, "clusterMonitoringRoleBinding"),
+		fmt.Sprintf(clusterWideNamePattern, instance.Namespace, instance.Name, "clusterMonitoringRoleBinding"),
 		labels,
 		rbacv1.RoleRef{
 			APIGroup: v1.SchemeGroupVersion.Group,
@@ -147,7 +147,7 @@ func (i rbacAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Securesi
 			Name:     "cluster-monitoring-view",
 		},
 		[]rbacv1.Subject{
-			{Kind: "ServiceAccount", Name: SegmentRBACName, Namespace: instance.Namespace},
+			{Kind: "ServiceAccount", Name: constants.InstanceName(SegmentRBACName, instance.Name), Namespace: instance.Namespace},
 		})
 	if _, err = i.Ensure(ctx, openshiftMonitoringClusterRoleBinding); err != nil {
 		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
@@ -166,7 +166,7 @@ func (i rbacAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Securesi
 	}
 
 	openshiftConsoleSBJRole := kubernetes.CreateClusterRole(
-		fmt.Sprintf(clusterWideNamePattern, instance.Namespace, "clusterRole"),
+		fmt.Sprintf(clusterWideNamePattern, instance.Namespace, instance.Name, "clusterRole"),
 		labels,
 		[]rbacv1.PolicyRule{
 			{
@@ -199,15 +199,15 @@ func (i rbacAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Securesi
 	}
 
 	openshiftConsoleSBJRolebinding := kubernetes.CreateClusterRoleBinding(
-		fmt.Sprintf(clusterWideNamePattern, instance.Namespace, "clusterRoleBinding"),
+		fmt.Sprintf(clusterWideNamePattern, instance.Namespace, instance.Name, "clusterRoleBinding"),
 		labels,
 		rbacv1.RoleRef{
 			APIGroup: v1.SchemeGroupVersion.Group,
 			Kind:     "ClusterRole",
-			Name:     fmt.Sprintf(clusterWideNamePattern, instance.Namespace, "clusterRole"),
+			Name:     fmt.Sprintf(clusterWideNamePattern, instance.Namespace, instance.Name, "clusterRole"),
 		},
 		[]rbacv1.Subject{
-			{Kind: "ServiceAccount", Name: SegmentRBACName, Namespace: instance.Namespace},
+			{Kind: "ServiceAccount", Name: constants.InstanceName(SegmentRBACName, instance.Name), Namespace: instance.Namespace},
 		})
 	if _, err = i.Ensure(ctx, openshiftConsoleSBJRolebinding); err != nil {
 		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
@@ -55,7 +55,7 @@ func (i segmentBackupCronJob) Handle(ctx context.Context, instance *rhtasv1alpha
 
 	segmentBackupCronJob := &batchv1.CronJob{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      SegmentBackupCronJobName,
+			Name:      constants.InstanceName(SegmentBackupCronJobName, instance.Name),
 			Namespace: instance.Namespace,
 			Labels:    labels,
 		},
@@ -65,7 +65,7 @@ func (i segmentBackupCronJob) Handle(ctx context.Context, instance *rhtasv1alpha
 				Spec: batchv1.JobSpec{
 					Template: corev1.PodTemplateSpec{
 						Spec: corev1.PodSpec{
-							ServiceAccountName: SegmentRBACName,
+							ServiceAccountName: constants.InstanceName(SegmentRBACName, instance.Name),
 							RestartPolicy:      "OnFailure",
 							Containers: []corev1.Container{
 								{
@@ -57,7 +57,7 @@ func (i segmentBackupJob) Handle(ctx context.Context, instance *rhtasv1alpha1.Se
 		},
 	}
 
-	job := kubernetes.CreateJob(instance.Namespace, SegmentBackupJobName, labels, constants.SegmentBackupImage, SegmentRBACName, parallelism, completions, activeDeadlineSeconds, backoffLimit, command, env)
+	job := kubernetes.CreateJob(instance.Namespace, constants.InstanceName(SegmentBackupJobName, instance.Name), labels, constants.SegmentBackupImage, constants.InstanceName(SegmentRBACName, instance.Name), parallelism, completions, activeDeadlineSeconds, backoffLimit, command, env)
 	if err = ctrl.SetControllerReference(instance, job, i.Client.Scheme()); err != nil {
 		return i.Failed(fmt.Errorf("could not set controll reference for Job: %w", err))
 	}
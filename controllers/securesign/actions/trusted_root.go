@@ -0,0 +1,233 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/action"
+	k8sutils "github.com/securesign/operator/controllers/common/utils/kubernetes"
+	"github.com/securesign/operator/controllers/constants"
+	"github.com/securesign/operator/controllers/securesign/utils"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func NewTrustedRootAction() action.Action[rhtasv1alpha1.Securesign] {
+	return &trustedRootAction{}
+}
+
+type trustedRootAction struct {
+	action.BaseAction
+}
+
+func (i trustedRootAction) Name() string {
+	return "trusted root"
+}
+
+func (i trustedRootAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.Securesign) bool {
+	return meta.FindStatusCondition(instance.Status.Conditions, constants.Ready) != nil
+}
+
+// Handle generates the trusted root and the signing configuration from the statuses of the components.
+// The documents are stored in a ConfigMap and in an immutable Secret which the TUF repository publishes as targets,
+// a new Secret is created whenever they change.
+func (i trustedRootAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Securesign) *action.Result {
+	services, err := i.services(ctx, instance)
+	if err != nil {
+		return i.Failed(fmt.Errorf("could not resolve trusted root: %w", err))
+	}
+	if services == nil {
+		// components are not ready yet
+		return i.Continue()
+	}
+
+	cm := &v1.ConfigMap{}
+	name := fmt.Sprintf(TrustedRootNameFormat, instance.Name)
+	exists := true
+	if err = i.Client.Get(ctx, types.NamespacedName{Name: name, Namespace: instance.Namespace}, cm); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return i.Failed(err)
+		}
+		exists = false
+		cm = k8sutils.InitConfigmap(instance.Namespace, name, constants.LabelsFor(TrustedRootComponentName, name, instance.Name), nil)
+	}
+
+	trustedRoot, err := utils.TrustedRoot([]byte(cm.Data[utils.TrustedRootFile]), *services, time.Now())
+	if err != nil {
+		return i.Failed(fmt.Errorf("could not generate trusted root: %w", err))
+	}
+	signingConfig, err := utils.SigningConfig(*services)
+	if err != nil {
+		return i.Failed(fmt.Errorf("could not generate signing config: %w", err))
+	}
+	data := map[string]string{
+		utils.TrustedRootFile:   string(trustedRoot),
+		utils.SigningConfigFile: string(signingConfig),
+	}
+	if exists && reflect.DeepEqual(cm.Data, data) && instance.Status.TrustedRoot != nil && instance.Status.TrustedRoot.SecretRef != nil {
+		if err = i.cleanup(ctx, instance); err != nil {
+			i.Logger.Error(err, "could not remove previous trusted root secrets")
+		}
+		return i.Continue()
+	}
+
+	cm.Data = data
+	if err = controllerutil.SetControllerReference(instance, cm, i.Client.Scheme()); err != nil {
+		return i.Failed(fmt.Errorf("could not set controller reference for ConfigMap: %w", err))
+	}
+	if exists {
+		err = i.Client.Update(ctx, cm)
+	} else {
+		err = i.Client.Create(ctx, cm)
+	}
+	if err != nil {
+		return i.Failed(fmt.Errorf("could not store trusted root: %w", err))
+	}
+
+	labels := constants.LabelsFor(TrustedRootComponentName, name, instance.Name)
+	secret := k8sutils.CreateImmutableSecret(name+"-", instance.Namespace, map[string][]byte{
+		utils.TrustedRootFile:   trustedRoot,
+		utils.SigningConfigFile: signingConfig,
+	}, labels)
+	if err = controllerutil.SetControllerReference(instance, secret, i.Client.Scheme()); err != nil {
+		return i.Failed(fmt.Errorf("could not set controller reference for Secret: %w", err))
+	}
+	if err = i.Client.Create(ctx, secret); err != nil {
+		return i.Failed(fmt.Errorf("could not store trusted root: %w", err))
+	}
+	// the previous Secrets are removed once the TUF publishes the new one
+	instance.Status.TrustedRoot = &rhtasv1alpha1.SecuresignTrustedRootStatus{
+		ConfigMapRef: &rhtasv1alpha1.LocalObjectReference{Name: cm.Name},
+		SecretRef:    &rhtasv1alpha1.LocalObjectReference{Name: secret.Name},
+	}
+	return i.StatusUpdate(ctx, instance)
+}
+
+// services returns the services of the components used by the Securesign, or nil when some of them aren't ready yet.
+// The external components are read from the spec, the disabled ones are left out.
+func (i trustedRootAction) services(ctx context.Context, instance *rhtasv1alpha1.Securesign) (*utils.Services, error) {
+	key := types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}
+	services := &utils.Services{}
+	var err error
+
+	switch spec := instance.Spec.Fulcio; spec.Mode {
+	case rhtasv1alpha1.ComponentDisabled:
+	case rhtasv1alpha1.ComponentExternal:
+		services.Fulcio.URL = spec.External.Url
+		if services.Fulcio.CertChain, err = k8sutils.GetSecretData(i.Client, instance.Namespace, spec.External.KeyRef); err != nil {
+			return nil, err
+		}
+	default:
+		fulcio := &rhtasv1alpha1.Fulcio{}
+		if err = i.Client.Get(ctx, key, fulcio); err != nil {
+			return nil, client.IgnoreNotFound(err)
+		}
+		if fulcio.Status.Certificate == nil || fulcio.Status.Certificate.CARef == nil || fulcio.Status.Url == "" {
+			return nil, nil
+		}
+		services.Fulcio.URL = fulcio.Status.Url
+		if services.Fulcio.CertChain, err = k8sutils.GetSecretData(i.Client, instance.Namespace, fulcio.Status.Certificate.CARef); err != nil {
+			return nil, err
+		}
+	}
+
+	ctlog := &rhtasv1alpha1.CTlog{}
+	ready, err := i.transparencyLog(ctx, instance, instance.Spec.Ctlog.Mode, instance.Spec.Ctlog.External, ctlog, &services.CTlog,
+		func() (string, *rhtasv1alpha1.SecretKeySelector) { return ctlog.Status.Url, ctlog.Status.PublicKeyRef })
+	if !ready || err != nil {
+		return nil, err
+	}
+	rekor := &rhtasv1alpha1.Rekor{}
+	ready, err = i.transparencyLog(ctx, instance, instance.Spec.Rekor.Mode, instance.Spec.Rekor.External, rekor, &services.Rekor,
+		func() (string, *rhtasv1alpha1.SecretKeySelector) { return rekor.Status.Url, rekor.Status.PublicKeyRef })
+	if !ready || err != nil {
+		return nil, err
+	}
+
+	if tsa := instance.Spec.Tsa; tsa != nil {
+		services.TSA = &utils.CertificateAuthority{URL: tsa.Url}
+		if services.TSA.CertChain, err = k8sutils.GetSecretData(i.Client, instance.Namespace, &tsa.CertChainRef); err != nil {
+			return nil, err
+		}
+	}
+	if issuers := instance.Spec.Fulcio.Config.OIDCIssuers; len(issuers) > 0 {
+		services.OIDCURL = issuers[0].IssuerURL
+		if services.OIDCURL == "" {
+			services.OIDCURL = issuers[0].Issuer
+		}
+	}
+	return services, nil
+}
+
+// transparencyLog resolves the Rekor or CTlog into log, it returns false when the managed log isn't ready yet.
+func (i trustedRootAction) transparencyLog(ctx context.Context, instance *rhtasv1alpha1.Securesign, mode rhtasv1alpha1.ComponentMode,
+	external *rhtasv1alpha1.ExternalService, obj client.Object, log *utils.TransparencyLog, status func() (string, *rhtasv1alpha1.SecretKeySelector)) (bool, error) {
+	var err error
+	switch mode {
+	case rhtasv1alpha1.ComponentDisabled:
+		return true, nil
+	case rhtasv1alpha1.ComponentExternal:
+		*log, err = i.log(instance.Namespace, external.Url, external.KeyRef)
+		return err == nil, err
+	}
+	if err = i.Client.Get(ctx, types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}, obj); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	url, ref := status()
+	if ref == nil || url == "" {
+		return false, nil
+	}
+	*log, err = i.log(instance.Namespace, url, ref)
+	return err == nil, err
+}
+
+// log returns the transparency log whose key is trusted since its Secret was created.
+func (i trustedRootAction) log(namespace, url string, ref *rhtasv1alpha1.SecretKeySelector) (utils.TransparencyLog, error) {
+	secret, err := k8sutils.GetSecret(i.Client, namespace, ref.Name)
+	if err != nil {
+		return utils.TransparencyLog{}, err
+	}
+	key, ok := secret.Data[ref.Key]
+	if !ok {
+		return utils.TransparencyLog{}, fmt.Errorf("key %s not found in secret %s", ref.Key, ref.Name)
+	}
+	return utils.TransparencyLog{URL: url, PublicKey: key, Start: secret.CreationTimestamp.Time}, nil
+}
+
+// cleanup removes the previous trusted root Secrets, the Secrets still published by the TUF are kept.
+func (i trustedRootAction) cleanup(ctx context.Context, instance *rhtasv1alpha1.Securesign) error {
+	keep := map[string]bool{instance.Status.TrustedRoot.SecretRef.Name: true}
+	if managed(instance.Spec.Tuf.Mode) {
+		tuf := &rhtasv1alpha1.Tuf{}
+		if err := i.Client.Get(ctx, types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}, tuf); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+		for _, k := range tuf.Status.Keys {
+			if k.SecretRef != nil {
+				keep[k.SecretRef.Name] = true
+			}
+		}
+	}
+
+	name := fmt.Sprintf(TrustedRootNameFormat, instance.Name)
+	list := &v1.SecretList{}
+	if err := i.Client.List(ctx, list, client.InNamespace(instance.Namespace),
+		client.MatchingLabels(constants.LabelsFor(TrustedRootComponentName, name, instance.Name))); err != nil {
+		return err
+	}
+	for _, s := range list.Items {
+		if !keep[s.Name] {
+			if err := i.Client.Delete(ctx, &s); client.IgnoreNotFound(err) != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
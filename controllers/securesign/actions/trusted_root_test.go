@@ -0,0 +1,81 @@
+package actions
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+	testAction "github.com/securesign/operator/controllers/common/test/action"
+	"github.com/securesign/operator/controllers/constants"
+	"github.com/securesign/operator/controllers/securesign/utils"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestTrustedRootCleanup(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.TODO()
+
+	labels := constants.LabelsFor(TrustedRootComponentName, "sample-trusted-root", "sample")
+	secret := func(name string) *v1.Secret {
+		return &v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels}}
+	}
+	target := func(secret string) []v1alpha1.TufKey {
+		return []v1alpha1.TufKey{{
+			Name: utils.TrustedRootFile,
+			SecretRef: &v1alpha1.SecretKeySelector{
+				LocalObjectReference: v1alpha1.LocalObjectReference{Name: secret},
+				Key:                  utils.TrustedRootFile,
+			},
+		}}
+	}
+	instance := &v1alpha1.Securesign{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample", Namespace: "default"},
+		Status: v1alpha1.SecuresignStatus{
+			TrustedRoot: &v1alpha1.SecuresignTrustedRootStatus{SecretRef: &v1alpha1.LocalObjectReference{Name: "sample-trusted-root-new"}},
+		},
+	}
+	tuf := &v1alpha1.Tuf{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample", Namespace: "default"},
+		Status:     v1alpha1.TufStatus{Keys: target("sample-trusted-root-old")},
+	}
+	c := testAction.FakeClientBuilder().
+		WithStatusSubresource(tuf).
+		WithObjects(tuf, secret("sample-trusted-root-older"), secret("sample-trusted-root-old"), secret("sample-trusted-root-new")).
+		Build()
+	a := testAction.PrepareAction(c, NewTrustedRootAction()).(*trustedRootAction)
+	exists := func(name string) bool {
+		return !apierrors.IsNotFound(c.Get(ctx, types.NamespacedName{Name: name, Namespace: "default"}, &v1.Secret{}))
+	}
+
+	// the Secret published by the TUF is kept until the TUF switches to the new one
+	g.Expect(a.cleanup(ctx, instance)).To(Succeed())
+	g.Expect(exists("sample-trusted-root-older")).To(BeFalse())
+	g.Expect(exists("sample-trusted-root-old")).To(BeTrue())
+	g.Expect(exists("sample-trusted-root-new")).To(BeTrue())
+
+	tuf.Status.Keys = target("sample-trusted-root-new")
+	g.Expect(c.Status().Update(ctx, tuf)).To(Succeed())
+	g.Expect(a.cleanup(ctx, instance)).To(Succeed())
+	g.Expect(exists("sample-trusted-root-old")).To(BeFalse())
+	g.Expect(exists("sample-trusted-root-new")).To(BeTrue())
+}
+
+func TestTSATarget(t *testing.T) {
+	g := NewWithT(t)
+	ref := &v1alpha1.SecretKeySelector{LocalObjectReference: v1alpha1.LocalObjectReference{Name: "tsa"}, Key: "chain"}
+
+	g.Expect(referenceTarget([]v1alpha1.TufKey{{Name: "rekor.pub"}}, TSATargetName, ref)).To(Equal([]v1alpha1.TufKey{
+		{Name: "rekor.pub"},
+		{Name: TSATargetName, SecretRef: ref},
+	}))
+
+	// a reference set in the spec is kept
+	custom := &v1alpha1.SecretKeySelector{LocalObjectReference: v1alpha1.LocalObjectReference{Name: "custom"}, Key: "chain"}
+	g.Expect(referenceTarget([]v1alpha1.TufKey{{Name: TSATargetName, SecretRef: custom}}, TSATargetName, ref)).To(Equal([]v1alpha1.TufKey{
+		{Name: TSATargetName, SecretRef: custom},
+	}))
+}
@@ -0,0 +1,123 @@
+package actions
+
+import (
+	"context"
+
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/action"
+	"k8s.io/apimachinery/pkg/api/equality"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewUnmanagedAction() action.Action[rhtasv1alpha1.Securesign] {
+	return &unmanagedAction{}
+}
+
+type unmanagedAction struct {
+	action.BaseAction
+}
+
+type component struct {
+	condition string
+	mode      rhtasv1alpha1.ComponentMode
+	external  *rhtasv1alpha1.ExternalService
+	object    client.Object
+	// setStatus replaces the status of the unmanaged component in the Securesign
+	setStatus func(rhtasv1alpha1.SecuresignComponentStatus)
+}
+
+func (i unmanagedAction) Name() string {
+	return "unmanaged components"
+}
+
+func (i unmanagedAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.Securesign) bool {
+	for _, c := range components(instance) {
+		if !managed(c.mode) {
+			return true
+		}
+	}
+	return false
+}
+
+// Handle removes the components which are no longer managed by the Securesign
+// and reports the external and disabled components as available.
+func (i unmanagedAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Securesign) *action.Result {
+	previous := instance.Status.DeepCopy()
+	for _, c := range components(instance) {
+		if managed(c.mode) {
+			continue
+		}
+		if err := i.Client.Get(ctx, types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}, c.object); client.IgnoreNotFound(err) != nil {
+			return i.Failed(err)
+		} else if err == nil && v1.IsControlledBy(c.object, instance) {
+			if err = i.Client.Delete(ctx, c.object); client.IgnoreNotFound(err) != nil {
+				return i.Failed(err)
+			}
+			i.Logger.Info("Removed component", "condition", c.condition, "mode", c.mode)
+		}
+
+		condition := v1.Condition{
+			Type:    c.condition,
+			Status:  v1.ConditionTrue,
+			Reason:  string(c.mode),
+			Message: "Component is disabled",
+		}
+		if c.mode == rhtasv1alpha1.ComponentExternal && c.external != nil {
+			condition.Message = "Using external " + c.external.Url
+		}
+		copyCondition(instance, c.condition, &condition)
+		c.setStatus(rhtasv1alpha1.SecuresignComponentStatus{Mode: c.mode})
+	}
+	if !equality.Semantic.DeepEqual(previous, &instance.Status) {
+		return i.StatusUpdate(ctx, instance)
+	}
+	return i.Continue()
+}
+
+// components lists the components of the Securesign along with their mode.
+// The status of an external component holds its URL and key from the spec.
+func components(instance *rhtasv1alpha1.Securesign) []component {
+	spec := instance.Spec
+	status := &instance.Status
+	return []component{
+		{condition: TrillianCondition, mode: spec.Trillian.Mode, object: &rhtasv1alpha1.Trillian{},
+			setStatus: func(s rhtasv1alpha1.SecuresignComponentStatus) {
+				status.TrillianStatus = rhtasv1alpha1.SecuresignTrillianStatus{SecuresignComponentStatus: s}
+			}},
+		{condition: FulcioCondition, mode: spec.Fulcio.Mode, external: spec.Fulcio.External, object: &rhtasv1alpha1.Fulcio{},
+			setStatus: func(s rhtasv1alpha1.SecuresignComponentStatus) {
+				status.FulcioStatus = rhtasv1alpha1.SecuresignFulcioStatus{SecuresignComponentStatus: s}
+				if e := spec.Fulcio.External; s.Mode == rhtasv1alpha1.ComponentExternal && e != nil {
+					status.FulcioStatus.Url, status.FulcioStatus.CARef = e.Url, e.KeyRef
+				}
+			}},
+		{condition: RekorCondition, mode: spec.Rekor.Mode, external: spec.Rekor.External, object: &rhtasv1alpha1.Rekor{},
+			setStatus: func(s rhtasv1alpha1.SecuresignComponentStatus) {
+				status.RekorStatus = rhtasv1alpha1.SecuresignRekorStatus{SecuresignComponentStatus: s}
+				if e := spec.Rekor.External; s.Mode == rhtasv1alpha1.ComponentExternal && e != nil {
+					status.RekorStatus.Url, status.RekorStatus.PublicKeyRef = e.Url, e.KeyRef
+				}
+			}},
+		{condition: CTlogCondition, mode: spec.Ctlog.Mode, external: spec.Ctlog.External, object: &rhtasv1alpha1.CTlog{},
+			setStatus: func(s rhtasv1alpha1.SecuresignComponentStatus) {
+				status.CTlogStatus = rhtasv1alpha1.SecuresignCTlogStatus{SecuresignComponentStatus: s}
+				if e := spec.Ctlog.External; s.Mode == rhtasv1alpha1.ComponentExternal && e != nil {
+					status.CTlogStatus.Url, status.CTlogStatus.PublicKeyRef = e.Url, e.KeyRef
+				}
+			}},
+		{condition: TufCondition, mode: spec.Tuf.Mode, external: spec.Tuf.External, object: &rhtasv1alpha1.Tuf{},
+			setStatus: func(s rhtasv1alpha1.SecuresignComponentStatus) {
+				status.TufStatus = rhtasv1alpha1.SecuresignTufStatus{SecuresignComponentStatus: s}
+				if e := spec.Tuf.External; s.Mode == rhtasv1alpha1.ComponentExternal && e != nil {
+					status.TufStatus.Url = e.Url
+				}
+			}},
+	}
+}
+
+// managed reports whether the component is deployed by the Securesign, the components are managed unless set otherwise.
+func managed(mode rhtasv1alpha1.ComponentMode) bool {
+	return mode == "" || mode == rhtasv1alpha1.ComponentManaged
+}
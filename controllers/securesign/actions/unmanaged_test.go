@@ -0,0 +1,85 @@
+package actions
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+	testAction "github.com/securesign/operator/controllers/common/test/action"
+	"github.com/securesign/operator/controllers/constants"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func TestUnmanaged(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.TODO()
+
+	instance := &v1alpha1.Securesign{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample", Namespace: "default", UID: "uid"},
+		Spec: v1alpha1.SecuresignSpec{
+			Rekor: v1alpha1.SecuresignRekorSpec{
+				Mode: v1alpha1.ComponentExternal,
+				External: &v1alpha1.ExternalService{
+					Url:    "https://rekor.example.com",
+					KeyRef: &v1alpha1.SecretKeySelector{LocalObjectReference: v1alpha1.LocalObjectReference{Name: "rekor"}, Key: "public"},
+				},
+			},
+			Ctlog: v1alpha1.SecuresignCTlogSpec{Mode: v1alpha1.ComponentDisabled},
+		},
+	}
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{Type: RekorCondition, Status: metav1.ConditionUnknown, Reason: constants.Pending})
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{Type: CTlogCondition, Status: metav1.ConditionUnknown, Reason: constants.Pending})
+
+	rekor := &v1alpha1.Rekor{ObjectMeta: metav1.ObjectMeta{Name: "sample", Namespace: "default"}}
+	c := testAction.FakeClientBuilder().WithStatusSubresource(instance).WithObjects(instance).Build()
+	g.Expect(controllerutil.SetControllerReference(instance, rekor, c.Scheme())).To(Succeed())
+	g.Expect(c.Create(ctx, rekor)).To(Succeed())
+
+	a := testAction.PrepareAction(c, NewUnmanagedAction())
+	g.Expect(a.CanHandle(ctx, instance)).To(BeTrue())
+	g.Expect(a.Handle(ctx, instance)).ToNot(BeNil())
+
+	// the previously managed Rekor is removed
+	err := c.Get(ctx, types.NamespacedName{Name: "sample", Namespace: "default"}, &v1alpha1.Rekor{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+	g.Expect(instance.Status.RekorStatus.Url).To(Equal("https://rekor.example.com"))
+	g.Expect(meta.FindStatusCondition(instance.Status.Conditions, RekorCondition).Reason).To(Equal(string(v1alpha1.ComponentExternal)))
+	g.Expect(meta.IsStatusConditionTrue(instance.Status.Conditions, RekorCondition)).To(BeTrue())
+	g.Expect(meta.FindStatusCondition(instance.Status.Conditions, CTlogCondition).Reason).To(Equal(string(v1alpha1.ComponentDisabled)))
+
+	// nothing left to change
+	g.Expect(a.Handle(ctx, instance)).To(BeNil())
+
+	// the external and disabled components count as ready
+	for _, condition := range []string{TrillianCondition, FulcioCondition, TufCondition} {
+		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{Type: condition, Status: metav1.ConditionTrue, Reason: constants.Ready})
+	}
+	g.Expect(sortByStatus(instance.Status.Conditions)).To(HaveLen(5))
+	g.Expect(meta.IsStatusConditionTrue(instance.Status.Conditions, sortByStatus(instance.Status.Conditions)[0])).To(BeTrue())
+}
+
+func TestComponentTargets(t *testing.T) {
+	g := NewWithT(t)
+	keyRef := &v1alpha1.SecretKeySelector{LocalObjectReference: v1alpha1.LocalObjectReference{Name: "fulcio"}, Key: "cert"}
+	instance := &v1alpha1.Securesign{
+		Spec: v1alpha1.SecuresignSpec{
+			Fulcio: v1alpha1.SecuresignFulcioSpec{
+				Mode:     v1alpha1.ComponentExternal,
+				External: &v1alpha1.ExternalService{Url: "https://fulcio.example.com", KeyRef: keyRef},
+			},
+			Ctlog: v1alpha1.SecuresignCTlogSpec{Mode: v1alpha1.ComponentDisabled},
+		},
+	}
+
+	targets := componentTargets([]v1alpha1.TufKey{{Name: "rekor.pub"}, {Name: "ctfe.pub"}, {Name: "fulcio_v1.crt.pem"}}, instance)
+	g.Expect(targets).To(Equal([]v1alpha1.TufKey{
+		{Name: "rekor.pub"},
+		{Name: "fulcio_v1.crt.pem", SecretRef: keyRef},
+	}))
+}
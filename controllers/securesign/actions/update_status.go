@@ -30,20 +30,25 @@ func (i updateStatusAction) CanHandle(ctx context.Context, instance *rhtasv1alph
 func (i updateStatusAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Securesign) *action.Result {
 	sorted := sortByStatus(instance.Status.Conditions)
 
-	if !meta.IsStatusConditionTrue(instance.Status.Conditions, sorted[0]) {
-		meta.SetStatusCondition(&instance.Status.Conditions, v1.Condition{
-			Type:   constants.Ready,
-			Status: v1.ConditionFalse,
-			Reason: meta.FindStatusCondition(instance.Status.Conditions, sorted[0]).Reason,
-		})
-		return i.StatusUpdate(ctx, instance)
+	ready := v1.Condition{
+		Type:               constants.Ready,
+		Status:             v1.ConditionTrue,
+		Reason:             constants.Ready,
+		ObservedGeneration: instance.Generation,
+	}
+	if worst := meta.FindStatusCondition(instance.Status.Conditions, sorted[0]); worst.Status != v1.ConditionTrue {
+		// the least ready component explains the state of the Securesign
+		ready.Status = v1.ConditionFalse
+		ready.Reason = worst.Reason
+		if worst.Message != "" {
+			ready.Message = worst.Type + ": " + worst.Message
+		}
 	}
-	if !meta.IsStatusConditionTrue(instance.Status.Conditions, constants.Ready) {
-		meta.SetStatusCondition(&instance.Status.Conditions, v1.Condition{
-			Type:   constants.Ready,
-			Status: v1.ConditionTrue,
-			Reason: constants.Ready,
-		})
+	current := meta.FindStatusCondition(instance.Status.Conditions, constants.Ready)
+	if current.Status != ready.Status || current.Reason != ready.Reason || current.Message != ready.Message ||
+		current.ObservedGeneration != ready.ObservedGeneration || instance.Status.ObservedGeneration != instance.Generation {
+		meta.SetStatusCondition(&instance.Status.Conditions, ready)
+		instance.Status.ObservedGeneration = instance.Generation
 		return i.StatusUpdate(ctx, instance)
 	}
 	return i.Continue()
@@ -52,18 +57,23 @@ func (i updateStatusAction) Handle(ctx context.Context, instance *rhtasv1alpha1.
 func sortByStatus(conditions []v1.Condition) []string {
 	sorted := []string{TrillianCondition, FulcioCondition, RekorCondition, CTlogCondition, TufCondition}
 	sort.SliceStable(sorted, func(i, j int) bool {
-		iCondition := meta.FindStatusCondition(conditions, sorted[i])
-		jCondition := meta.FindStatusCondition(conditions, sorted[j])
-		switch iCondition.Reason {
-		case constants.Initialize:
-			return jCondition.Reason == constants.Ready
-		case constants.Creating:
-			return jCondition.Reason == constants.Ready || jCondition.Reason == constants.Initialize
-		case constants.Pending:
-			return jCondition.Reason == constants.Ready || jCondition.Reason == constants.Initialize || jCondition.Reason == constants.Creating
-		default:
-			return true
-		}
+		return rank(meta.FindStatusCondition(conditions, sorted[i])) < rank(meta.FindStatusCondition(conditions, sorted[j]))
 	})
 	return sorted
 }
+
+// rank orders the component conditions from the least to the most ready, failures come first.
+func rank(condition *v1.Condition) int {
+	switch condition.Reason {
+	case constants.Ready, string(rhtasv1alpha1.ComponentExternal), string(rhtasv1alpha1.ComponentDisabled):
+		return 4
+	case constants.Initialize:
+		return 3
+	case constants.Creating:
+		return 2
+	case constants.Pending:
+		return 1
+	default:
+		return 0
+	}
+}
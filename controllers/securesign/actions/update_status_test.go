@@ -0,0 +1,67 @@
+package actions
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+	testAction "github.com/securesign/operator/controllers/common/test/action"
+	"github.com/securesign/operator/controllers/common/utils"
+	"github.com/securesign/operator/controllers/constants"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestCopyRekorStatus(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.TODO()
+
+	instance := &v1alpha1.Securesign{ObjectMeta: metav1.ObjectMeta{Name: "sample", Namespace: "default", Generation: 2}}
+	for _, condition := range []string{constants.Ready, TrillianCondition, FulcioCondition, RekorCondition, CTlogCondition, TufCondition} {
+		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{Type: condition, Status: metav1.ConditionTrue, Reason: constants.Ready})
+	}
+	rekor := &v1alpha1.Rekor{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample", Namespace: "default"},
+		Status: v1alpha1.RekorStatus{
+			Url:              "https://rekor.example.com",
+			RekorSearchUIUrl: "https://rekor-search-ui.example.com",
+			TreeID:           utils.Pointer(int64(42)),
+			Conditions: []metav1.Condition{
+				{Type: constants.Ready, Status: metav1.ConditionFalse, Reason: constants.Failure, Message: "could not create tree"},
+			},
+		},
+	}
+	c := testAction.FakeClientBuilder().WithStatusSubresource(instance, rekor).WithObjects(instance, rekor).Build()
+
+	a := testAction.PrepareAction(c, NewRekorAction()).(*rekorAction)
+	g.Expect(a.CopyStatus(ctx, client.ObjectKeyFromObject(rekor), instance)).ToNot(BeNil())
+	g.Expect(instance.Status.RekorStatus.Url).To(Equal("https://rekor.example.com"))
+	g.Expect(instance.Status.RekorStatus.RekorSearchUIUrl).To(Equal("https://rekor-search-ui.example.com"))
+	g.Expect(*instance.Status.RekorStatus.TreeID).To(Equal(int64(42)))
+	g.Expect(instance.Status.RekorStatus.Mode).To(Equal(v1alpha1.ComponentManaged))
+	g.Expect(instance.Status.RekorStatus.Image).To(Equal(constants.RekorServerImage))
+	g.Expect(instance.Status.RekorStatus.LastError).To(Equal("could not create tree"))
+	g.Expect(meta.FindStatusCondition(instance.Status.Conditions, RekorCondition).Message).To(Equal("could not create tree"))
+
+	// the failure is reported by the Securesign
+	u := testAction.PrepareAction(c, NewUpdateStatusAction())
+	g.Expect(u.Handle(ctx, instance)).ToNot(BeNil())
+	ready := meta.FindStatusCondition(instance.Status.Conditions, constants.Ready)
+	g.Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+	g.Expect(ready.Reason).To(Equal(constants.Failure))
+	g.Expect(ready.Message).To(Equal(RekorCondition + ": could not create tree"))
+	g.Expect(instance.Status.ObservedGeneration).To(Equal(int64(2)))
+	g.Expect(u.Handle(ctx, instance)).To(BeNil())
+
+	// the last error is cleared once the Rekor is ready
+	rekor.Status.Conditions = []metav1.Condition{{Type: constants.Ready, Status: metav1.ConditionTrue, Reason: constants.Ready}}
+	g.Expect(c.Status().Update(ctx, rekor)).To(Succeed())
+	g.Expect(a.CopyStatus(ctx, client.ObjectKeyFromObject(rekor), instance)).ToNot(BeNil())
+	g.Expect(instance.Status.RekorStatus.LastError).To(BeEmpty())
+	g.Expect(a.CopyStatus(ctx, client.ObjectKeyFromObject(rekor), instance)).To(BeNil())
+
+	g.Expect(u.Handle(ctx, instance)).ToNot(BeNil())
+	g.Expect(meta.IsStatusConditionTrue(instance.Status.Conditions, constants.Ready)).To(BeTrue())
+}
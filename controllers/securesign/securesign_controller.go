@@ -20,15 +20,21 @@ import (
 	"context"
 
 	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common"
 	"github.com/securesign/operator/controllers/common/action"
+	"github.com/securesign/operator/controllers/common/utils/kubernetes"
 	"github.com/securesign/operator/controllers/constants"
 	"github.com/securesign/operator/controllers/securesign/actions"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
@@ -40,7 +46,8 @@ const (
 // SecuresignReconciler reconciles a Securesign object
 type SecuresignReconciler struct {
 	client.Client
-	Scheme *runtime.Scheme
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
 }
 
 //+kubebuilder:rbac:groups=rhtas.redhat.com,resources=securesigns,verbs=get;list;watch;create;update;patch;delete
@@ -53,6 +60,7 @@ type SecuresignReconciler struct {
 //+kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete;deletecollection
 //+kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=networking,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=core,resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=get;list;watch;create;update;patch;delete;deletecollection
@@ -94,7 +102,7 @@ func (r *SecuresignReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 	if instance.DeletionTimestamp != nil {
 		labels := constants.LabelsFor(actions.SegmentBackupJobName, actions.SegmentBackupCronJobName, instance.Name)
-		labels["app.kubernetes.io/instance-namespace"] = instance.Namespace
+		labels[kubernetes.InstanceNamespaceLabel] = instance.Namespace
 		if err := r.Client.DeleteAllOf(ctx, &v1.ClusterRoleBinding{}, client.MatchingLabels(labels)); err != nil {
 			log.Error(err, "problem with removing clusterRoleBinding resource")
 		}
@@ -107,18 +115,25 @@ func (r *SecuresignReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		if err := r.Client.DeleteAllOf(ctx, &v1.RoleBinding{}, client.InNamespace(actions.OpenshiftMonitoringNS), client.MatchingLabels(labels)); err != nil {
 			log.Error(err, "problem with removing RoleBinding resource in %s", actions.OpenshiftMonitoringNS)
 		}
+		if err := actions.RemoveClientConfig(ctx, r.Client, &instance); err != nil {
+			log.Error(err, "problem with removing client configuration")
+		}
 
 		controllerutil.RemoveFinalizer(target, finalizer)
 		return ctrl.Result{}, r.Update(ctx, target)
 	}
 
 	acs := []action.Action[rhtasv1alpha1.Securesign]{
+		common.NewMigrateNamesAction[rhtasv1alpha1.Securesign](actions.LegacyNames),
 		actions.NewInitializeStatusAction(),
+		actions.NewUnmanagedAction(),
 		actions.NewTrillianAction(),
 		actions.NewFulcioAction(),
 		actions.NewRekorAction(),
 		actions.NewCtlogAction(),
+		actions.NewTrustedRootAction(),
 		actions.NewTufAction(),
+		actions.NewClientConfigAction(),
 		actions.NewRBACAction(),
 		actions.NewSegmentBackupJobAction(),
 		actions.NewSegmentBackupCronJobAction(),
@@ -128,6 +143,7 @@ func (r *SecuresignReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	for _, a := range acs {
 		a.InjectClient(r.Client)
 		a.InjectLogger(log.WithName(a.Name()))
+		a.InjectRecorder(r.Recorder)
 
 		if a.CanHandle(ctx, target) {
 			result := a.Handle(ctx, target)
@@ -148,5 +164,24 @@ func (r *SecuresignReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&rhtasv1alpha1.Tuf{}).
 		Owns(&rhtasv1alpha1.Trillian{}).
 		Owns(&rhtasv1alpha1.CTlog{}).
+		Owns(&corev1.ConfigMap{}).
+		// the client configuration is served under the URL of the ClientServer
+		Watches(&rhtasv1alpha1.ClientServer{}, handler.EnqueueRequestsFromMapFunc(allSecuresigns(mgr.GetClient()))).
 		Complete(r)
 }
+
+// allSecuresigns maps an event to the requests of all Securesign instances in the cluster.
+func allSecuresigns(c client.Client) handler.MapFunc {
+	return func(ctx context.Context, _ client.Object) []reconcile.Request {
+		list := &rhtasv1alpha1.SecuresignList{}
+		if err := c.List(ctx, list); err != nil {
+			ctrllog.FromContext(ctx).Error(err, "unable to list Securesign instances")
+			return nil
+		}
+		requests := make([]reconcile.Request, len(list.Items))
+		for i, k := range list.Items {
+			requests[i] = reconcile.Request{NamespacedName: types.NamespacedName{Namespace: k.Namespace, Name: k.Name}}
+		}
+		return requests
+	}
+}
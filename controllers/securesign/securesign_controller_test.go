@@ -0,0 +1,30 @@
+package securesign
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+	testAction "github.com/securesign/operator/controllers/common/test/action"
+	"github.com/securesign/operator/controllers/constants"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestAllSecuresigns(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.TODO()
+
+	c := testAction.FakeClientBuilder().WithObjects(
+		&v1alpha1.Securesign{ObjectMeta: metav1.ObjectMeta{Name: "first", Namespace: "default"}},
+		&v1alpha1.Securesign{ObjectMeta: metav1.ObjectMeta{Name: "second", Namespace: "other"}},
+	).Build()
+	server := &v1alpha1.ClientServer{ObjectMeta: metav1.ObjectMeta{Name: constants.ClientServerName}}
+
+	g.Expect(allSecuresigns(c)(ctx, server)).To(ConsistOf(
+		reconcile.Request{NamespacedName: types.NamespacedName{Name: "first", Namespace: "default"}},
+		reconcile.Request{NamespacedName: types.NamespacedName{Name: "second", Namespace: "other"}},
+	))
+}
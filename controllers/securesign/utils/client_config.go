@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// ClientConfig are the settings of the Sigstore clients using a Securesign.
+// The settings of the disabled components are empty.
+type ClientConfig struct {
+	TufURL string
+	// SHA256 checksum of the TUF root metadata the clients are initialized with
+	TufRootChecksum string
+	FulcioURL       string
+	RekorURL        string
+	CTlogURL        string
+	OIDCIssuerURL   string
+	OIDCClientID    string
+}
+
+type clientVariable struct {
+	name  string
+	value func(ClientConfig) string
+	// variables of cosign, gitsign and rekor-cli set to the same value
+	aliases []string
+}
+
+var clientVariables = []clientVariable{
+	{"TUF_URL", func(c ClientConfig) string { return c.TufURL }, []string{"COSIGN_MIRROR"}},
+	{"TUF_ROOT_CHECKSUM", func(c ClientConfig) string { return c.TufRootChecksum }, nil},
+	{"FULCIO_URL", func(c ClientConfig) string { return c.FulcioURL }, []string{"COSIGN_FULCIO_URL", "SIGSTORE_FULCIO_URL"}},
+	{"REKOR_URL", func(c ClientConfig) string { return c.RekorURL }, []string{"COSIGN_REKOR_URL", "SIGSTORE_REKOR_URL", "REKOR_REKOR_SERVER"}},
+	{"CTLOG_URL", func(c ClientConfig) string { return c.CTlogURL }, nil},
+	{"OIDC_ISSUER_URL", func(c ClientConfig) string { return c.OIDCIssuerURL }, []string{"COSIGN_OIDC_ISSUER", "SIGSTORE_OIDC_ISSUER"}},
+	{"OIDC_CLIENT_ID", func(c ClientConfig) string { return c.OIDCClientID }, []string{"COSIGN_OIDC_CLIENT_ID", "SIGSTORE_OIDC_CLIENT_ID"}},
+}
+
+// Data returns the ConfigMap data with a key for each setting, so it can be used as the environment of a container.
+func (c ClientConfig) Data() map[string]string {
+	data := make(map[string]string)
+	for _, v := range clientVariables {
+		if value := v.value(c); value != "" {
+			data[v.name] = value
+		}
+	}
+	return data
+}
+
+// Env returns the shell file exporting the settings along with the variables read by cosign, gitsign and rekor-cli.
+func (c ClientConfig) Env(instance string) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "# Sigstore client configuration of %s\n", instance)
+	fmt.Fprintf(&b, "# Usage: source <this file>\n")
+	for _, v := range clientVariables {
+		value := v.value(c)
+		if value == "" {
+			continue
+		}
+		fmt.Fprintf(&b, "export %s=%s\n", v.name, quote(value))
+		for _, alias := range v.aliases {
+			fmt.Fprintf(&b, "export %s=%s\n", alias, quote(value))
+		}
+	}
+	if c.TufURL != "" {
+		fmt.Fprintf(&b, "export COSIGN_ROOT=%s\n", quote(strings.TrimSuffix(c.TufURL, "/")+"/root.json"))
+	}
+	return b.Bytes()
+}
+
+// quote quotes the value for the shell.
+func quote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestClientConfig(t *testing.T) {
+	g := NewWithT(t)
+	config := ClientConfig{
+		TufURL:          "https://tuf.example.com",
+		TufRootChecksum: "0a1b",
+		FulcioURL:       "https://fulcio.example.com",
+		RekorURL:        "https://rekor.example.com",
+		OIDCIssuerURL:   "https://oidc.example.com/realms/it's",
+		OIDCClientID:    "trusted-artifact-signer",
+	}
+
+	g.Expect(config.Data()).To(Equal(map[string]string{
+		"TUF_URL":           "https://tuf.example.com",
+		"TUF_ROOT_CHECKSUM": "0a1b",
+		"FULCIO_URL":        "https://fulcio.example.com",
+		"REKOR_URL":         "https://rekor.example.com",
+		"OIDC_ISSUER_URL":   "https://oidc.example.com/realms/it's",
+		"OIDC_CLIENT_ID":    "trusted-artifact-signer",
+	}))
+
+	env := string(config.Env("default/securesign-sample"))
+	g.Expect(env).To(HavePrefix("# Sigstore client configuration of default/securesign-sample\n"))
+	g.Expect(env).To(ContainSubstring("export TUF_URL='https://tuf.example.com'\nexport COSIGN_MIRROR='https://tuf.example.com'\n"))
+	g.Expect(env).To(ContainSubstring("export COSIGN_ROOT='https://tuf.example.com/root.json'\n"))
+	g.Expect(env).To(ContainSubstring("export REKOR_REKOR_SERVER='https://rekor.example.com'\n"))
+	g.Expect(env).To(ContainSubstring(`export SIGSTORE_OIDC_ISSUER='https://oidc.example.com/realms/it'\''s'` + "\n"))
+	// the disabled CTlog is left out
+	g.Expect(env).ToNot(ContainSubstring("CTLOG_URL"))
+}
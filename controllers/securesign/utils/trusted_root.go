@@ -0,0 +1,287 @@
+package utils
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+)
+
+const (
+	TrustedRootFile   = "trusted_root.json"
+	SigningConfigFile = "signing_config.json"
+
+	trustedRootMediaType   = "application/vnd.dev.sigstore.trustedroot+json;version=0.1"
+	signingConfigMediaType = "application/vnd.dev.sigstore.signingconfig.v0.1+json"
+)
+
+// TransparencyLog is a Rekor or CTlog instance trusted by the clients.
+type TransparencyLog struct {
+	URL string
+	// PEM encoded public key of the log
+	PublicKey []byte
+	// Start of the validity of the key, the log entries integrated before aren't trusted
+	Start time.Time
+}
+
+// CertificateAuthority is a Fulcio or TSA instance trusted by the clients.
+type CertificateAuthority struct {
+	URL string
+	// PEM encoded certificate chain of the authority, the root certificate is the last one
+	CertChain []byte
+}
+
+// Services are the Sigstore services the clients use to sign and verify.
+// The services without a key or certificate chain are disabled, they are left out of the trusted root.
+type Services struct {
+	Fulcio CertificateAuthority
+	Rekor  TransparencyLog
+	CTlog  TransparencyLog
+	// TSA is optional
+	TSA     *CertificateAuthority
+	OIDCURL string
+}
+
+type trustedRoot struct {
+	MediaType              string                    `json:"mediaType"`
+	Tlogs                  []transparencyLogInstance `json:"tlogs"`
+	CertificateAuthorities []certificateAuthority    `json:"certificateAuthorities"`
+	Ctlogs                 []transparencyLogInstance `json:"ctlogs"`
+	TimestampAuthorities   []certificateAuthority    `json:"timestampAuthorities,omitempty"`
+}
+
+type transparencyLogInstance struct {
+	BaseURL       string    `json:"baseUrl"`
+	HashAlgorithm string    `json:"hashAlgorithm"`
+	PublicKey     publicKey `json:"publicKey"`
+	LogID         logID     `json:"logId"`
+}
+
+type publicKey struct {
+	RawBytes   []byte         `json:"rawBytes"`
+	KeyDetails string         `json:"keyDetails"`
+	ValidFor   validityPeriod `json:"validFor"`
+}
+
+type logID struct {
+	KeyID []byte `json:"keyId"`
+}
+
+type certificateAuthority struct {
+	Subject   distinguishedName `json:"subject"`
+	URI       string            `json:"uri,omitempty"`
+	CertChain certChain         `json:"certChain"`
+	ValidFor  validityPeriod    `json:"validFor"`
+}
+
+type distinguishedName struct {
+	Organization string `json:"organization,omitempty"`
+	CommonName   string `json:"commonName,omitempty"`
+}
+
+type certChain struct {
+	Certificates []certificate `json:"certificates"`
+}
+
+type certificate struct {
+	RawBytes []byte `json:"rawBytes"`
+}
+
+type validityPeriod struct {
+	Start *time.Time `json:"start,omitempty"`
+	End   *time.Time `json:"end,omitempty"`
+}
+
+type signingConfig struct {
+	MediaType string   `json:"mediaType"`
+	CAURL     string   `json:"caUrl,omitempty"`
+	OIDCURL   string   `json:"oidcUrl,omitempty"`
+	TlogURLs  []string `json:"tlogUrls,omitempty"`
+	TSAURLs   []string `json:"tsaUrls,omitempty"`
+}
+
+// TrustedRoot returns the Sigstore trusted root of the services.
+// The keys and certificate authorities of the previous trusted root which aren't used anymore are kept,
+// their validity ends now so the clients can still verify the signatures made before.
+func TrustedRoot(previous []byte, services Services, now time.Time) ([]byte, error) {
+	root := trustedRoot{}
+	if len(previous) > 0 {
+		if err := json.Unmarshal(previous, &root); err != nil {
+			return nil, fmt.Errorf("could not parse previous trusted root: %w", err)
+		}
+	}
+	root.MediaType = trustedRootMediaType
+	end := now.UTC().Truncate(time.Second)
+
+	rekor, err := logInstance(services.Rekor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Rekor public key: %w", err)
+	}
+	root.Tlogs = mergeLogs(root.Tlogs, rekor, end)
+	ctlog, err := logInstance(services.CTlog)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CTlog public key: %w", err)
+	}
+	root.Ctlogs = mergeLogs(root.Ctlogs, ctlog, end)
+
+	fulcio, err := authority(services.Fulcio)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Fulcio certificate chain: %w", err)
+	}
+	root.CertificateAuthorities = mergeAuthorities(root.CertificateAuthorities, fulcio, end)
+	if services.TSA != nil {
+		tsa, err := authority(*services.TSA)
+		if err != nil {
+			return nil, fmt.Errorf("invalid TSA certificate chain: %w", err)
+		}
+		root.TimestampAuthorities = mergeAuthorities(root.TimestampAuthorities, tsa, end)
+	} else {
+		root.TimestampAuthorities = mergeAuthorities(root.TimestampAuthorities, nil, end)
+	}
+	return json.MarshalIndent(root, "", "  ")
+}
+
+// SigningConfig returns the Sigstore signing configuration with the URLs of the services.
+func SigningConfig(services Services) ([]byte, error) {
+	config := signingConfig{
+		MediaType: signingConfigMediaType,
+		CAURL:     services.Fulcio.URL,
+		OIDCURL:   services.OIDCURL,
+	}
+	if services.Rekor.URL != "" {
+		config.TlogURLs = []string{services.Rekor.URL}
+	}
+	if services.TSA != nil && services.TSA.URL != "" {
+		config.TSAURLs = []string{services.TSA.URL}
+	}
+	return json.MarshalIndent(config, "", "  ")
+}
+
+func logInstance(log TransparencyLog) (*transparencyLogInstance, error) {
+	if len(log.PublicKey) == 0 {
+		return nil, nil
+	}
+	key, err := cryptoutils.UnmarshalPEMToPublicKey(log.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	details, err := keyDetails(key)
+	if err != nil {
+		return nil, err
+	}
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return nil, err
+	}
+	id := sha256.Sum256(der)
+	start := log.Start.UTC().Truncate(time.Second)
+	return &transparencyLogInstance{
+		BaseURL:       log.URL,
+		HashAlgorithm: "SHA2_256",
+		PublicKey: publicKey{
+			RawBytes:   der,
+			KeyDetails: details,
+			ValidFor:   validityPeriod{Start: &start},
+		},
+		LogID: logID{KeyID: id[:]},
+	}, nil
+}
+
+func keyDetails(key any) (string, error) {
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		switch k.Curve {
+		case elliptic.P256():
+			return "PKIX_ECDSA_P256_SHA_256", nil
+		case elliptic.P384():
+			return "PKIX_ECDSA_P384_SHA_384", nil
+		case elliptic.P521():
+			return "PKIX_ECDSA_P521_SHA_512", nil
+		}
+	case ed25519.PublicKey:
+		return "PKIX_ED25519", nil
+	case *rsa.PublicKey:
+		switch k.Size() * 8 {
+		case 2048, 3072, 4096:
+			return fmt.Sprintf("PKIX_RSA_PKCS1V15_%d_SHA256", k.Size()*8), nil
+		}
+	}
+	return "", fmt.Errorf("unsupported public key %T", key)
+}
+
+// mergeLogs keeps the current log first and ends the validity of the other logs.
+func mergeLogs(logs []transparencyLogInstance, current *transparencyLogInstance, end time.Time) []transparencyLogInstance {
+	merged := []transparencyLogInstance{}
+	if current != nil {
+		merged = append(merged, *current)
+	}
+	for _, log := range logs {
+		if current != nil && bytes.Equal(log.LogID.KeyID, current.LogID.KeyID) {
+			// the key is trusted since it was used first
+			if log.PublicKey.ValidFor.Start != nil && log.PublicKey.ValidFor.Start.Before(*current.PublicKey.ValidFor.Start) {
+				merged[0].PublicKey.ValidFor.Start = log.PublicKey.ValidFor.Start
+			}
+			continue
+		}
+		if log.PublicKey.ValidFor.End == nil {
+			log.PublicKey.ValidFor.End = &end
+		}
+		merged = append(merged, log)
+	}
+	return merged
+}
+
+func authority(ca CertificateAuthority) (*certificateAuthority, error) {
+	if len(ca.CertChain) == 0 {
+		return nil, nil
+	}
+	certs, err := cryptoutils.UnmarshalCertificatesFromPEM(ca.CertChain)
+	if err != nil {
+		return nil, err
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no certificate found")
+	}
+	authority := &certificateAuthority{
+		URI: ca.URL,
+		Subject: distinguishedName{
+			CommonName: certs[0].Subject.CommonName,
+		},
+	}
+	if len(certs[0].Subject.Organization) > 0 {
+		authority.Subject.Organization = certs[0].Subject.Organization[0]
+	}
+	for _, c := range certs {
+		authority.CertChain.Certificates = append(authority.CertChain.Certificates, certificate{RawBytes: c.Raw})
+	}
+	start := certs[0].NotBefore.UTC()
+	authority.ValidFor.Start = &start
+	return authority, nil
+}
+
+// mergeAuthorities keeps the current authority first and ends the validity of the other authorities.
+func mergeAuthorities(authorities []certificateAuthority, current *certificateAuthority, end time.Time) []certificateAuthority {
+	var merged []certificateAuthority
+	if current != nil {
+		merged = append(merged, *current)
+	}
+	for _, a := range authorities {
+		if current != nil && len(a.CertChain.Certificates) > 0 &&
+			bytes.Equal(a.CertChain.Certificates[0].RawBytes, current.CertChain.Certificates[0].RawBytes) {
+			continue
+		}
+		if a.ValidFor.End == nil {
+			a.ValidFor.End = &end
+		}
+		merged = append(merged, a)
+	}
+	return merged
+}
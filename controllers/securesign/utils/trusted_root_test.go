@@ -0,0 +1,132 @@
+package utils
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+)
+
+func publicKeyPEM(g *WithT) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	g.Expect(err).ShouldNot(HaveOccurred())
+	pem, err := cryptoutils.MarshalPublicKeyToPEM(key.Public())
+	g.Expect(err).ShouldNot(HaveOccurred())
+	return pem, der
+}
+
+func certificatePEM(g *WithT, notBefore time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "fulcio.local", Organization: []string{"Red Hat"}},
+		NotBefore:             notBefore,
+		NotAfter:              notBefore.Add(365 * 24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	cert, err := x509.ParseCertificate(der)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	pem, err := cryptoutils.MarshalCertificateToPEM(cert)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	return pem
+}
+
+func TestTrustedRoot(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	rekorKey, rekorDER := publicKeyPEM(g)
+	ctlogKey, _ := publicKeyPEM(g)
+	services := Services{
+		Fulcio:  CertificateAuthority{URL: "https://fulcio.local", CertChain: certificatePEM(g, now.Add(-time.Hour))},
+		Rekor:   TransparencyLog{URL: "https://rekor.local", PublicKey: rekorKey, Start: now.Add(-2 * time.Hour)},
+		CTlog:   TransparencyLog{URL: "http://ctlog.default.svc/trusted-artifact-signer", PublicKey: ctlogKey, Start: now.Add(-3 * time.Hour)},
+		OIDCURL: "https://oidc.local",
+	}
+
+	content, err := TrustedRoot(nil, services, now)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	root := trustedRoot{}
+	g.Expect(json.Unmarshal(content, &root)).To(Succeed())
+	g.Expect(root.MediaType).To(Equal(trustedRootMediaType))
+	g.Expect(root.Tlogs).To(HaveLen(1))
+	g.Expect(root.Tlogs[0].BaseURL).To(Equal("https://rekor.local"))
+	g.Expect(root.Tlogs[0].PublicKey.KeyDetails).To(Equal("PKIX_ECDSA_P256_SHA_256"))
+	g.Expect(root.Tlogs[0].PublicKey.RawBytes).To(Equal(rekorDER))
+	id := sha256.Sum256(rekorDER)
+	g.Expect(root.Tlogs[0].LogID.KeyID).To(Equal(id[:]))
+	g.Expect(*root.Tlogs[0].PublicKey.ValidFor.Start).To(Equal(now.Add(-2 * time.Hour)))
+	g.Expect(root.Tlogs[0].PublicKey.ValidFor.End).To(BeNil())
+	g.Expect(root.Ctlogs).To(HaveLen(1))
+	g.Expect(root.CertificateAuthorities).To(HaveLen(1))
+	g.Expect(root.CertificateAuthorities[0].Subject).To(Equal(distinguishedName{Organization: "Red Hat", CommonName: "fulcio.local"}))
+	g.Expect(root.CertificateAuthorities[0].URI).To(Equal("https://fulcio.local"))
+	g.Expect(*root.CertificateAuthorities[0].ValidFor.Start).To(Equal(now.Add(-time.Hour)))
+	g.Expect(root.TimestampAuthorities).To(BeEmpty())
+	g.Expect(string(content)).To(ContainSubstring(`"validFor": {`))
+
+	// unchanged services keep the trusted root
+	again, err := TrustedRoot(content, services, now.Add(time.Hour))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(again).To(Equal(content))
+
+	// the rotated key is kept until now
+	services.Rekor.PublicKey, _ = publicKeyPEM(g)
+	services.Rekor.Start = now
+	services.TSA = &CertificateAuthority{CertChain: certificatePEM(g, now)}
+	rotated, err := TrustedRoot(content, services, now.Add(time.Hour))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	root = trustedRoot{}
+	g.Expect(json.Unmarshal(rotated, &root)).To(Succeed())
+	g.Expect(root.Tlogs).To(HaveLen(2))
+	g.Expect(root.Tlogs[0].PublicKey.ValidFor.End).To(BeNil())
+	g.Expect(root.Tlogs[1].LogID.KeyID).To(Equal(id[:]))
+	g.Expect(*root.Tlogs[1].PublicKey.ValidFor.End).To(Equal(now.Add(time.Hour)))
+	g.Expect(root.Ctlogs).To(HaveLen(1))
+	g.Expect(root.TimestampAuthorities).To(HaveLen(1))
+
+	// the key of the disabled CTlog is kept until now
+	services.CTlog = TransparencyLog{}
+	disabled, err := TrustedRoot(rotated, services, now.Add(2*time.Hour))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	root = trustedRoot{}
+	g.Expect(json.Unmarshal(disabled, &root)).To(Succeed())
+	g.Expect(root.Ctlogs).To(HaveLen(1))
+	g.Expect(*root.Ctlogs[0].PublicKey.ValidFor.End).To(Equal(now.Add(2 * time.Hour)))
+	g.Expect(root.Tlogs).To(HaveLen(2))
+
+	services.Rekor.PublicKey = []byte("not a key")
+	_, err = TrustedRoot(nil, services, now)
+	g.Expect(err).To(MatchError(ContainSubstring("Rekor")))
+}
+
+func TestSigningConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	content, err := SigningConfig(Services{
+		Fulcio:  CertificateAuthority{URL: "https://fulcio.local"},
+		Rekor:   TransparencyLog{URL: "https://rekor.local"},
+		OIDCURL: "https://oidc.local",
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(content).To(MatchJSON(`{
+		"mediaType": "application/vnd.dev.sigstore.signingconfig.v0.1+json",
+		"caUrl": "https://fulcio.local",
+		"oidcUrl": "https://oidc.local",
+		"tlogUrls": ["https://rekor.local"]
+	}`))
+}
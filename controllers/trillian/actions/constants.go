@@ -1,20 +1,42 @@
 package actions
 
+import "github.com/securesign/operator/controllers/constants"
+
 const (
 	DbDeploymentName        = "trillian-db"
 	DbPvcName               = "trillian-mysql"
 	LogserverDeploymentName = "trillian-logserver"
 	LogsignerDeploymentName = "trillian-logsigner"
+	ElectionDeploymentName  = "trillian-election"
 
 	DbComponentName         = "trillian-db"
 	LogServerComponentName  = "trillian-logserver"
 	LogServerMonitoringName = "prometheus-k8s-logserver"
 	LogSignerComponentName  = "trillian-logsigner"
 	LogSignerMonitoringName = "prometheus-k8s-logsigner"
+	ElectionComponentName   = "trillian-election"
 
 	RBACName = "trillian"
 
+	CAConfigMapName        = "trillian-ca"
+	CAConfigMapKey         = "service-ca.crt"
+	InternalCASecretName   = "trillian-internal-ca"
+	LogserverTLSSecretName = "trillian-logserver-tls"
+	LogsignerTLSSecretName = "trillian-logsigner-tls"
+
 	DbCondition     = "DBAvailable"
 	ServerCondition = "LogServerAvailable"
 	SignerCondition = "LogSignerAvailable"
+	TreesCondition  = "TreesSynced"
+	SchemaCondition = "SchemaReady"
+	QuotaCondition  = "QuotasSynced"
+	LeaderCondition = "SignerLeadersResolved"
+
+	CredentialsCondition = "CredentialsRotated"
 )
+
+// PausedByRestoreAnnotation scales the Logsigner down while the database is restored, the value is the name of the TrillianRestore.
+const PausedByRestoreAnnotation = constants.LabelNamespace + "/paused-by-restore"
+
+// RotateDbCredentialsAnnotation requests the rotation of the managed database credentials whenever its value changes.
+const RotateDbCredentialsAnnotation = constants.LabelNamespace + "/rotate-db-credentials"
@@ -0,0 +1,113 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/action"
+	"github.com/securesign/operator/controllers/common/utils"
+	"github.com/securesign/operator/controllers/common/utils/kubernetes"
+	"github.com/securesign/operator/controllers/constants"
+	trillian "github.com/securesign/operator/controllers/trillian/actions"
+	trillianUtils "github.com/securesign/operator/controllers/trillian/utils"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewCheckAction() action.Action[rhtasv1alpha1.Trillian] {
+	return &checkAction{}
+}
+
+type checkAction struct {
+	action.BaseAction
+}
+
+func (i checkAction) Name() string {
+	return "check db"
+}
+
+func (i checkAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.Trillian) bool {
+	c := meta.FindStatusCondition(instance.Status.Conditions, constants.Ready)
+	return c.Reason == constants.Creating && instance.Status.Db.DatabaseSecretRef != nil &&
+		!meta.IsStatusConditionTrue(instance.Status.Conditions, trillian.DbCondition)
+}
+
+// Handle gates the rollout of the Logserver and Logsigner until the database is usable.
+func (i checkAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Trillian) *action.Result {
+	if utils.OptionalBool(instance.Spec.Db.Create) {
+		return i.waitForManagedDb(ctx, instance)
+	}
+
+	schemaPresent, err := i.check(ctx, instance)
+	if err != nil {
+		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+			Type:    trillian.DbCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  constants.Failure,
+			Message: err.Error(),
+		})
+		return i.FailedWithStatusUpdate(ctx, fmt.Errorf("external database is not usable: %w", err), instance)
+	}
+
+	message := fmt.Sprintf("Working with external DB, TLS mode %s, schema present: %t", trillianUtils.DBTLSMode(instance.Spec.Db), schemaPresent)
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:    trillian.DbCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  constants.Ready,
+		Message: message,
+	})
+	return i.StatusUpdate(ctx, instance)
+}
+
+// waitForManagedDb waits until the database pod passes the readiness probe.
+func (i checkAction) waitForManagedDb(ctx context.Context, instance *rhtasv1alpha1.Trillian) *action.Result {
+	dp := &appsv1.Deployment{}
+	if err := i.Client.Get(ctx, types.NamespacedName{Name: constants.InstanceName(trillian.DbDeploymentName, instance.Name), Namespace: instance.Namespace}, dp); client.IgnoreNotFound(err) != nil {
+		return i.Failed(err)
+	}
+	for _, c := range dp.Status.Conditions {
+		if c.Type == appsv1.DeploymentAvailable && c.Status == corev1.ConditionTrue {
+			meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+				Type:   trillian.DbCondition,
+				Status: metav1.ConditionTrue,
+				Reason: constants.Ready,
+			})
+			return i.StatusUpdate(ctx, instance)
+		}
+	}
+
+	if c := meta.FindStatusCondition(instance.Status.Conditions, trillian.DbCondition); c == nil || c.Reason != constants.Creating {
+		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+			Type:    trillian.DbCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  constants.Creating,
+			Message: "Waiting for database to be ready",
+		})
+		return i.StatusUpdate(ctx, instance)
+	}
+	i.Logger.Info("Waiting for database deployment")
+	return i.Requeue()
+}
+
+// check verifies connectivity of the external database and reports whether the schema is present.
+// The schema is provisioned by the Operator when it is missing.
+func (i checkAction) check(ctx context.Context, instance *rhtasv1alpha1.Trillian) (bool, error) {
+	secret, err := kubernetes.GetSecret(i.Client, instance.Namespace, instance.Status.Db.DatabaseSecretRef.Name)
+	if err != nil {
+		return false, fmt.Errorf("could not read database secret: %w", err)
+	}
+	caCert, err := kubernetes.GetSecretData(i.Client, instance.Namespace, instance.Spec.Db.TLS.CACertRef)
+	if err != nil {
+		return false, fmt.Errorf("could not read database CA certificate: %w", err)
+	}
+	conn, err := trillianUtils.NewDatabaseConnection(secret.Data, instance.Spec.Db, caCert)
+	if err != nil {
+		return false, err
+	}
+	return trillianUtils.CheckDatabase(ctx, conn)
+}
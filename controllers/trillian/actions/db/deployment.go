@@ -43,7 +43,7 @@ func (i deployAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Trilli
 	openshift = kubernetes.IsOpenShift(i.Client)
 
 	labels := constants.LabelsFor(actions.DbComponentName, actions.DbDeploymentName, instance.Name)
-	db, err := trillianUtils.CreateTrillDb(instance, actions.DbDeploymentName, actions.RBACName, openshift, labels)
+	db, err := trillianUtils.CreateTrillDb(instance, constants.InstanceName(actions.DbDeploymentName, instance.Name), constants.InstanceName(actions.RBACName, instance.Name), openshift, labels)
 	if err != nil {
 		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
 			Type:    actions.DbCondition,
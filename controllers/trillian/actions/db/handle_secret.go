@@ -21,8 +21,15 @@ import (
 const (
 	port = 3306
 	host = "trillian-mysql"
+
+	passwordLength = 12
 )
 
+// service returns the host and port of the managed database.
+func service(instance *rhtasv1alpha1.Trillian) (string, int) {
+	return constants.InstanceName(host, instance.Name), port
+}
+
 func NewHandleSecretAction() action.Action[rhtasv1alpha1.Trillian] {
 	return &handleSecretAction{}
 }
@@ -45,7 +52,7 @@ func (i handleSecretAction) Handle(ctx context.Context, instance *rhtasv1alpha1.
 		if instance.Spec.Db.DatabaseSecretRef != nil {
 			instance.Status.Db.DatabaseSecretRef = instance.Spec.Db.DatabaseSecretRef
 			meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{Type: trillian.DbCondition,
-				Status: metav1.ConditionTrue, Reason: constants.Ready, Message: "Working with external DB"})
+				Status: metav1.ConditionFalse, Reason: constants.Creating, Message: "Checking external DB"})
 		} else {
 			meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{Type: trillian.DbCondition,
 				Status: metav1.ConditionFalse, Reason: constants.Failure, Message: "Expecting external DB configuration"})
@@ -57,7 +64,7 @@ func (i handleSecretAction) Handle(ctx context.Context, instance *rhtasv1alpha1.
 	)
 	dbLabels := constants.LabelsFor(trillian.DbComponentName, trillian.DbDeploymentName, instance.Name)
 
-	dbSecret := i.createDbSecret(instance.Namespace, dbLabels)
+	dbSecret := i.createDbSecret(instance, dbLabels)
 	if err = controllerutil.SetControllerReference(instance, dbSecret, i.Client.Scheme()); err != nil {
 		return i.Failed(fmt.Errorf("could not set controller reference for secret: %w", err))
 	}
@@ -84,16 +91,17 @@ func (i handleSecretAction) Handle(ctx context.Context, instance *rhtasv1alpha1.
 	}
 	return i.StatusUpdate(ctx, instance)
 }
-func (i handleSecretAction) createDbSecret(namespace string, labels map[string]string) *corev1.Secret {
+func (i handleSecretAction) createDbSecret(instance *rhtasv1alpha1.Trillian, labels map[string]string) *corev1.Secret {
 	// Define a new Secret object
 	var rootPass []byte
 	var mysqlPass []byte
-	rootPass = common.GeneratePassword(12)
-	mysqlPass = common.GeneratePassword(12)
+	rootPass = common.GeneratePassword(passwordLength)
+	mysqlPass = common.GeneratePassword(passwordLength)
+	host, port := service(instance)
 	return &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			GenerateName: "rhtas",
-			Namespace:    namespace,
+			Namespace:    instance.Namespace,
 			Labels:       labels,
 		},
 		Type: "Opaque",
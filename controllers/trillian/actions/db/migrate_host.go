@@ -0,0 +1,48 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/action"
+	"github.com/securesign/operator/controllers/common/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func NewMigrateHostAction() action.Action[rhtasv1alpha1.Trillian] {
+	return &migrateHostAction{}
+}
+
+// migrateHostAction points the managed database secret to the service scoped by the instance name.
+// The secret created by earlier versions refers to the service shared by all instances in the namespace.
+type migrateHostAction struct {
+	action.BaseAction
+}
+
+func (i migrateHostAction) Name() string {
+	return "migrate db host"
+}
+
+func (i migrateHostAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.Trillian) bool {
+	return utils.OptionalBool(instance.Spec.Db.Create) && instance.Status.Db.DatabaseSecretRef != nil
+}
+
+func (i migrateHostAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Trillian) *action.Result {
+	secret := &corev1.Secret{}
+	if err := i.Client.Get(ctx, types.NamespacedName{Name: instance.Status.Db.DatabaseSecretRef.Name, Namespace: instance.Namespace}, secret); err != nil {
+		return i.Failed(fmt.Errorf("could not get database secret: %w", err))
+	}
+	current := string(secret.Data["mysql-host"])
+	if current != host {
+		return i.Continue()
+	}
+	scoped, _ := service(instance)
+	secret.Data["mysql-host"] = []byte(scoped)
+	if err := i.Client.Update(ctx, secret); err != nil {
+		return i.Failed(fmt.Errorf("could not update database secret: %w", err))
+	}
+	i.Recorder.Eventf(instance, corev1.EventTypeNormal, "DatabaseHostMigrated", "Database host changed from %s to %s", current, scoped)
+	return i.Continue()
+}
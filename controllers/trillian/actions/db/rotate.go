@@ -0,0 +1,214 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common"
+	"github.com/securesign/operator/controllers/common/action"
+	"github.com/securesign/operator/controllers/common/utils"
+	"github.com/securesign/operator/controllers/common/utils/kubernetes"
+	"github.com/securesign/operator/controllers/constants"
+	"github.com/securesign/operator/controllers/trillian/actions"
+	trillianUtils "github.com/securesign/operator/controllers/trillian/utils"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func NewRotateCredentialsAction() action.Action[rhtasv1alpha1.Trillian] {
+	return &rotateCredentialsAction{}
+}
+
+type rotateCredentialsAction struct {
+	action.BaseAction
+}
+
+func (i rotateCredentialsAction) Name() string {
+	return "rotate credentials"
+}
+
+func (i rotateCredentialsAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.Trillian) bool {
+	c := meta.FindStatusCondition(instance.Status.Conditions, constants.Ready)
+	if c.Reason != constants.Ready || !utils.OptionalBool(instance.Spec.Db.Create) || instance.Status.Db.DatabaseSecretRef == nil {
+		return false
+	}
+	if instance.Status.DbCredentials.PendingSecretRef != nil || rotationRequested(instance) {
+		return true
+	}
+	due, err := trillianUtils.RotationDue(instance, time.Now())
+	if err != nil {
+		// report the invalid schedule once
+		c = meta.FindStatusCondition(instance.Status.Conditions, actions.CredentialsCondition)
+		return c == nil || c.Message != err.Error()
+	}
+	return due
+}
+
+// Handle rotates the credentials without interrupting the Logserver and Logsigner, each step is persisted in the status:
+// the new credentials are written to a new secret, a Job adds the new passwords while the old ones are still accepted,
+// the database secret is updated and the Logserver and Logsigner are rolled out, then a Job removes the old passwords.
+// The database secret is updated in place so the database itself is not restarted.
+func (i rotateCredentialsAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Trillian) *action.Result {
+	if _, err := trillianUtils.RotationDue(instance, time.Now()); err != nil {
+		return i.fail(ctx, instance, err)
+	}
+
+	current, err := kubernetes.GetSecret(i.Client, instance.Namespace, instance.Status.Db.DatabaseSecretRef.Name)
+	if err != nil {
+		return i.Failed(fmt.Errorf("could not get database secret: %w", err))
+	}
+
+	pending := instance.Status.DbCredentials.PendingSecretRef
+	if pending == nil {
+		secret := kubernetes.CreateSecret("", instance.Namespace, maps.Clone(current.Data),
+			constants.LabelsFor(actions.DbComponentName, actions.DbDeploymentName, instance.Name))
+		secret.GenerateName = "rhtas"
+		secret.Data[trillianUtils.DbRootPasswordKey] = common.GeneratePassword(passwordLength)
+		secret.Data[trillianUtils.DbPasswordKey] = common.GeneratePassword(passwordLength)
+		secret.Data[trillianUtils.DbUserKey] = []byte(trillianUtils.AlternateUser(string(current.Data[trillianUtils.DbUserKey])))
+		if err = controllerutil.SetControllerReference(instance, secret, i.Client.Scheme()); err != nil {
+			return i.Failed(fmt.Errorf("could not set controller reference for secret: %w", err))
+		}
+		if err = i.Client.Create(ctx, secret); err != nil {
+			return i.fail(ctx, instance, fmt.Errorf("could not create secret with new credentials: %w", err))
+		}
+
+		instance.Status.DbCredentials.PendingSecretRef = &rhtasv1alpha1.LocalObjectReference{Name: secret.Name}
+		instance.Status.DbCredentials.Step = trillianUtils.CredentialsRetain
+		instance.Status.DbCredentials.LastRotationRequest = instance.Annotations[actions.RotateDbCredentialsAnnotation]
+		i.Recorder.Event(instance, corev1.EventTypeNormal, "CredentialsRotationStarted", "Rotating database credentials")
+		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+			Type:    actions.CredentialsCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  constants.Creating,
+			Message: "Rotating database credentials",
+		})
+		return i.StatusUpdate(ctx, instance)
+	}
+
+	if instance.Status.DbCredentials.Step == trillianUtils.CredentialsDiscard {
+		return i.discard(ctx, instance, current)
+	}
+
+	if result := i.runJob(ctx, instance, trillianUtils.CredentialsRetain, string(current.Data[trillianUtils.DbUserKey])); result != nil {
+		return result
+	}
+
+	newCredentials, err := kubernetes.GetSecret(i.Client, instance.Namespace, pending.Name)
+	if err != nil {
+		return i.Failed(fmt.Errorf("could not get secret with new credentials: %w", err))
+	}
+	current.Data = newCredentials.Data
+	if err = i.Client.Update(ctx, current); err != nil {
+		return i.Failed(fmt.Errorf("could not update database secret: %w", err))
+	}
+
+	// the rotation time in the pod template rolls the Logserver and Logsigner out
+	now := metav1.Now()
+	instance.Status.DbCredentials.LastRotationTime = &now
+	instance.Status.DbCredentials.Step = trillianUtils.CredentialsDiscard
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:    actions.CredentialsCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  constants.Creating,
+		Message: "Waiting for Logserver and Logsigner to use new database credentials",
+	})
+	return i.StatusUpdate(ctx, instance)
+}
+
+// discard removes the old passwords once the Logserver and Logsigner run with the new credentials.
+func (i rotateCredentialsAction) discard(ctx context.Context, instance *rhtasv1alpha1.Trillian, current *corev1.Secret) *action.Result {
+	for _, name := range []string{actions.LogserverDeploymentName, actions.LogsignerDeploymentName} {
+		dp := &appsv1.Deployment{}
+		if err := i.Client.Get(ctx, types.NamespacedName{Name: constants.InstanceName(name, instance.Name), Namespace: instance.Namespace}, dp); err != nil {
+			return i.Failed(fmt.Errorf("could not get %s deployment: %w", name, err))
+		}
+		if !trillianUtils.CredentialsRolledOut(dp, instance.Status.DbCredentials.LastRotationTime) {
+			i.Logger.Info("Waiting for rollout with new database credentials", "name", dp.Name)
+			return i.Requeue()
+		}
+	}
+
+	if result := i.runJob(ctx, instance, trillianUtils.CredentialsDiscard, string(current.Data[trillianUtils.DbUserKey])); result != nil {
+		return result
+	}
+
+	pending := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: instance.Status.DbCredentials.PendingSecretRef.Name, Namespace: instance.Namespace}}
+	if err := i.Client.Delete(ctx, pending); client.IgnoreNotFound(err) != nil {
+		return i.Failed(fmt.Errorf("could not delete secret with new credentials: %w", err))
+	}
+
+	instance.Status.DbCredentials.PendingSecretRef = nil
+	instance.Status.DbCredentials.Step = ""
+	i.Recorder.Event(instance, corev1.EventTypeNormal, "CredentialsRotated", "Database credentials rotated")
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:    actions.CredentialsCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  constants.Ready,
+		Message: "Database credentials rotated",
+	})
+	return i.StatusUpdate(ctx, instance)
+}
+
+// runJob creates the Job of the rotation step, it returns nil once the Job succeeded.
+func (i rotateCredentialsAction) runJob(ctx context.Context, instance *rhtasv1alpha1.Trillian, step string, user string) *action.Result {
+	name := "trillian-credentials-" + instance.Status.DbCredentials.PendingSecretRef.Name
+	if step == trillianUtils.CredentialsDiscard {
+		name = "trillian-credentials-discard-" + instance.Status.DbCredentials.PendingSecretRef.Name
+	}
+	job := &batchv1.Job{}
+	err := i.Client.Get(ctx, types.NamespacedName{Name: name, Namespace: instance.Namespace}, job)
+	switch {
+	case apierrors.IsNotFound(err):
+		labels := constants.LabelsFor(actions.DbComponentName, name, instance.Name)
+		if job, err = trillianUtils.CreateRotationJob(instance, step, user, constants.TrillianDbImage, name, constants.InstanceName(actions.RBACName, instance.Name), labels); err != nil {
+			return i.fail(ctx, instance, err)
+		}
+		if err = controllerutil.SetControllerReference(instance, job, i.Client.Scheme()); err != nil {
+			return i.Failed(fmt.Errorf("could not set controller reference for Job: %w", err))
+		}
+		if err = i.Client.Create(ctx, job); err != nil {
+			return i.fail(ctx, instance, fmt.Errorf("could not create credentials rotation Job: %w", err))
+		}
+		return i.Requeue()
+	case err != nil:
+		return i.Failed(err)
+	}
+
+	finished, err := trillianUtils.JobFinished(job)
+	if err != nil {
+		// the Job is created again once the failed one is deleted
+		return i.fail(ctx, instance, err)
+	}
+	if !finished {
+		i.Logger.Info("Waiting for credentials rotation job", "name", job.Name)
+		return i.Requeue()
+	}
+	return nil
+}
+
+func (i rotateCredentialsAction) fail(ctx context.Context, instance *rhtasv1alpha1.Trillian, err error) *action.Result {
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:    actions.CredentialsCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  constants.Failure,
+		Message: err.Error(),
+	})
+	return i.FailedWithStatusUpdate(ctx, fmt.Errorf("could not rotate database credentials: %w", err), instance)
+}
+
+// rotationRequested reports whether the rotation annotation has a value not handled yet.
+func rotationRequested(instance *rhtasv1alpha1.Trillian) bool {
+	request, ok := instance.Annotations[actions.RotateDbCredentialsAnnotation]
+	return ok && request != instance.Status.DbCredentials.LastRotationRequest
+}
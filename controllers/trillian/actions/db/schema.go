@@ -0,0 +1,198 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/action"
+	"github.com/securesign/operator/controllers/common/utils/kubernetes"
+	"github.com/securesign/operator/controllers/constants"
+	"github.com/securesign/operator/controllers/trillian/actions"
+	trillianUtils "github.com/securesign/operator/controllers/trillian/utils"
+	"golang.org/x/mod/semver"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// schemaAttemptsAnnotation counts the failed schema Jobs, it is kept on the ConfigMap of the script.
+	schemaAttemptsAnnotation = "rhtas.redhat.com/schema-attempts"
+
+	schemaRetryDelay    = 10 * time.Second
+	schemaRetryMaxDelay = 10 * time.Minute
+)
+
+func NewSchemaAction() action.Action[rhtasv1alpha1.Trillian] {
+	return &schemaAction{}
+}
+
+type schemaAction struct {
+	action.BaseAction
+}
+
+func (i schemaAction) Name() string {
+	return "provision schema"
+}
+
+func (i schemaAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.Trillian) bool {
+	c := meta.FindStatusCondition(instance.Status.Conditions, constants.Ready)
+	schema := meta.FindStatusCondition(instance.Status.Conditions, actions.SchemaCondition)
+	return (c.Reason == constants.Creating || c.Reason == constants.Ready ||
+		(c.Reason == constants.Failure && schema != nil && schema.Reason == constants.Failure)) &&
+		meta.IsStatusConditionTrue(instance.Status.Conditions, actions.DbCondition) &&
+		(instance.Status.SchemaVersion == "" || semver.Compare(instance.Status.SchemaVersion, schemaVersion()) < 0)
+}
+
+// schemaVersion returns the version of the schema required by the Trillian log server.
+func schemaVersion() string {
+	return trillianUtils.SchemaVersion(constants.TrillianServerImage)
+}
+
+// Handle provisions or migrates the schema, the Logserver and Logsigner are not rolled out until the Job succeeds.
+// A failed Job is deleted and created again with an exponential backoff.
+func (i schemaAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Trillian) *action.Result {
+	version := schemaVersion()
+	name := constants.InstanceName(trillianUtils.SchemaResourceName(version), instance.Name)
+	labels := constants.LabelsFor(actions.DbComponentName, name, instance.Name)
+
+	cm := kubernetes.InitConfigmap(instance.Namespace, name, labels, map[string]string{
+		trillianUtils.SchemaScriptKey: trillianUtils.SchemaScript(instance.Status.SchemaVersion, version),
+	})
+	if err := controllerutil.SetControllerReference(instance, cm, i.Client.Scheme()); err != nil {
+		return i.Failed(fmt.Errorf("could not set controller reference for ConfigMap: %w", err))
+	}
+	if _, err := i.Ensure(ctx, cm); err != nil {
+		return i.fail(ctx, instance, fmt.Errorf("could not create schema ConfigMap: %w", err))
+	}
+
+	job := &batchv1.Job{}
+	err := i.Client.Get(ctx, types.NamespacedName{Name: name, Namespace: instance.Namespace}, job)
+	if err == nil && job.DeletionTimestamp != nil {
+		i.Logger.Info("Waiting for failed schema job to be deleted", "name", job.Name)
+		return i.Requeue()
+	}
+	switch {
+	case apierrors.IsNotFound(err):
+		if job, err = trillianUtils.CreateSchemaJob(instance, constants.TrillianDbImage, name, constants.InstanceName(actions.RBACName, instance.Name), labels); err != nil {
+			return i.fail(ctx, instance, err)
+		}
+		if err = controllerutil.SetControllerReference(instance, job, i.Client.Scheme()); err != nil {
+			return i.Failed(fmt.Errorf("could not set controller reference for Job: %w", err))
+		}
+		if err = i.Client.Create(ctx, job); err != nil {
+			return i.fail(ctx, instance, fmt.Errorf("could not create schema Job: %w", err))
+		}
+		message := "Provisioning schema " + version
+		if instance.Status.SchemaVersion != "" {
+			message = fmt.Sprintf("Migrating schema from %s to %s", instance.Status.SchemaVersion, version)
+		}
+		i.Recorder.Event(instance, corev1.EventTypeNormal, "SchemaJobCreated", message)
+		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+			Type:    actions.SchemaCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  constants.Creating,
+			Message: message,
+		})
+		return i.StatusUpdate(ctx, instance)
+	case err != nil:
+		return i.Failed(err)
+	}
+
+	finished, err := trillianUtils.JobFinished(job)
+	if err != nil {
+		return i.retry(ctx, instance, job, err)
+	}
+	if !finished {
+		i.Logger.Info("Waiting for schema job", "name", job.Name)
+		return i.Requeue()
+	}
+
+	i.Recorder.Eventf(instance, corev1.EventTypeNormal, "SchemaReady", "Trillian schema %s is ready", version)
+	instance.Status.SchemaVersion = version
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:    actions.SchemaCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  constants.Ready,
+		Message: "Schema " + version,
+	})
+	if meta.FindStatusCondition(instance.Status.Conditions, constants.Ready).Reason == constants.Failure {
+		// resume the rollout stopped by the failed Job
+		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{Type: constants.Ready,
+			Status: metav1.ConditionFalse, Reason: constants.Creating})
+	}
+	return i.StatusUpdate(ctx, instance)
+}
+
+// retry reports the failed Job and deletes it once the backoff of the attempt elapsed, so the next reconciliation creates it again.
+func (i schemaAction) retry(ctx context.Context, instance *rhtasv1alpha1.Trillian, job *batchv1.Job, jobErr error) *action.Result {
+	cm := &corev1.ConfigMap{}
+	if err := i.Client.Get(ctx, client.ObjectKeyFromObject(job), cm); err != nil {
+		return i.Failed(err)
+	}
+	attempts, _ := strconv.Atoi(cm.Annotations[schemaAttemptsAnnotation])
+
+	for _, t := range []string{actions.SchemaCondition, constants.Ready} {
+		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+			Type:    t,
+			Status:  metav1.ConditionFalse,
+			Reason:  constants.Failure,
+			Message: fmt.Sprintf("%s, attempt %d", jobErr.Error(), attempts+1),
+		})
+	}
+	if err := i.Client.Status().Update(ctx, instance); err != nil {
+		return i.Failed(err)
+	}
+
+	if wait := schemaRetryBackoff(attempts) - time.Since(jobFailedAt(job)); wait > 0 {
+		i.Logger.Info("Schema job failed, waiting before retry", "name", job.Name, "retryAfter", wait)
+		return &action.Result{Result: reconcile.Result{RequeueAfter: wait}}
+	}
+
+	if _, err := kubernetes.EnsureAnnotations(ctx, i.Client, cm, map[string]string{schemaAttemptsAnnotation: strconv.Itoa(attempts + 1)}); err != nil {
+		return i.Failed(err)
+	}
+	if err := i.Client.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+		return i.Failed(fmt.Errorf("could not delete failed schema Job: %w", err))
+	}
+	i.Recorder.Eventf(instance, corev1.EventTypeWarning, "SchemaJobFailed", "Retrying failed schema job %s: %v", job.Name, jobErr)
+	return i.Requeue()
+}
+
+// schemaRetryBackoff doubles the delay of every failed attempt up to schemaRetryMaxDelay.
+func schemaRetryBackoff(attempts int) time.Duration {
+	delay := schemaRetryDelay
+	for ; attempts > 0 && delay < schemaRetryMaxDelay; attempts-- {
+		delay *= 2
+	}
+	return min(delay, schemaRetryMaxDelay)
+}
+
+// jobFailedAt returns the time the Job failed, the creation time when the Job has no failure condition.
+func jobFailedAt(job *batchv1.Job) time.Time {
+	for _, c := range job.Status.Conditions {
+		if c.Type == batchv1.JobFailed && c.Status == corev1.ConditionTrue {
+			return c.LastTransitionTime.Time
+		}
+	}
+	return job.CreationTimestamp.Time
+}
+
+func (i schemaAction) fail(ctx context.Context, instance *rhtasv1alpha1.Trillian, err error) *action.Result {
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:    actions.SchemaCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  constants.Failure,
+		Message: err.Error(),
+	})
+	return i.FailedWithStatusUpdate(ctx, fmt.Errorf("could not provision trillian schema: %w", err), instance)
+}
@@ -0,0 +1,81 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+	testAction "github.com/securesign/operator/controllers/common/test/action"
+	"github.com/securesign/operator/controllers/constants"
+	"github.com/securesign/operator/controllers/trillian/actions"
+	trillianUtils "github.com/securesign/operator/controllers/trillian/utils"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestSchemaRetry(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.TODO()
+
+	instance := &v1alpha1.Trillian{
+		ObjectMeta: metav1.ObjectMeta{Name: "trillian", Namespace: "default"},
+		Status: v1alpha1.TrillianStatus{
+			Db: v1alpha1.TrillianDB{DatabaseSecretRef: &v1alpha1.LocalObjectReference{Name: "db"}},
+		},
+	}
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{Type: constants.Ready, Status: metav1.ConditionFalse, Reason: constants.Creating})
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{Type: actions.DbCondition, Status: metav1.ConditionTrue, Reason: constants.Ready})
+	c := testAction.FakeClientBuilder().WithStatusSubresource(instance).WithObjects(instance).Build()
+	a := testAction.PrepareAction(c, NewSchemaAction())
+	key := types.NamespacedName{Name: constants.InstanceName(trillianUtils.SchemaResourceName(schemaVersion()), instance.Name), Namespace: "default"}
+
+	g.Expect(a.CanHandle(ctx, instance)).To(BeTrue())
+	g.Expect(a.Handle(ctx, instance)).ToNot(BeNil())
+	job := &batchv1.Job{}
+	g.Expect(c.Get(ctx, key, job)).To(Succeed())
+
+	// the failed Job is kept until the backoff elapses
+	failure := batchv1.JobCondition{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Message: "BackoffLimitExceeded",
+		LastTransitionTime: metav1.Now()}
+	job.Status.Conditions = []batchv1.JobCondition{failure}
+	g.Expect(c.Status().Update(ctx, job)).To(Succeed())
+	result := a.Handle(ctx, instance)
+	g.Expect(result.Err).ToNot(HaveOccurred())
+	g.Expect(result.Result.RequeueAfter).To(BeNumerically("~", schemaRetryDelay, time.Second))
+	g.Expect(c.Get(ctx, key, &batchv1.Job{})).To(Succeed())
+	g.Expect(meta.FindStatusCondition(instance.Status.Conditions, constants.Ready)).To(And(
+		HaveField("Reason", constants.Failure), HaveField("Message", ContainSubstring("BackoffLimitExceeded"))))
+	g.Expect(a.CanHandle(ctx, instance)).To(BeTrue())
+
+	failure.LastTransitionTime = metav1.NewTime(time.Now().Add(-schemaRetryDelay))
+	job.Status.Conditions = []batchv1.JobCondition{failure}
+	g.Expect(c.Status().Update(ctx, job)).To(Succeed())
+	g.Expect(a.Handle(ctx, instance)).ToNot(BeNil())
+	g.Expect(apierrors.IsNotFound(c.Get(ctx, key, &batchv1.Job{}))).To(BeTrue())
+	cm := &corev1.ConfigMap{}
+	g.Expect(c.Get(ctx, key, cm)).To(Succeed())
+	g.Expect(cm.Annotations).To(HaveKeyWithValue(schemaAttemptsAnnotation, "1"))
+
+	// the Job is created again and its success resumes the rollout
+	g.Expect(a.Handle(ctx, instance)).ToNot(BeNil())
+	g.Expect(c.Get(ctx, key, job)).To(Succeed())
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+	g.Expect(c.Status().Update(ctx, job)).To(Succeed())
+	g.Expect(a.Handle(ctx, instance)).ToNot(BeNil())
+	g.Expect(instance.Status.SchemaVersion).To(Equal(schemaVersion()))
+	g.Expect(meta.FindStatusCondition(instance.Status.Conditions, constants.Ready).Reason).To(Equal(constants.Creating))
+	g.Expect(a.CanHandle(ctx, instance)).To(BeFalse())
+}
+
+func TestSchemaRetryBackoff(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(schemaRetryBackoff(0)).To(Equal(schemaRetryDelay))
+	g.Expect(schemaRetryBackoff(2)).To(Equal(4 * schemaRetryDelay))
+	g.Expect(schemaRetryBackoff(100)).To(Equal(schemaRetryMaxDelay))
+}
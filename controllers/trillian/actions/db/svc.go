@@ -41,13 +41,14 @@ func (i createServiceAction) Handle(ctx context.Context, instance *rhtasv1alpha1
 	)
 
 	labels := constants.LabelsFor(actions.DbComponentName, actions.DbDeploymentName, instance.Name)
-	mysql := k8sutils.CreateService(instance.Namespace, host, port, labels)
+	host, port := service(instance)
+	svc := k8sutils.CreateService(instance.Namespace, host, port, labels)
 
-	if err = controllerutil.SetControllerReference(instance, mysql, i.Client.Scheme()); err != nil {
+	if err = controllerutil.SetControllerReference(instance, svc, i.Client.Scheme()); err != nil {
 		return i.Failed(fmt.Errorf("could not set controller reference for DB service: %w", err))
 	}
 
-	if updated, err = i.Ensure(ctx, mysql); err != nil {
+	if updated, err = i.Ensure(ctx, svc); err != nil {
 		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
 			Type:    actions.DbCondition,
 			Status:  metav1.ConditionFalse,
@@ -0,0 +1,45 @@
+package actions
+
+import (
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common"
+	"github.com/securesign/operator/controllers/constants"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// LegacyNames lists the objects created before the names were scoped by the instance.
+// The internal CA is kept, the other objects are created again under the new names.
+func LegacyNames(instance *rhtasv1alpha1.Trillian) []common.LegacyName {
+	return []common.LegacyName{
+		{Object: &v1.ServiceAccount{}, Name: RBACName},
+		{Object: &rbacv1.Role{}, Name: RBACName},
+		{Object: &rbacv1.RoleBinding{}, Name: RBACName},
+
+		{Object: &appsv1.Deployment{}, Name: DbDeploymentName},
+		{Object: &v1.Service{}, Name: "trillian-mysql"},
+
+		{Object: &appsv1.Deployment{}, Name: LogserverDeploymentName},
+		{Object: &v1.Service{}, Name: LogserverDeploymentName},
+		{Object: &rbacv1.Role{}, Name: LogServerMonitoringName},
+		{Object: &rbacv1.RoleBinding{}, Name: LogServerMonitoringName},
+		{Object: &monitoringv1.ServiceMonitor{}, Name: LogServerComponentName},
+
+		{Object: &appsv1.Deployment{}, Name: LogsignerDeploymentName},
+		{Object: &v1.Service{}, Name: LogsignerDeploymentName},
+		{Object: &rbacv1.Role{}, Name: LogSignerMonitoringName},
+		{Object: &rbacv1.RoleBinding{}, Name: LogSignerMonitoringName},
+		{Object: &monitoringv1.ServiceMonitor{}, Name: LogSignerComponentName},
+
+		{Object: &appsv1.Deployment{}, Name: ElectionDeploymentName},
+		{Object: &v1.Service{}, Name: ElectionDeploymentName},
+		{Object: &monitoringv1.ServiceMonitor{}, Name: ElectionComponentName},
+
+		{Object: &v1.ConfigMap{}, Name: CAConfigMapName},
+		{Object: &v1.Secret{}, Name: InternalCASecretName, Rename: constants.InstanceName(InternalCASecretName, instance.Name)},
+		{Object: &v1.Secret{}, Name: LogserverTLSSecretName},
+		{Object: &v1.Secret{}, Name: LogsignerTLSSecretName},
+	}
+}
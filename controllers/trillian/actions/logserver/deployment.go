@@ -43,8 +43,8 @@ func (i deployAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Trilli
 
 	labels := constants.LabelsFor(actions.LogServerComponentName, actions.LogserverDeploymentName, instance.Name)
 	server, err := trillianUtils.CreateTrillDeployment(instance, constants.TrillianServerImage,
-		actions.LogserverDeploymentName,
-		actions.RBACName,
+		constants.InstanceName(actions.LogserverDeploymentName, instance.Name),
+		constants.InstanceName(actions.RBACName, instance.Name),
 		labels)
 	server.Spec.Template.Spec.Containers[0].Ports = append(server.Spec.Template.Spec.Containers[0].Ports, corev1.ContainerPort{
 		Protocol:      corev1.ProtocolTCP,
@@ -66,6 +66,14 @@ func (i deployAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Trilli
 		return i.FailedWithStatusUpdate(ctx, fmt.Errorf("could not create Trillian server: %w", err), instance)
 	}
 
+	trillianUtils.UseQuota(server, instance, constants.InstanceName(actions.ElectionDeploymentName, instance.Name))
+	if instance.Spec.Quota.DryRun {
+		server.Spec.Template.Spec.Containers[0].Args = append(server.Spec.Template.Spec.Containers[0].Args, "--quota_dry_run=true")
+	}
+	if instance.Status.TLS != nil {
+		trillianUtils.UseTLS(server, instance.Status.TLS.LogServerCertRef)
+	}
+
 	if err = controllerutil.SetControllerReference(instance, server, i.Client.Scheme()); err != nil {
 		return i.Failed(fmt.Errorf("could not set controller reference for server: %w", err))
 	}
@@ -41,7 +41,7 @@ func (i monitoringAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Tr
 	monitoringLabels := constants.LabelsFor(actions.LogServerComponentName, actions.LogServerMonitoringName, instance.Name)
 	role := kubernetes.CreateRole(
 		instance.Namespace,
-		actions.LogServerMonitoringName,
+		constants.InstanceName(actions.LogServerMonitoringName, instance.Name),
 		monitoringLabels,
 		[]v1.PolicyRule{
 			{
@@ -74,12 +74,12 @@ func (i monitoringAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Tr
 
 	roleBinding := kubernetes.CreateRoleBinding(
 		instance.Namespace,
-		actions.LogServerMonitoringName,
+		constants.InstanceName(actions.LogServerMonitoringName, instance.Name),
 		monitoringLabels,
 		v1.RoleRef{
 			APIGroup: v1.SchemeGroupVersion.Group,
 			Kind:     "Role",
-			Name:     actions.LogServerMonitoringName,
+			Name:     constants.InstanceName(actions.LogServerMonitoringName, instance.Name),
 		},
 		[]v1.Subject{
 			{Kind: "ServiceAccount", Name: "prometheus-k8s", Namespace: "openshift-monitoring"},
@@ -105,17 +105,20 @@ func (i monitoringAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Tr
 		return i.FailedWithStatusUpdate(ctx, fmt.Errorf("could not create monitoring RoleBinding: %w", err), instance)
 	}
 
+	endpoint := monitoringv1.Endpoint{
+		Interval: monitoringv1.Duration("30s"),
+		Port:     actions.LogServerMonitoringName,
+		Scheme:   "http",
+	}
+	if tlsConfig := actions.MonitoringTLSConfig(instance, constants.InstanceName(actions.LogserverDeploymentName, instance.Name)); tlsConfig != nil {
+		endpoint.Scheme = "https"
+		endpoint.TLSConfig = tlsConfig
+	}
 	serviceMonitor := kubernetes.CreateServiceMonitor(
 		instance.Namespace,
-		actions.LogServerComponentName,
+		constants.InstanceName(actions.LogServerComponentName, instance.Name),
 		monitoringLabels,
-		[]monitoringv1.Endpoint{
-			{
-				Interval: monitoringv1.Duration("30s"),
-				Port:     actions.LogServerMonitoringName,
-				Scheme:   "http",
-			},
-		},
+		[]monitoringv1.Endpoint{endpoint},
 		constants.LabelsForComponent(actions.LogServerComponentName, instance.Name),
 	)
 
@@ -46,7 +46,7 @@ func (i createServiceAction) Handle(ctx context.Context, instance *rhtasv1alpha1
 	)
 
 	labels := constants.LabelsFor(actions.LogServerComponentName, actions.LogserverDeploymentName, instance.Name)
-	logserverService := k8sutils.CreateService(instance.Namespace, actions.LogserverDeploymentName, serverPort, labels)
+	logserverService := k8sutils.CreateService(instance.Namespace, constants.InstanceName(actions.LogserverDeploymentName, instance.Name), serverPort, labels)
 
 	if instance.Spec.Monitoring.Enabled {
 		logserverService.Spec.Ports = append(logserverService.Spec.Ports, corev1.ServicePort{
@@ -77,6 +77,16 @@ func (i createServiceAction) Handle(ctx context.Context, instance *rhtasv1alpha1
 		return i.FailedWithStatusUpdate(ctx, fmt.Errorf("could not create logserver Service: %w", err), instance)
 	}
 
+	if instance.Status.TLS != nil {
+		if annotations := actions.ServingCertAnnotations(i.Client, instance, instance.Status.TLS.LogServerCertRef); annotations != nil {
+			annotated, err := k8sutils.EnsureAnnotations(ctx, i.Client, logserverService, annotations)
+			if err != nil {
+				return i.Failed(fmt.Errorf("could not annotate logserver Service: %w", err))
+			}
+			updated = updated || annotated
+		}
+	}
+
 	if updated {
 		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
 			Type:    actions.ServerCondition,
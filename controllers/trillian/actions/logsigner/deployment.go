@@ -40,10 +40,9 @@ func (i deployAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Trilli
 
 	labels := constants.LabelsFor(actions.LogSignerComponentName, actions.LogsignerDeploymentName, instance.Name)
 	signer, err := trillianUtils.CreateTrillDeployment(instance, constants.TrillianLogSignerImage,
-		actions.LogsignerDeploymentName,
-		actions.RBACName,
+		constants.InstanceName(actions.LogsignerDeploymentName, instance.Name),
+		constants.InstanceName(actions.RBACName, instance.Name),
 		labels)
-	signer.Spec.Template.Spec.Containers[0].Args = append(signer.Spec.Template.Spec.Containers[0].Args, "--force_master=true")
 	if err != nil {
 		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
 			Type:    actions.SignerCondition,
@@ -60,6 +59,17 @@ func (i deployAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Trilli
 		return i.FailedWithStatusUpdate(ctx, fmt.Errorf("could not create Trillian LogSigner: %w", err), instance)
 	}
 
+	trillianUtils.UseElection(signer, instance, constants.InstanceName(actions.ElectionDeploymentName, instance.Name))
+	trillianUtils.UseQuota(signer, instance, constants.InstanceName(actions.ElectionDeploymentName, instance.Name))
+	if instance.Status.TLS != nil {
+		trillianUtils.UseTLS(signer, instance.Status.TLS.LogSignerCertRef)
+	}
+
+	if _, paused := instance.Annotations[actions.PausedByRestoreAnnotation]; paused {
+		replicas := int32(0)
+		signer.Spec.Replicas = &replicas
+	}
+
 	if err = controllerutil.SetControllerReference(instance, signer, i.Client.Scheme()); err != nil {
 		return i.Failed(fmt.Errorf("could not set controller reference for LogSigner deployment: %w", err))
 	}
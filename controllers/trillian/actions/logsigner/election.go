@@ -0,0 +1,206 @@
+package logsigner
+
+import (
+	"context"
+	"fmt"
+
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/action"
+	k8sutils "github.com/securesign/operator/controllers/common/utils/kubernetes"
+	"github.com/securesign/operator/controllers/constants"
+	"github.com/securesign/operator/controllers/trillian/actions"
+	trillianUtils "github.com/securesign/operator/controllers/trillian/utils"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func NewElectionAction() action.Action[rhtasv1alpha1.Trillian] {
+	return &electionAction{}
+}
+
+type electionAction struct {
+	action.BaseAction
+}
+
+func (i electionAction) Name() string {
+	return "election"
+}
+
+func (i electionAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.Trillian) bool {
+	c := meta.FindStatusCondition(instance.Status.Conditions, constants.Ready)
+	return c.Reason == constants.Creating || c.Reason == constants.Ready
+}
+
+// Handle deploys the etcd cluster running the election of multiple Logsigner replicas and storing the quota token buckets.
+// The server is removed when the Logsigner is scaled down to a single replica and no token bucket is configured.
+func (i electionAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Trillian) *action.Result {
+	if !trillianUtils.ElectionEnabled(instance) &&
+		(instance.Status.SignerLeaders != nil || meta.FindStatusCondition(instance.Status.Conditions, actions.LeaderCondition) != nil) {
+		instance.Status.SignerLeaders = nil
+		meta.RemoveStatusCondition(&instance.Status.Conditions, actions.LeaderCondition)
+		return i.StatusUpdate(ctx, instance)
+	}
+	if !trillianUtils.ElectionServerEnabled(instance) {
+		return i.cleanup(ctx, instance)
+	}
+
+	name := constants.InstanceName(actions.ElectionDeploymentName, instance.Name)
+	labels := constants.LabelsFor(actions.ElectionComponentName, actions.ElectionDeploymentName, instance.Name)
+	if err := i.ensureCertificates(ctx, instance, name); err != nil {
+		return i.fail(ctx, instance, err)
+	}
+
+	// the single replica Deployment of previous versions is replaced by the StatefulSet
+	if err := i.deleteOwned(ctx, instance, &appsv1.Deployment{}, name); err != nil {
+		return i.Failed(fmt.Errorf("could not delete election Deployment: %w", err))
+	}
+
+	sts := trillianUtils.CreateElectionStatefulSet(instance, name, constants.InstanceName(actions.RBACName, instance.Name), labels)
+	svc := k8sutils.CreateService(instance.Namespace, name, trillianUtils.ElectionPort, labels)
+	svc.Spec.Ports = append(svc.Spec.Ports, corev1.ServicePort{
+		Name:       "metrics",
+		Protocol:   corev1.ProtocolTCP,
+		Port:       trillianUtils.ElectionMetricsPort,
+		TargetPort: intstr.FromInt(trillianUtils.ElectionMetricsPort),
+	})
+	peers := trillianUtils.CreateElectionPeersService(instance.Namespace, name, labels)
+
+	updated := false
+	for _, obj := range []client.Object{peers, svc, sts} {
+		if err := controllerutil.SetControllerReference(instance, obj, i.Client.Scheme()); err != nil {
+			return i.Failed(fmt.Errorf("could not set controller reference for election server: %w", err))
+		}
+		ensured, err := i.Ensure(ctx, obj)
+		if err != nil {
+			return i.fail(ctx, instance, fmt.Errorf("could not create election server: %w", err))
+		}
+		updated = updated || ensured
+	}
+
+	if updated {
+		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+			Type:    actions.SignerCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  constants.Creating,
+			Message: "Election server created",
+		})
+		return i.StatusUpdate(ctx, instance)
+	}
+	return i.Continue()
+}
+
+// ensureCertificates issues the certificate of the etcd members by the CA of the election server.
+// The members authenticate each other with the certificate, the Logserver and Logsigner verify it with the CA certificate.
+func (i electionAction) ensureCertificates(ctx context.Context, instance *rhtasv1alpha1.Trillian, name string) error {
+	ca := &corev1.Secret{}
+	err := i.Client.Get(ctx, types.NamespacedName{Name: trillianUtils.ElectionCASecret(name), Namespace: instance.Namespace}, ca)
+	switch {
+	case apierrors.IsNotFound(err):
+		cert, key, err := trillianUtils.CreateCA(name)
+		if err != nil {
+			return fmt.Errorf("could not create election CA: %w", err)
+		}
+		ca = k8sutils.CreateSecret(trillianUtils.ElectionCASecret(name), instance.Namespace, map[string][]byte{
+			corev1.TLSCertKey:       cert,
+			corev1.TLSPrivateKeyKey: key,
+		}, constants.LabelsFor(actions.ElectionComponentName, trillianUtils.ElectionCASecret(name), instance.Name))
+		if err = controllerutil.SetControllerReference(instance, ca, i.Client.Scheme()); err != nil {
+			return fmt.Errorf("could not set controller reference for Secret: %w", err)
+		}
+		if err = i.Client.Create(ctx, ca); err != nil {
+			return fmt.Errorf("could not create election CA: %w", err)
+		}
+	case err != nil:
+		return err
+	}
+	caCert, caKey := ca.Data[corev1.TLSCertKey], ca.Data[corev1.TLSPrivateKeyKey]
+
+	dnsNames := trillianUtils.ElectionDNSNames(name, instance.Namespace)
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: trillianUtils.ElectionTLSSecret(name), Namespace: instance.Namespace}}
+	if _, err = controllerutil.CreateOrUpdate(ctx, i.Client, secret, func() error {
+		secret.Labels = constants.LabelsFor(actions.ElectionComponentName, secret.Name, instance.Name)
+		secret.Type = corev1.SecretTypeTLS
+		if trillianUtils.CertificateIsValid(secret.Data[corev1.TLSCertKey], caCert, dnsNames) {
+			return controllerutil.SetControllerReference(instance, secret, i.Client.Scheme())
+		}
+		cert, key, err := trillianUtils.IssuePeerCertificate(caCert, caKey, dnsNames)
+		if err != nil {
+			return err
+		}
+		secret.Data = map[string][]byte{
+			corev1.TLSCertKey:       cert,
+			corev1.TLSPrivateKeyKey: key,
+			"ca.crt":                caCert,
+		}
+		return controllerutil.SetControllerReference(instance, secret, i.Client.Scheme())
+	}); err != nil {
+		return fmt.Errorf("could not issue certificate for election server: %w", err)
+	}
+	return nil
+}
+
+// cleanup deletes the election server which is no longer used, including the volumes of the etcd members.
+func (i electionAction) cleanup(ctx context.Context, instance *rhtasv1alpha1.Trillian) *action.Result {
+	name := constants.InstanceName(actions.ElectionDeploymentName, instance.Name)
+	for _, o := range []struct {
+		obj  client.Object
+		name string
+	}{
+		{&appsv1.StatefulSet{}, name},
+		{&appsv1.Deployment{}, name},
+		{&corev1.Service{}, name},
+		{&corev1.Service{}, trillianUtils.ElectionPeersService(name)},
+		{&corev1.Secret{}, trillianUtils.ElectionTLSSecret(name)},
+		{&corev1.Secret{}, trillianUtils.ElectionCASecret(name)},
+	} {
+		if err := i.deleteOwned(ctx, instance, o.obj, o.name); err != nil {
+			return i.Failed(fmt.Errorf("could not delete election server: %w", err))
+		}
+	}
+
+	pvcs := &corev1.PersistentVolumeClaimList{}
+	if err := i.Client.List(ctx, pvcs, client.InNamespace(instance.Namespace),
+		client.MatchingLabels(constants.LabelsFor(actions.ElectionComponentName, actions.ElectionDeploymentName, instance.Name))); err != nil {
+		return i.Failed(err)
+	}
+	for idx := range pvcs.Items {
+		if err := i.Client.Delete(ctx, &pvcs.Items[idx]); client.IgnoreNotFound(err) != nil {
+			return i.Failed(fmt.Errorf("could not delete election server volume: %w", err))
+		}
+	}
+	return i.Continue()
+}
+
+// deleteOwned deletes the object controlled by the instance, other objects of the same name are kept.
+func (i electionAction) deleteOwned(ctx context.Context, instance *rhtasv1alpha1.Trillian, obj client.Object, name string) error {
+	if err := i.Client.Get(ctx, types.NamespacedName{Name: name, Namespace: instance.Namespace}, obj); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if !metav1.IsControlledBy(obj, instance) {
+		return nil
+	}
+	return client.IgnoreNotFound(i.Client.Delete(ctx, obj))
+}
+
+func (i electionAction) fail(ctx context.Context, instance *rhtasv1alpha1.Trillian, err error) *action.Result {
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:    actions.SignerCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  constants.Failure,
+		Message: err.Error(),
+	})
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:    constants.Ready,
+		Status:  metav1.ConditionFalse,
+		Reason:  constants.Failure,
+		Message: err.Error(),
+	})
+	return i.FailedWithStatusUpdate(ctx, err, instance)
+}
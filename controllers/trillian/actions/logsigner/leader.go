@@ -0,0 +1,157 @@
+package logsigner
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common"
+	"github.com/securesign/operator/controllers/common/action"
+	"github.com/securesign/operator/controllers/constants"
+	"github.com/securesign/operator/controllers/trillian/actions"
+	trillianUtils "github.com/securesign/operator/controllers/trillian/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const metricsTimeout = 5 * time.Second
+
+func NewLeaderAction() action.Action[rhtasv1alpha1.Trillian] {
+	return &leaderAction{}
+}
+
+type leaderAction struct {
+	action.BaseAction
+}
+
+func (i leaderAction) Name() string {
+	return "signer leaders"
+}
+
+func (i leaderAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.Trillian) bool {
+	return meta.IsStatusConditionTrue(instance.Status.Conditions, constants.Ready) && trillianUtils.ElectionEnabled(instance)
+}
+
+// Handle reports the Logsigner replicas elected to sign the trees, the leaders are read from the metrics of the replicas.
+// The status is refreshed whenever the Trillian is reconciled, at least with the periodic sync of the trees.
+// Replicas which metrics can't be read are reported by the SignerLeadersResolved condition.
+func (i leaderAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Trillian) *action.Result {
+	httpClient, scheme, err := i.metricsClient(ctx, instance)
+	if err != nil {
+		return i.fail(ctx, instance, err)
+	}
+
+	pods := &corev1.PodList{}
+	if err = i.Client.List(ctx, pods, client.InNamespace(instance.Namespace),
+		client.MatchingLabels(constants.LabelsForComponent(actions.LogSignerComponentName, instance.Name))); err != nil {
+		return i.fail(ctx, instance, fmt.Errorf("could not list logsigner pods: %w", err))
+	}
+
+	trees := make(map[string][]int64, len(pods.Items))
+	var unreachable []string
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodRunning || pod.Status.PodIP == "" || pod.DeletionTimestamp != nil {
+			continue
+		}
+		url := fmt.Sprintf("%s://%s/metrics", scheme, net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(monitoringPort)))
+		ids, err := leadingTrees(ctx, httpClient, url)
+		if err != nil {
+			// the replica is not considered as a leader
+			i.Logger.Info("Could not read logsigner metrics", "pod", pod.Name, "error", err.Error())
+			unreachable = append(unreachable, pod.Name)
+			continue
+		}
+		trees[pod.Name] = ids
+	}
+
+	leaders := trillianUtils.SignerLeaders(trees)
+	condition := metav1.Condition{
+		Type:    actions.LeaderCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  constants.Ready,
+		Message: fmt.Sprintf("Leaders of %d trees resolved", len(leaders)),
+	}
+	if len(unreachable) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = constants.Failure
+		condition.Message = "Could not read the metrics of pods " + strings.Join(unreachable, ", ")
+	}
+
+	if equality.Semantic.DeepEqual(instance.Status.SignerLeaders, leaders) && !conditionChanged(instance, condition) {
+		return i.Continue()
+	}
+	instance.Status.SignerLeaders = leaders
+	meta.SetStatusCondition(&instance.Status.Conditions, condition)
+	return i.StatusUpdate(ctx, instance)
+}
+
+// fail reports the failed lookup of the leaders, the Trillian stays ready as the signing is not affected.
+func (i leaderAction) fail(ctx context.Context, instance *rhtasv1alpha1.Trillian, err error) *action.Result {
+	condition := metav1.Condition{
+		Type:    actions.LeaderCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  constants.Failure,
+		Message: err.Error(),
+	}
+	if !conditionChanged(instance, condition) {
+		return i.Failed(err)
+	}
+	meta.SetStatusCondition(&instance.Status.Conditions, condition)
+	return i.FailedWithStatusUpdate(ctx, err, instance)
+}
+
+func conditionChanged(instance *rhtasv1alpha1.Trillian, condition metav1.Condition) bool {
+	c := meta.FindStatusCondition(instance.Status.Conditions, condition.Type)
+	return c == nil || c.Status != condition.Status || c.Reason != condition.Reason || c.Message != condition.Message
+}
+
+// metricsClient returns the client of the Logsigner metrics endpoint, the endpoint is served with the gRPC certificate.
+func (i leaderAction) metricsClient(ctx context.Context, instance *rhtasv1alpha1.Trillian) (*http.Client, string, error) {
+	httpClient := &http.Client{Timeout: metricsTimeout}
+	if instance.Status.TLS == nil {
+		return httpClient, "http", nil
+	}
+	caCert, err := common.FindTrillianCACert(ctx, i.Client, instance.Namespace, instance.Name)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not find trillian CA certificate: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if caCert == nil || !pool.AppendCertsFromPEM(caCert) {
+		return nil, "", errors.New("could not parse trillian CA certificate")
+	}
+	httpClient.Transport = &http.Transport{
+		TLSClientConfig: &tls.Config{
+			RootCAs:    pool,
+			ServerName: fmt.Sprintf("%s.%s.svc", constants.InstanceName(actions.LogsignerDeploymentName, instance.Name), instance.Namespace),
+			MinVersion: tls.VersionTLS12,
+		},
+	}
+	return httpClient, "https", nil
+}
+
+func leadingTrees(ctx context.Context, httpClient *http.Client, url string) ([]int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return trillianUtils.LeadingTrees(resp.Body)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/securesign/operator/controllers/common/utils/kubernetes"
 	"github.com/securesign/operator/controllers/constants"
 	"github.com/securesign/operator/controllers/trillian/actions"
+	trillianUtils "github.com/securesign/operator/controllers/trillian/utils"
 	v1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -41,7 +42,7 @@ func (i monitoringAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Tr
 	monitoringLabels := constants.LabelsFor(actions.LogSignerComponentName, actions.LogSignerMonitoringName, instance.Name)
 	role := kubernetes.CreateRole(
 		instance.Namespace,
-		actions.LogSignerMonitoringName,
+		constants.InstanceName(actions.LogSignerMonitoringName, instance.Name),
 		monitoringLabels,
 		[]v1.PolicyRule{
 			{
@@ -74,12 +75,12 @@ func (i monitoringAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Tr
 
 	roleBinding := kubernetes.CreateRoleBinding(
 		instance.Namespace,
-		actions.LogSignerMonitoringName,
+		constants.InstanceName(actions.LogSignerMonitoringName, instance.Name),
 		monitoringLabels,
 		v1.RoleRef{
 			APIGroup: v1.SchemeGroupVersion.Group,
 			Kind:     "Role",
-			Name:     actions.LogSignerMonitoringName,
+			Name:     constants.InstanceName(actions.LogSignerMonitoringName, instance.Name),
 		},
 		[]v1.Subject{
 			{Kind: "ServiceAccount", Name: "prometheus-k8s", Namespace: "openshift-monitoring"},
@@ -105,17 +106,20 @@ func (i monitoringAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Tr
 		return i.FailedWithStatusUpdate(ctx, fmt.Errorf("could not create monitoring RoleBinding: %w", err), instance)
 	}
 
+	endpoint := monitoringv1.Endpoint{
+		Interval: monitoringv1.Duration("30s"),
+		Port:     actions.LogSignerComponentName,
+		Scheme:   "http",
+	}
+	if tlsConfig := actions.MonitoringTLSConfig(instance, constants.InstanceName(actions.LogsignerDeploymentName, instance.Name)); tlsConfig != nil {
+		endpoint.Scheme = "https"
+		endpoint.TLSConfig = tlsConfig
+	}
 	serviceMonitor := kubernetes.CreateServiceMonitor(
 		instance.Namespace,
-		actions.LogSignerComponentName,
+		constants.InstanceName(actions.LogSignerComponentName, instance.Name),
 		monitoringLabels,
-		[]monitoringv1.Endpoint{
-			{
-				Interval: monitoringv1.Duration("30s"),
-				Port:     actions.LogSignerComponentName,
-				Scheme:   "http",
-			},
-		},
+		[]monitoringv1.Endpoint{endpoint},
 		constants.LabelsForComponent(actions.LogSignerComponentName, instance.Name),
 	)
 
@@ -139,6 +143,34 @@ func (i monitoringAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Tr
 		return i.FailedWithStatusUpdate(ctx, fmt.Errorf("could not create serviceMonitor: %w", err), instance)
 	}
 
+	if trillianUtils.ElectionServerEnabled(instance) {
+		electionMonitor := kubernetes.CreateServiceMonitor(
+			instance.Namespace,
+			constants.InstanceName(actions.ElectionComponentName, instance.Name),
+			monitoringLabels,
+			[]monitoringv1.Endpoint{
+				{
+					Interval: monitoringv1.Duration("30s"),
+					Port:     "metrics",
+					Scheme:   "http",
+				},
+			},
+			constants.LabelsForComponent(actions.ElectionComponentName, instance.Name),
+		)
+		if err = controllerutil.SetControllerReference(instance, electionMonitor, i.Client.Scheme()); err != nil {
+			return i.Failed(fmt.Errorf("could not set controller reference for election serviceMonitor: %w", err))
+		}
+		if _, err = i.Ensure(ctx, electionMonitor); err != nil {
+			meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+				Type:    actions.SignerCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  constants.Failure,
+				Message: err.Error(),
+			})
+			return i.FailedWithStatusUpdate(ctx, fmt.Errorf("could not create election serviceMonitor: %w", err), instance)
+		}
+	}
+
 	// monitors & RBAC are not watched - do not need to re-enqueue
 	return i.Continue()
 }
@@ -44,7 +44,7 @@ func (i createServiceAction) Handle(ctx context.Context, instance *rhtasv1alpha1
 	)
 
 	labels := constants.LabelsFor(actions.LogSignerComponentName, actions.LogsignerDeploymentName, instance.Name)
-	logsignerService := k8sutils.CreateService(instance.Namespace, actions.LogsignerDeploymentName, monitoringPort, labels)
+	logsignerService := k8sutils.CreateService(instance.Namespace, constants.InstanceName(actions.LogsignerDeploymentName, instance.Name), monitoringPort, labels)
 
 	if err = controllerutil.SetControllerReference(instance, logsignerService, i.Client.Scheme()); err != nil {
 		return i.Failed(fmt.Errorf("could not set controller reference for logsigner Service: %w", err))
@@ -66,6 +66,16 @@ func (i createServiceAction) Handle(ctx context.Context, instance *rhtasv1alpha1
 		return i.FailedWithStatusUpdate(ctx, fmt.Errorf("could not create logsigner Service: %w", err), instance)
 	}
 
+	if instance.Status.TLS != nil {
+		if annotations := actions.ServingCertAnnotations(i.Client, instance, instance.Status.TLS.LogSignerCertRef); annotations != nil {
+			annotated, err := k8sutils.EnsureAnnotations(ctx, i.Client, logsignerService, annotations)
+			if err != nil {
+				return i.Failed(fmt.Errorf("could not annotate logsigner Service: %w", err))
+			}
+			updated = updated || annotated
+		}
+	}
+
 	if updated {
 		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
 			Type:    actions.ServerCondition,
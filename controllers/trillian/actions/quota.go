@@ -0,0 +1,91 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common"
+	"github.com/securesign/operator/controllers/common/action"
+	"github.com/securesign/operator/controllers/common/utils/kubernetes"
+	"github.com/securesign/operator/controllers/constants"
+	trillianUtils "github.com/securesign/operator/controllers/trillian/utils"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func NewQuotaAction() action.Action[rhtasv1alpha1.Trillian] {
+	return &quotaAction{}
+}
+
+type quotaAction struct {
+	action.BaseAction
+}
+
+func (i quotaAction) Name() string {
+	return "manage quotas"
+}
+
+func (i quotaAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.Trillian) bool {
+	if !meta.IsStatusConditionTrue(instance.Status.Conditions, constants.Ready) {
+		return false
+	}
+	return trillianUtils.QuotaBucketsEnabled(instance) || meta.FindStatusCondition(instance.Status.Conditions, QuotaCondition) != nil
+}
+
+// Handle configures the token buckets through the quota API of the Logserver.
+// The buckets are stored on the etcd cluster, missing buckets are configured again with the periodic sync of the trees.
+func (i quotaAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Trillian) *action.Result {
+	if !trillianUtils.QuotaBucketsEnabled(instance) {
+		meta.RemoveStatusCondition(&instance.Status.Conditions, QuotaCondition)
+		return i.StatusUpdate(ctx, instance)
+	}
+
+	trillUrl, err := kubernetes.GetInternalUrl(ctx, i.Client, instance.Namespace, constants.InstanceName(LogserverDeploymentName, instance.Name))
+	if err != nil {
+		return i.Failed(err)
+	}
+	trillUrl += ":8091"
+	caCert, err := common.FindTrillianCACert(ctx, i.Client, instance.Namespace, instance.Name)
+	if err != nil {
+		return i.Failed(fmt.Errorf("could not find trillian CA certificate: %w", err))
+	}
+
+	current, err := common.ListTrillianQuotas(ctx, trillUrl, caCert)
+	if err != nil {
+		return i.syncFailed(ctx, instance, err)
+	}
+	desired := trillianUtils.QuotaConfigs(instance.Spec.Quota)
+	create, update, remove := trillianUtils.QuotaChanges(current, desired)
+	if len(create) > 0 || len(update) > 0 || len(remove) > 0 {
+		if err = common.ApplyTrillianQuotas(ctx, trillUrl, caCert, create, update, remove); err != nil {
+			return i.syncFailed(ctx, instance, err)
+		}
+		i.Recorder.Eventf(instance, v1.EventTypeNormal, "QuotasConfigured", "Trillian quotas configured: %d created, %d updated, %d deleted",
+			len(create), len(update), len(remove))
+	}
+
+	condition := metav1.Condition{
+		Type:    QuotaCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  constants.Ready,
+		Message: fmt.Sprintf("%d token buckets configured", len(desired)),
+	}
+	if c := meta.FindStatusCondition(instance.Status.Conditions, QuotaCondition); c != nil &&
+		c.Status == condition.Status && c.Message == condition.Message {
+		return i.Continue()
+	}
+	meta.SetStatusCondition(&instance.Status.Conditions, condition)
+	return i.StatusUpdate(ctx, instance)
+}
+
+func (i quotaAction) syncFailed(ctx context.Context, instance *rhtasv1alpha1.Trillian, err error) *action.Result {
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:    QuotaCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  constants.Failure,
+		Message: err.Error(),
+	})
+	return i.FailedWithStatusUpdate(ctx, fmt.Errorf("could not configure trillian quotas: %w", err), instance)
+}
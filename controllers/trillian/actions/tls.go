@@ -0,0 +1,203 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common"
+	"github.com/securesign/operator/controllers/common/action"
+	"github.com/securesign/operator/controllers/common/utils/kubernetes"
+	"github.com/securesign/operator/controllers/constants"
+	trillianUtils "github.com/securesign/operator/controllers/trillian/utils"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func NewTLSAction() action.Action[rhtasv1alpha1.Trillian] {
+	return &tlsAction{}
+}
+
+type tlsAction struct {
+	action.BaseAction
+}
+
+func (i tlsAction) Name() string {
+	return "tls"
+}
+
+func (i tlsAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.Trillian) bool {
+	c := meta.FindStatusCondition(instance.Status.Conditions, constants.Ready)
+	return (c.Reason == constants.Creating || c.Reason == constants.Ready) &&
+		(instance.Spec.TLS.Enabled || instance.Status.TLS != nil)
+}
+
+func (i tlsAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Trillian) *action.Result {
+	if !instance.Spec.TLS.Enabled {
+		// clients stop using TLS once the CA is gone
+		if err := i.Client.Delete(ctx, &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: constants.InstanceName(CAConfigMapName, instance.Name), Namespace: instance.Namespace},
+		}); err != nil && !apierrors.IsNotFound(err) {
+			return i.Failed(err)
+		}
+		instance.Status.TLS = nil
+		return i.StatusUpdate(ctx, instance)
+	}
+
+	var (
+		err         error
+		caCert      []byte
+		annotations map[string]string
+	)
+	status := &rhtasv1alpha1.TrillianTLSStatus{
+		LogServerCertRef: &rhtasv1alpha1.LocalObjectReference{Name: constants.InstanceName(LogserverTLSSecretName, instance.Name)},
+		LogSignerCertRef: &rhtasv1alpha1.LocalObjectReference{Name: constants.InstanceName(LogsignerTLSSecretName, instance.Name)},
+		CACertRef:        &rhtasv1alpha1.LocalObjectReference{Name: constants.InstanceName(CAConfigMapName, instance.Name)},
+	}
+
+	switch {
+	case instance.Spec.TLS.CertificateRef != nil:
+		status.LogServerCertRef = instance.Spec.TLS.CertificateRef
+		status.LogSignerCertRef = instance.Spec.TLS.CertificateRef
+		caCert, err = kubernetes.GetSecretData(i.Client, instance.Namespace, &rhtasv1alpha1.SecretKeySelector{
+			LocalObjectReference: *instance.Spec.TLS.CertificateRef,
+			Key:                  "ca.crt",
+		})
+		if err == nil && len(caCert) == 0 {
+			err = errors.New("certificate secret does not contain ca.crt")
+		}
+	case kubernetes.IsOpenShift(i.Client):
+		// serving certificates are issued by service CA for annotated services
+		annotations = map[string]string{"service.beta.openshift.io/inject-cabundle": "true"}
+	default:
+		caCert, err = i.ensureInternalCertificates(ctx, instance)
+	}
+	if err != nil {
+		return i.fail(ctx, instance, err)
+	}
+
+	labels := constants.LabelsFor(LogServerComponentName, CAConfigMapName, instance.Name)
+	labels[common.TrillianCALabel] = CAConfigMapKey
+	cm := &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: constants.InstanceName(CAConfigMapName, instance.Name), Namespace: instance.Namespace}}
+	if _, err = controllerutil.CreateOrUpdate(ctx, i.Client, cm, func() error {
+		cm.Labels = labels
+		for k, v := range annotations {
+			metav1.SetMetaDataAnnotation(&cm.ObjectMeta, k, v)
+		}
+		// the bundle is injected by service CA on OpenShift
+		if caCert != nil {
+			cm.Data = map[string]string{CAConfigMapKey: string(caCert)}
+		}
+		return controllerutil.SetControllerReference(instance, cm, i.Client.Scheme())
+	}); err != nil {
+		return i.fail(ctx, instance, fmt.Errorf("could not create Trillian CA ConfigMap: %w", err))
+	}
+
+	if equality.Semantic.DeepEqual(instance.Status.TLS, status) {
+		return i.Continue()
+	}
+	instance.Status.TLS = status
+	i.Recorder.Event(instance, v1.EventTypeNormal, "TLSConfigured", "Trillian gRPC endpoints secured by TLS")
+	return i.StatusUpdate(ctx, instance)
+}
+
+// ensureInternalCertificates issues serving certificates by the internal CA managed by the Operator and returns the CA certificate.
+func (i tlsAction) ensureInternalCertificates(ctx context.Context, instance *rhtasv1alpha1.Trillian) ([]byte, error) {
+	labels := constants.LabelsFor(LogServerComponentName, InternalCASecretName, instance.Name)
+	ca := &v1.Secret{}
+	err := i.Client.Get(ctx, types.NamespacedName{Name: constants.InstanceName(InternalCASecretName, instance.Name), Namespace: instance.Namespace}, ca)
+	switch {
+	case apierrors.IsNotFound(err):
+		cert, key, err := trillianUtils.CreateCA("trillian-internal-ca")
+		if err != nil {
+			return nil, fmt.Errorf("could not create internal CA: %w", err)
+		}
+		ca = kubernetes.CreateSecret(constants.InstanceName(InternalCASecretName, instance.Name), instance.Namespace, map[string][]byte{
+			v1.TLSCertKey:       cert,
+			v1.TLSPrivateKeyKey: key,
+		}, labels)
+		if err = controllerutil.SetControllerReference(instance, ca, i.Client.Scheme()); err != nil {
+			return nil, fmt.Errorf("could not set controller reference for Secret: %w", err)
+		}
+		if err = i.Client.Create(ctx, ca); err != nil {
+			return nil, fmt.Errorf("could not create internal CA: %w", err)
+		}
+		i.Recorder.Event(instance, v1.EventTypeNormal, "InternalCACreated", "Internal CA for Trillian TLS created")
+	case err != nil:
+		return nil, err
+	}
+	caCert, caKey := ca.Data[v1.TLSCertKey], ca.Data[v1.TLSPrivateKeyKey]
+
+	for service, secretName := range map[string]string{
+		constants.InstanceName(LogserverDeploymentName, instance.Name): constants.InstanceName(LogserverTLSSecretName, instance.Name),
+		constants.InstanceName(LogsignerDeploymentName, instance.Name): constants.InstanceName(LogsignerTLSSecretName, instance.Name),
+	} {
+		dnsNames := trillianUtils.ServiceDNSNames(service, instance.Namespace)
+		secret := &v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: instance.Namespace}}
+		if _, err = controllerutil.CreateOrUpdate(ctx, i.Client, secret, func() error {
+			secret.Labels = constants.LabelsFor(LogServerComponentName, secretName, instance.Name)
+			secret.Type = v1.SecretTypeTLS
+			if trillianUtils.CertificateIsValid(secret.Data[v1.TLSCertKey], caCert, dnsNames) {
+				return controllerutil.SetControllerReference(instance, secret, i.Client.Scheme())
+			}
+			cert, key, err := trillianUtils.IssueServerCertificate(caCert, caKey, dnsNames)
+			if err != nil {
+				return err
+			}
+			secret.Data = map[string][]byte{
+				v1.TLSCertKey:       cert,
+				v1.TLSPrivateKeyKey: key,
+				"ca.crt":            caCert,
+			}
+			return controllerutil.SetControllerReference(instance, secret, i.Client.Scheme())
+		}); err != nil {
+			return nil, fmt.Errorf("could not issue certificate for %s: %w", service, err)
+		}
+	}
+	return caCert, nil
+}
+
+func (i tlsAction) fail(ctx context.Context, instance *rhtasv1alpha1.Trillian, err error) *action.Result {
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:    constants.Ready,
+		Status:  metav1.ConditionFalse,
+		Reason:  constants.Failure,
+		Message: err.Error(),
+	})
+	return i.FailedWithStatusUpdate(ctx, fmt.Errorf("could not configure TLS: %w", err), instance)
+}
+
+// MonitoringTLSConfig returns the configuration for scraping metrics of the service secured by TLS.
+func MonitoringTLSConfig(instance *rhtasv1alpha1.Trillian, service string) *monitoringv1.TLSConfig {
+	if instance.Status.TLS == nil || instance.Status.TLS.CACertRef == nil {
+		return nil
+	}
+	return &monitoringv1.TLSConfig{
+		SafeTLSConfig: monitoringv1.SafeTLSConfig{
+			CA: monitoringv1.SecretOrConfigMap{
+				ConfigMap: &v1.ConfigMapKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: instance.Status.TLS.CACertRef.Name},
+					Key:                  CAConfigMapKey,
+				},
+			},
+			ServerName: fmt.Sprintf("%s.%s.svc", service, instance.Namespace),
+		},
+	}
+}
+
+// ServingCertAnnotations returns annotations requesting the serving certificate from OpenShift service CA.
+// It returns nil when the certificate is not issued by service CA.
+func ServingCertAnnotations(c client.Client, instance *rhtasv1alpha1.Trillian, secret *rhtasv1alpha1.LocalObjectReference) map[string]string {
+	if instance.Status.TLS == nil || secret == nil || instance.Spec.TLS.CertificateRef != nil || !kubernetes.IsOpenShift(c) {
+		return nil
+	}
+	return map[string]string{"service.beta.openshift.io/serving-cert-secret-name": secret.Name}
+}
@@ -0,0 +1,149 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common"
+	"github.com/securesign/operator/controllers/common/action"
+	"github.com/securesign/operator/controllers/common/utils/kubernetes"
+	"github.com/securesign/operator/controllers/constants"
+	trillianUtils "github.com/securesign/operator/controllers/trillian/utils"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// trees are created by Rekor and CTlog controllers, the list is refreshed periodically
+const treesSyncInterval = time.Minute
+
+func NewTreesAction() action.Action[rhtasv1alpha1.Trillian] {
+	return &treesAction{}
+}
+
+type treesAction struct {
+	action.BaseAction
+}
+
+func (i treesAction) Name() string {
+	return "manage trees"
+}
+
+func (i treesAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.Trillian) bool {
+	return meta.IsStatusConditionTrue(instance.Status.Conditions, constants.Ready)
+}
+
+func (i treesAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Trillian) *action.Result {
+	trillUrl, err := kubernetes.GetInternalUrl(ctx, i.Client, instance.Namespace, constants.InstanceName(LogserverDeploymentName, instance.Name))
+	if err != nil {
+		return i.Failed(err)
+	}
+	trillUrl += ":8091"
+	caCert, err := common.FindTrillianCACert(ctx, i.Client, instance.Namespace, instance.Name)
+	if err != nil {
+		return i.Failed(fmt.Errorf("could not find trillian CA certificate: %w", err))
+	}
+
+	trees, err := common.ListTrillianTrees(ctx, trillUrl, caCert)
+	if err != nil {
+		return i.syncFailed(ctx, instance, err)
+	}
+
+	var errs []error
+	changes, missing := trillianUtils.TreeStateChanges(trees, instance.Spec.Trees)
+	for _, id := range missing {
+		errs = append(errs, fmt.Errorf("trillian tree %d not found", id))
+	}
+	for id, state := range changes {
+		if _, err := common.UpdateTrillianTreeState(ctx, trillUrl, caCert, id, state); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		i.Recorder.Eventf(instance, v1.EventTypeNormal, "TreeStateChanged", "Trillian tree %d is %s", id, state)
+	}
+	if len(changes) > 0 {
+		if trees, err = common.ListTrillianTrees(ctx, trillUrl, caCert); err != nil {
+			return i.syncFailed(ctx, instance, err)
+		}
+	}
+
+	owners, err := i.treeOwners(ctx, instance.Namespace)
+	if err != nil {
+		return i.Failed(err)
+	}
+	status := trillianUtils.TreeStatus(trees, owners)
+
+	condition := metav1.Condition{
+		Type:    TreesCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  constants.Ready,
+		Message: fmt.Sprintf("%d trees found", len(status)),
+	}
+	if len(errs) > 0 {
+		err = errors.Join(errs...)
+		i.Logger.Error(err, "could not change state of trees")
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = constants.Failure
+		condition.Message = err.Error()
+	}
+
+	if equality.Semantic.DeepEqual(instance.Status.Trees, status) &&
+		meta.IsStatusConditionPresentAndEqual(instance.Status.Conditions, TreesCondition, condition.Status) &&
+		meta.FindStatusCondition(instance.Status.Conditions, TreesCondition).Message == condition.Message {
+		return &action.Result{Result: reconcile.Result{RequeueAfter: treesSyncInterval}}
+	}
+	instance.Status.Trees = status
+	meta.SetStatusCondition(&instance.Status.Conditions, condition)
+	return i.StatusUpdate(ctx, instance)
+}
+
+// treeOwners maps tree IDs to Rekor and CTlog resources in the namespace using them.
+func (i treesAction) treeOwners(ctx context.Context, namespace string) (map[int64]string, error) {
+	names := make(map[int64][]string)
+	add := func(id *int64, owner string) {
+		if id != nil {
+			names[*id] = append(names[*id], owner)
+		}
+	}
+
+	rekors := &rhtasv1alpha1.RekorList{}
+	if err := i.Client.List(ctx, rekors, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("could not list Rekor resources: %w", err)
+	}
+	for _, r := range rekors.Items {
+		add(r.Status.TreeID, "Rekor/"+r.Name)
+	}
+	ctlogs := &rhtasv1alpha1.CTlogList{}
+	if err := i.Client.List(ctx, ctlogs, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("could not list CTlog resources: %w", err)
+	}
+	for _, c := range ctlogs.Items {
+		add(c.Status.TreeID, "CTlog/"+c.Name)
+	}
+
+	// a tree shared by several logs lists all of them
+	owners := make(map[int64]string, len(names))
+	for id, n := range names {
+		slices.Sort(n)
+		owners[id] = strings.Join(n, ",")
+	}
+	return owners, nil
+}
+
+func (i treesAction) syncFailed(ctx context.Context, instance *rhtasv1alpha1.Trillian, err error) *action.Result {
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:    TreesCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  constants.Failure,
+		Message: err.Error(),
+	})
+	return i.FailedWithStatusUpdate(ctx, fmt.Errorf("could not list trillian trees: %w", err), instance)
+}
@@ -19,11 +19,13 @@ package trillian
 import (
 	"context"
 
+	"github.com/securesign/operator/controllers/common"
 	"github.com/securesign/operator/controllers/common/action"
 	actions2 "github.com/securesign/operator/controllers/trillian/actions"
 	"github.com/securesign/operator/controllers/trillian/actions/db"
 	"github.com/securesign/operator/controllers/trillian/actions/logserver"
 	"github.com/securesign/operator/controllers/trillian/actions/logsigner"
+	batchv1 "k8s.io/api/batch/v1"
 	v12 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/record"
 
@@ -76,19 +78,27 @@ func (r *TrillianReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	}
 	target := instance.DeepCopy()
 	actions := []action.Action[rhtasv1alpha1.Trillian]{
+		common.NewMigrateNamesAction[rhtasv1alpha1.Trillian](actions2.LegacyNames),
+		db.NewMigrateHostAction(),
+
 		actions2.NewToPendingPhaseAction(),
 		actions2.NewToCreatePhaseAction(),
 		actions2.NewRBACAction(),
+		actions2.NewTLSAction(),
 
 		db.NewHandleSecretAction(),
 		db.NewCreatePvcAction(),
 		db.NewDeployAction(),
 		db.NewCreateServiceAction(),
+		db.NewCheckAction(),
+		db.NewSchemaAction(),
+		db.NewRotateCredentialsAction(),
 
 		logserver.NewDeployAction(),
 		logserver.NewCreateServiceAction(),
 		logserver.NewCreateMonitorAction(),
 
+		logsigner.NewElectionAction(),
 		logsigner.NewDeployAction(),
 		logsigner.NewCreateServiceAction(),
 		logsigner.NewCreateMonitorAction(),
@@ -99,6 +109,10 @@ func (r *TrillianReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		logserver.NewInitializeAction(),
 		logsigner.NewInitializeAction(),
 		actions2.NewInitializeAction(),
+
+		logsigner.NewLeaderAction(),
+		actions2.NewQuotaAction(),
+		actions2.NewTreesAction(),
 	}
 
 	for _, a := range actions {
@@ -122,6 +136,8 @@ func (r *TrillianReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&rhtasv1alpha1.Trillian{}).
 		Owns(&v1.Deployment{}).
+		Owns(&v1.StatefulSet{}).
 		Owns(&v12.Service{}).
+		Owns(&batchv1.Job{}).
 		Complete(r)
 }
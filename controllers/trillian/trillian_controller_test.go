@@ -25,12 +25,14 @@ import (
 	"github.com/securesign/operator/api/v1alpha1"
 	"github.com/securesign/operator/controllers/constants"
 	actions "github.com/securesign/operator/controllers/trillian/actions"
+	trillianUtils "github.com/securesign/operator/controllers/trillian/utils"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -132,27 +134,52 @@ var _ = Describe("Trillian controller", func() {
 
 			By("Database SVC created")
 			Eventually(func() error {
-				return k8sClient.Get(ctx, types.NamespacedName{Name: "trillian-mysql", Namespace: Namespace}, &corev1.Service{})
+				return k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName("trillian-mysql", Name), Namespace: Namespace}, &corev1.Service{})
 			}, time.Minute, time.Second).Should(Succeed())
 
 			By("Database Deployment created")
 			Eventually(func() error {
-				return k8sClient.Get(ctx, types.NamespacedName{Name: actions.DbDeploymentName, Namespace: Namespace}, &appsv1.Deployment{})
+				return k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.DbDeploymentName, Name), Namespace: Namespace}, &appsv1.Deployment{})
 			}, time.Minute, time.Second).Should(Succeed())
 
+			By("Database is ready")
+			db := &appsv1.Deployment{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.DbDeploymentName, Name), Namespace: Namespace}, db)).To(Succeed())
+			db.Status.Conditions = []appsv1.DeploymentCondition{
+				{Status: corev1.ConditionTrue, Type: appsv1.DeploymentAvailable, Reason: constants.Ready}}
+			Expect(k8sClient.Status().Update(ctx, db)).Should(Succeed())
+			Eventually(func() bool {
+				Expect(k8sClient.Get(ctx, typeNamespaceName, found)).Should(Succeed())
+				return meta.IsStatusConditionTrue(found.Status.Conditions, actions.DbCondition)
+			}, time.Minute, time.Second).Should(BeTrue())
+
+			By("Schema Job succeeded")
+			schemaVersion := trillianUtils.SchemaVersion(constants.TrillianServerImage)
+			job := &batchv1.Job{}
+			Eventually(func() error {
+				return k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(trillianUtils.SchemaResourceName(schemaVersion), Name), Namespace: Namespace}, job)
+			}, time.Minute, time.Second).Should(Succeed())
+			job.Status.Conditions = []batchv1.JobCondition{
+				{Status: corev1.ConditionTrue, Type: batchv1.JobComplete}}
+			Expect(k8sClient.Status().Update(ctx, job)).Should(Succeed())
+			Eventually(func() string {
+				Expect(k8sClient.Get(ctx, typeNamespaceName, found)).Should(Succeed())
+				return found.Status.SchemaVersion
+			}, time.Minute, time.Second).Should(Equal(schemaVersion))
+
 			By("LogServer Deployment created")
 			Eventually(func() error {
-				return k8sClient.Get(ctx, types.NamespacedName{Name: actions.LogserverDeploymentName, Namespace: Namespace}, &appsv1.Deployment{})
+				return k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.LogserverDeploymentName, Name), Namespace: Namespace}, &appsv1.Deployment{})
 			}, time.Minute, time.Second).Should(Succeed())
 
 			By("LogServerSvc Deployment created")
 			Eventually(func() error {
-				return k8sClient.Get(ctx, types.NamespacedName{Name: actions.LogserverDeploymentName, Namespace: Namespace}, &corev1.Service{})
+				return k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.LogserverDeploymentName, Name), Namespace: Namespace}, &corev1.Service{})
 			}, time.Minute, time.Second).Should(Succeed())
 
 			By("LogSigner Deployment created")
 			Eventually(func() error {
-				return k8sClient.Get(ctx, types.NamespacedName{Name: actions.LogsignerDeploymentName, Namespace: Namespace}, &appsv1.Deployment{})
+				return k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.LogsignerDeploymentName, Name), Namespace: Namespace}, &appsv1.Deployment{})
 			}, time.Minute, time.Second).Should(Succeed())
 
 			By("Waiting until Trillian instance is Initialization")
@@ -182,14 +209,14 @@ var _ = Describe("Trillian controller", func() {
 			By("Checking if controller will return deployment to desired state")
 			deployment := &appsv1.Deployment{}
 			Eventually(func() error {
-				return k8sClient.Get(ctx, types.NamespacedName{Name: actions.LogserverDeploymentName, Namespace: Namespace}, deployment)
+				return k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.LogserverDeploymentName, Name), Namespace: Namespace}, deployment)
 			}, time.Minute, time.Second).Should(Succeed())
 			replicas := int32(99)
 			deployment.Spec.Replicas = &replicas
 			Expect(k8sClient.Status().Update(ctx, deployment)).Should(Succeed())
 			Eventually(func() int32 {
 				deployment = &appsv1.Deployment{}
-				Expect(k8sClient.Get(ctx, types.NamespacedName{Name: actions.LogserverDeploymentName, Namespace: Namespace}, deployment)).Should(Succeed())
+				Expect(k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.LogserverDeploymentName, Name), Namespace: Namespace}, deployment)).Should(Succeed())
 				return *deployment.Spec.Replicas
 			}, time.Minute, time.Second).Should(Equal(int32(1)))
 		})
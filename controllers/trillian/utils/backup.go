@@ -0,0 +1,157 @@
+package trillianUtils
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/utils/kubernetes"
+	batchv1 "k8s.io/api/batch/v1"
+	core "k8s.io/api/core/v1"
+)
+
+const (
+	DumpExtension = ".sql.gz"
+
+	// S3 credentials keys of the secret referenced by the S3 target
+	S3AccessKeyIDKey     = "AWS_ACCESS_KEY_ID"
+	S3SecretAccessKeyKey = "AWS_SECRET_ACCESS_KEY"
+	S3DefaultRegion      = "us-east-1"
+
+	backupVolume    = "backup"
+	backupMountPath = "/var/lib/trillian-backup"
+)
+
+// dumpScript creates the consistent logical dump of InnoDB tables without locking the database.
+const dumpScript = `set -euo pipefail
+dump="` + backupMountPath + `/${DUMP_NAME}` + DumpExtension + `"
+mysqldump "$@" --single-transaction --quick --no-tablespaces --routines --triggers "$MYSQL_DATABASE" | gzip > "$dump.partial"
+mv "$dump.partial" "$dump"
+`
+
+// pvcRetentionScript deletes the oldest dumps from the PVC.
+const pvcRetentionScript = `ls -1t ` + backupMountPath + `/*` + DumpExtension + ` | tail -n +$((RETENTION + 1)) | xargs -r rm -f --
+`
+
+const s3UploadScript = `curl -fsS --aws-sigv4 "aws:amz:${S3_REGION}:s3" --user "${AWS_ACCESS_KEY_ID}:${AWS_SECRET_ACCESS_KEY}" -T "$dump" "${S3_URL}${DUMP_NAME}` + DumpExtension + `"
+rm -f "$dump"
+`
+
+const s3DownloadScript = `curl -fsS --aws-sigv4 "aws:amz:${S3_REGION}:s3" --user "${AWS_ACCESS_KEY_ID}:${AWS_SECRET_ACCESS_KEY}" -o "$dump" "${S3_URL}${DUMP_NAME}` + DumpExtension + `"
+`
+
+const restoreScript = `set -euo pipefail
+dump="` + backupMountPath + `/${DUMP_NAME}` + DumpExtension + `"
+%sgunzip -c "$dump" | mysql "$@"
+`
+
+// S3ObjectURL returns the path-style URL of the object holding the dump, the dump name is appended to the returned prefix.
+func S3ObjectURL(target *v1alpha1.S3Target, dump string) string {
+	return strings.TrimSuffix(target.Endpoint, "/") + "/" + target.Bucket + "/" + target.Prefix + dump
+}
+
+// S3Region returns the region of the bucket.
+func S3Region(target *v1alpha1.S3Target) string {
+	if target.Region == "" {
+		return S3DefaultRegion
+	}
+	return target.Region
+}
+
+// DumpLocation returns the location of the dump in the backup target.
+func DumpLocation(backup *v1alpha1.TrillianBackup, dump string) string {
+	if s3 := backup.Spec.Target.S3; s3 != nil {
+		return "s3://" + s3.Bucket + "/" + s3.Prefix + dump + DumpExtension
+	}
+	return "pvc://" + backup.Status.PvcName + "/" + dump + DumpExtension
+}
+
+// CreateBackupJob creates the Job dumping the Trillian database to the backup target.
+// The dump is named after the Job so scheduled backups do not overwrite each other.
+func CreateBackupJob(instance *v1alpha1.Trillian, backup *v1alpha1.TrillianBackup, image string, name string, sa string, labels map[string]string) (*batchv1.Job, error) {
+	script := dumpScript
+	if backup.Spec.Target.S3 != nil {
+		script += s3UploadScript
+	} else {
+		script += pvcRetentionScript
+	}
+	return createDumpJob(instance, backup, image, name, sa, labels, script, core.EnvVar{
+		Name: "DUMP_NAME",
+		ValueFrom: &core.EnvVarSource{
+			FieldRef: &core.ObjectFieldSelector{FieldPath: "metadata.labels['job-name']"},
+		},
+	}, core.EnvVar{
+		Name:  "RETENTION",
+		Value: strconv.Itoa(int(backup.Spec.Retention)),
+	})
+}
+
+// CreateRestoreJob creates the Job loading the dump from the backup target to the Trillian database.
+func CreateRestoreJob(instance *v1alpha1.Trillian, backup *v1alpha1.TrillianBackup, dump string, image string, name string, sa string, labels map[string]string) (*batchv1.Job, error) {
+	download := ""
+	if backup.Spec.Target.S3 != nil {
+		download = s3DownloadScript
+	}
+	job, err := createDumpJob(instance, backup, image, name, sa, labels, fmt.Sprintf(restoreScript, download), core.EnvVar{
+		Name:  "DUMP_NAME",
+		Value: dump,
+	})
+	if err != nil {
+		return nil, err
+	}
+	container := &job.Spec.Template.Spec.Containers[0]
+	container.Args = append(container.Args, "-D", "$(MYSQL_DATABASE)")
+	return job, nil
+}
+
+func createDumpJob(instance *v1alpha1.Trillian, backup *v1alpha1.TrillianBackup, image string, name string, sa string, labels map[string]string, script string, env ...core.EnvVar) (*batchv1.Job, error) {
+	if instance.Status.Db.DatabaseSecretRef == nil {
+		return nil, errors.New("reference to database secret is not set")
+	}
+	backupSource := core.VolumeSource{EmptyDir: &core.EmptyDirVolumeSource{}}
+	env = append(databaseEnv(instance.Status.Db.DatabaseSecretRef.Name), env...)
+	switch {
+	case backup.Spec.Target.S3 != nil:
+		s3 := backup.Spec.Target.S3
+		env = append(env,
+			core.EnvVar{Name: "S3_URL", Value: S3ObjectURL(s3, "")},
+			core.EnvVar{Name: "S3_REGION", Value: S3Region(s3)},
+		)
+		for _, key := range []string{S3AccessKeyIDKey, S3SecretAccessKeyKey} {
+			env = append(env, core.EnvVar{
+				Name: key,
+				ValueFrom: &core.EnvVarSource{
+					SecretKeyRef: &core.SecretKeySelector{
+						Key:                  key,
+						LocalObjectReference: core.LocalObjectReference{Name: s3.CredentialsRef.Name},
+					},
+				},
+			})
+		}
+	case backup.Status.PvcName != "":
+		backupSource = core.VolumeSource{
+			PersistentVolumeClaim: &core.PersistentVolumeClaimVolumeSource{ClaimName: backup.Status.PvcName},
+		}
+	default:
+		return nil, errors.New("backup PVC is not set")
+	}
+
+	job := kubernetes.CreateJob(instance.Namespace, name, labels, image, sa, 1, 1, 3600, 2,
+		[]string{"bash", "-c", script, "--"}, env)
+	job.Spec.Template.Labels = labels
+
+	pod := &job.Spec.Template.Spec
+	container := &pod.Containers[0]
+	pod.Volumes = append(pod.Volumes, core.Volume{
+		Name:         backupVolume,
+		VolumeSource: backupSource,
+	})
+	container.VolumeMounts = append(container.VolumeMounts, core.VolumeMount{
+		Name:      backupVolume,
+		MountPath: backupMountPath,
+	})
+	container.Args = mysqlClientArgs(instance.Spec.Db, pod, container)
+	return job, nil
+}
@@ -0,0 +1,82 @@
+package trillianUtils
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCreateBackupJob(t *testing.T) {
+	g := NewWithT(t)
+
+	instance := &v1alpha1.Trillian{
+		ObjectMeta: metav1.ObjectMeta{Name: "trillian", Namespace: "default"},
+		Status: v1alpha1.TrillianStatus{
+			Db: v1alpha1.TrillianDB{DatabaseSecretRef: &v1alpha1.LocalObjectReference{Name: "db"}},
+		},
+	}
+	backup := &v1alpha1.TrillianBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "default"},
+		Spec: v1alpha1.TrillianBackupSpec{
+			Retention: 3,
+			Target:    v1alpha1.BackupTarget{Pvc: &v1alpha1.Pvc{}},
+		},
+	}
+
+	_, err := CreateBackupJob(instance, backup, "image", "backup", "sa", map[string]string{})
+	g.Expect(err).Should(MatchError("backup PVC is not set"))
+
+	backup.Status.PvcName = "backup-pvc"
+	job, err := CreateBackupJob(instance, backup, "image", "backup", "sa", map[string]string{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	pod := job.Spec.Template.Spec
+	g.Expect(pod.Volumes).Should(ContainElement(HaveField("PersistentVolumeClaim.ClaimName", "backup-pvc")))
+	g.Expect(pod.Containers[0].Command[2]).Should(ContainSubstring("mysqldump"))
+	g.Expect(pod.Containers[0].Command[2]).Should(ContainSubstring("tail -n +$((RETENTION + 1))"))
+	g.Expect(pod.Containers[0].Env).Should(ContainElement(HaveField("ValueFrom.FieldRef.FieldPath", "metadata.labels['job-name']")))
+	g.Expect(pod.Containers[0].Env).Should(ContainElement(And(HaveField("Name", "RETENTION"), HaveField("Value", "3"))))
+	g.Expect(DumpLocation(backup, "backup")).Should(Equal("pvc://backup-pvc/backup.sql.gz"))
+
+	backup.Spec.Target = v1alpha1.BackupTarget{S3: &v1alpha1.S3Target{
+		Endpoint:       "https://s3.example.com/",
+		Bucket:         "bucket",
+		Prefix:         "trillian/",
+		CredentialsRef: v1alpha1.LocalObjectReference{Name: "credentials"},
+	}}
+	job, err = CreateBackupJob(instance, backup, "image", "backup", "sa", map[string]string{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	pod = job.Spec.Template.Spec
+	g.Expect(pod.Volumes).Should(ContainElement(HaveField("EmptyDir", Not(BeNil()))))
+	g.Expect(pod.Containers[0].Command[2]).Should(ContainSubstring("curl"))
+	g.Expect(pod.Containers[0].Env).Should(ContainElements(
+		And(HaveField("Name", "S3_URL"), HaveField("Value", "https://s3.example.com/bucket/trillian/")),
+		And(HaveField("Name", "S3_REGION"), HaveField("Value", S3DefaultRegion)),
+		HaveField("ValueFrom.SecretKeyRef.Key", S3SecretAccessKeyKey),
+	))
+	g.Expect(DumpLocation(backup, "backup")).Should(Equal("s3://bucket/trillian/backup.sql.gz"))
+}
+
+func TestCreateRestoreJob(t *testing.T) {
+	g := NewWithT(t)
+
+	instance := &v1alpha1.Trillian{
+		ObjectMeta: metav1.ObjectMeta{Name: "trillian", Namespace: "default"},
+		Status: v1alpha1.TrillianStatus{
+			Db: v1alpha1.TrillianDB{DatabaseSecretRef: &v1alpha1.LocalObjectReference{Name: "db"}},
+		},
+	}
+	backup := &v1alpha1.TrillianBackup{
+		Spec:   v1alpha1.TrillianBackupSpec{Target: v1alpha1.BackupTarget{Pvc: &v1alpha1.Pvc{}}},
+		Status: v1alpha1.TrillianBackupStatus{PvcName: "backup-pvc"},
+	}
+
+	job, err := CreateRestoreJob(instance, backup, "dump", "image", "restore", "sa", map[string]string{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	container := job.Spec.Template.Spec.Containers[0]
+	g.Expect(container.Command[2]).Should(ContainSubstring(`gunzip -c "$dump" | mysql "$@"`))
+	g.Expect(container.Command[2]).ShouldNot(ContainSubstring("curl"))
+	g.Expect(container.Args).Should(ContainElements("-D", "$(MYSQL_DATABASE)"))
+	g.Expect(container.Env).Should(ContainElement(And(HaveField("Name", "DUMP_NAME"), HaveField("Value", "dump"))))
+}
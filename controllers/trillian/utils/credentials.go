@@ -0,0 +1,148 @@
+package trillianUtils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/utils/kubernetes"
+	"github.com/securesign/operator/controllers/constants"
+	apps "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// CredentialsRotatedAnnotation on the pod template rolls the Logserver and Logsigner out once the credentials are rotated
+	CredentialsRotatedAnnotation = constants.LabelNamespace + "/db-credentials-rotated"
+
+	DbRootPasswordKey = "mysql-root-password"
+	DbPasswordKey     = "mysql-password"
+	DbUserKey         = "mysql-user"
+)
+
+// The credentials are rotated in two steps so the Logserver and Logsigner keep connecting while they roll out.
+// CredentialsRetain sets the new password of the alternate account while the current account is still accepted,
+// CredentialsDiscard removes the previous account once all pods use the new credentials.
+const (
+	CredentialsRetain  = "Retain"
+	CredentialsDiscard = "Discard"
+)
+
+// alternateUserSuffix marks the second account of the database user, the accounts take turns on every rotation.
+// MariaDB keeps a single password per account, so the Logserver and Logsigner switch to the other account instead.
+const alternateUserSuffix = "_alt"
+
+// mysqlRetainScript sets the password of the account not used by the Logserver and Logsigner, the account is created
+// with the privileges of the database user on the first rotation. The root password is used by the Operator Jobs only,
+// so it is changed directly. The new root password is tried when the old one is rejected, so the Job can be retried
+// after a partially applied rotation.
+const mysqlRetainScript = `set -eu
+sql="CREATE USER IF NOT EXISTS '${NEW_USER}'@'%' IDENTIFIED BY '${NEW_PASSWORD}';
+ALTER USER '${NEW_USER}'@'%' IDENTIFIED BY '${NEW_PASSWORD}';
+GRANT ALL PRIVILEGES ON ${MYSQL_DATABASE}.* TO '${NEW_USER}'@'%';
+ALTER USER IF EXISTS 'root'@'localhost' IDENTIFIED BY '${NEW_ROOT_PASSWORD}';
+ALTER USER IF EXISTS 'root'@'%' IDENTIFIED BY '${NEW_ROOT_PASSWORD}';"
+mysql -h "$MYSQL_HOSTNAME" -P "$MYSQL_PORT" -u root -p"$OLD_ROOT_PASSWORD" -e "$sql" ||
+  mysql -h "$MYSQL_HOSTNAME" -P "$MYSQL_PORT" -u root -p"$NEW_ROOT_PASSWORD" -e "$sql"
+`
+
+// mysqlDiscardScript removes the account used before the rotation, it is created again by the next rotation.
+const mysqlDiscardScript = `set -eu
+mysql -h "$MYSQL_HOSTNAME" -P "$MYSQL_PORT" -u root -p"$NEW_ROOT_PASSWORD" -e "DROP USER IF EXISTS '${OLD_USER}'@'%';"
+`
+
+// RotationDue reports whether the scheduled rotation of the database credentials is due.
+func RotationDue(instance *v1alpha1.Trillian, now time.Time) (bool, error) {
+	if instance.Spec.Db.CredentialsRotation.Schedule == "" {
+		return false, nil
+	}
+	schedule, err := cron.ParseStandard(instance.Spec.Db.CredentialsRotation.Schedule)
+	if err != nil {
+		return false, fmt.Errorf("invalid credentials rotation schedule: %w", err)
+	}
+	last := instance.CreationTimestamp.Time
+	if t := instance.Status.DbCredentials.LastRotationTime; t != nil {
+		last = t.Time
+	}
+	return !schedule.Next(last).After(now), nil
+}
+
+// AlternateUser returns the account taking turns with the database user.
+func AlternateUser(user string) string {
+	if owner, ok := strings.CutSuffix(user, alternateUserSuffix); ok {
+		return owner
+	}
+	return user + alternateUserSuffix
+}
+
+// CreateRotationJob creates the Job of the rotation step. The Retain step sets the passwords of the alternate account and root
+// to the ones from the pending secret, the Discard step removes the account replaced by the Retain step.
+// The user is the database user of the database secret.
+func CreateRotationJob(instance *v1alpha1.Trillian, step string, user string, image string, name string, sa string, labels map[string]string) (*batchv1.Job, error) {
+	if instance.Status.Db.DatabaseSecretRef == nil {
+		return nil, errors.New("reference to database secret is not set")
+	}
+	pending := instance.Status.DbCredentials.PendingSecretRef
+	if pending == nil {
+		return nil, errors.New("reference to secret with new credentials is not set")
+	}
+	env := databaseEnv(instance.Status.Db.DatabaseSecretRef.Name)
+	var (
+		script  string
+		secrets []struct{ name, secret, key string }
+	)
+	switch step {
+	case CredentialsRetain:
+		script = mysqlRetainScript
+		env = append(env, core.EnvVar{Name: "NEW_USER", Value: AlternateUser(user)})
+		secrets = []struct{ name, secret, key string }{
+			{"OLD_ROOT_PASSWORD", instance.Status.Db.DatabaseSecretRef.Name, DbRootPasswordKey},
+			{"NEW_ROOT_PASSWORD", pending.Name, DbRootPasswordKey},
+			{"NEW_PASSWORD", pending.Name, DbPasswordKey},
+		}
+	case CredentialsDiscard:
+		// the database secret holds the new credentials already
+		script = mysqlDiscardScript
+		env = append(env, core.EnvVar{Name: "OLD_USER", Value: AlternateUser(user)})
+		secrets = []struct{ name, secret, key string }{
+			{"NEW_ROOT_PASSWORD", instance.Status.Db.DatabaseSecretRef.Name, DbRootPasswordKey},
+		}
+	default:
+		return nil, fmt.Errorf("unknown credentials rotation step %q", step)
+	}
+	for _, v := range secrets {
+		env = append(env, core.EnvVar{
+			Name: v.name,
+			ValueFrom: &core.EnvVarSource{
+				SecretKeyRef: &core.SecretKeySelector{
+					Key:                  v.key,
+					LocalObjectReference: core.LocalObjectReference{Name: v.secret},
+				},
+			},
+		})
+	}
+	job := kubernetes.CreateJob(instance.Namespace, name, labels, image, sa, 1, 1, 600, 4,
+		[]string{"bash", "-c", script}, env)
+	job.Spec.Template.Labels = labels
+	return job, nil
+}
+
+// CredentialsRolledOut reports whether all pods of the Deployment were started after the credentials were rotated.
+func CredentialsRolledOut(dp *apps.Deployment, rotated *metav1.Time) bool {
+	if rotated == nil || dp.Spec.Template.Annotations[CredentialsRotatedAnnotation] != rotated.UTC().Format(time.RFC3339) {
+		return false
+	}
+	replicas := int32(1)
+	if dp.Spec.Replicas != nil {
+		replicas = *dp.Spec.Replicas
+	}
+	return dp.Status.ObservedGeneration >= dp.Generation &&
+		dp.Status.UpdatedReplicas == replicas &&
+		dp.Status.Replicas == replicas &&
+		dp.Status.AvailableReplicas == replicas
+}
@@ -0,0 +1,123 @@
+package trillianUtils
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRotationDue(t *testing.T) {
+	g := NewWithT(t)
+
+	created := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	instance := &v1alpha1.Trillian{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)},
+	}
+	g.Expect(RotationDue(instance, created.AddDate(1, 0, 0))).Should(BeFalse())
+
+	instance.Spec.Db.CredentialsRotation.Schedule = "0 0 1 * *"
+	g.Expect(RotationDue(instance, created)).Should(BeFalse())
+	g.Expect(RotationDue(instance, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))).Should(BeTrue())
+
+	instance.Status.DbCredentials.LastRotationTime = &metav1.Time{Time: time.Date(2024, 2, 1, 0, 0, 5, 0, time.UTC)}
+	g.Expect(RotationDue(instance, time.Date(2024, 2, 20, 0, 0, 0, 0, time.UTC))).Should(BeFalse())
+	g.Expect(RotationDue(instance, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))).Should(BeTrue())
+
+	instance.Spec.Db.CredentialsRotation.Schedule = "invalid"
+	_, err := RotationDue(instance, created)
+	g.Expect(err).Should(HaveOccurred())
+}
+
+func TestCreateRotationJob(t *testing.T) {
+	g := NewWithT(t)
+
+	instance := &v1alpha1.Trillian{
+		ObjectMeta: metav1.ObjectMeta{Name: "trillian", Namespace: "default"},
+		Status: v1alpha1.TrillianStatus{
+			Db: v1alpha1.TrillianDB{DatabaseSecretRef: &v1alpha1.LocalObjectReference{Name: "db"}},
+		},
+	}
+	g.Expect(AlternateUser("mysql")).Should(Equal("mysql_alt"))
+	g.Expect(AlternateUser("mysql_alt")).Should(Equal("mysql"))
+
+	_, err := CreateRotationJob(instance, CredentialsRetain, "mysql", "image", "rotate", "sa", map[string]string{})
+	g.Expect(err).Should(HaveOccurred())
+
+	// the password of the account not in use is set
+	instance.Status.DbCredentials.PendingSecretRef = &v1alpha1.LocalObjectReference{Name: "pending"}
+	job, err := CreateRotationJob(instance, CredentialsRetain, "mysql", "image", "rotate", "sa", map[string]string{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(job.Spec.Template.Spec.Containers[0].Command).Should(ContainElement(ContainSubstring("CREATE USER IF NOT EXISTS")))
+	env := job.Spec.Template.Spec.Containers[0].Env
+	g.Expect(env).Should(HaveLen(9))
+	g.Expect(env).Should(ContainElement(And(HaveField("Name", "NEW_USER"), HaveField("Value", "mysql_alt"))))
+	g.Expect(env).Should(ContainElement(And(
+		HaveField("Name", "OLD_ROOT_PASSWORD"),
+		HaveField("ValueFrom.SecretKeyRef.Name", "db"),
+		HaveField("ValueFrom.SecretKeyRef.Key", DbRootPasswordKey))))
+	g.Expect(env).Should(ContainElement(And(
+		HaveField("Name", "NEW_ROOT_PASSWORD"),
+		HaveField("ValueFrom.SecretKeyRef.Name", "pending"))))
+	g.Expect(env).Should(ContainElement(And(
+		HaveField("Name", "NEW_PASSWORD"),
+		HaveField("ValueFrom.SecretKeyRef.Name", "pending"),
+		HaveField("ValueFrom.SecretKeyRef.Key", DbPasswordKey))))
+
+	// the database secret holds the new credentials when the previous account is removed
+	job, err = CreateRotationJob(instance, CredentialsDiscard, "mysql_alt", "image", "discard", "sa", map[string]string{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(job.Spec.Template.Spec.Containers[0].Command).Should(ContainElement(ContainSubstring("DROP USER IF EXISTS")))
+	g.Expect(job.Spec.Template.Spec.Containers[0].Env).Should(ContainElement(And(HaveField("Name", "OLD_USER"), HaveField("Value", "mysql"))))
+	g.Expect(job.Spec.Template.Spec.Containers[0].Env).Should(ContainElement(And(
+		HaveField("Name", "NEW_ROOT_PASSWORD"),
+		HaveField("ValueFrom.SecretKeyRef.Name", "db"))))
+
+	_, err = CreateRotationJob(instance, "unknown", "mysql", "image", "rotate", "sa", map[string]string{})
+	g.Expect(err).Should(HaveOccurred())
+}
+
+func TestCredentialsRolledOut(t *testing.T) {
+	g := NewWithT(t)
+
+	rotated := &metav1.Time{Time: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)}
+	dp := &apps.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Generation: 2},
+		Spec: apps.DeploymentSpec{
+			Template: core.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{CredentialsRotatedAnnotation: "2024-02-01T00:00:00Z"},
+			}},
+		},
+		Status: apps.DeploymentStatus{ObservedGeneration: 2, Replicas: 2, UpdatedReplicas: 1, AvailableReplicas: 2},
+	}
+	g.Expect(CredentialsRolledOut(dp, nil)).Should(BeFalse())
+	// an old pod is still running
+	g.Expect(CredentialsRolledOut(dp, rotated)).Should(BeFalse())
+
+	dp.Status = apps.DeploymentStatus{ObservedGeneration: 2, Replicas: 1, UpdatedReplicas: 1, AvailableReplicas: 1}
+	g.Expect(CredentialsRolledOut(dp, rotated)).Should(BeTrue())
+	g.Expect(CredentialsRolledOut(dp, &metav1.Time{Time: rotated.AddDate(0, 1, 0)})).Should(BeFalse())
+}
+
+func TestCredentialsRotatedAnnotation(t *testing.T) {
+	g := NewWithT(t)
+
+	instance := &v1alpha1.Trillian{
+		ObjectMeta: metav1.ObjectMeta{Name: "trillian", Namespace: "default"},
+		Status: v1alpha1.TrillianStatus{
+			Db: v1alpha1.TrillianDB{DatabaseSecretRef: &v1alpha1.LocalObjectReference{Name: "db"}},
+		},
+	}
+	dp, err := CreateTrillDeployment(instance, "image", "trillian-logserver", "sa", map[string]string{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(dp.Spec.Template.Annotations).ShouldNot(HaveKey(CredentialsRotatedAnnotation))
+
+	instance.Status.DbCredentials.LastRotationTime = &metav1.Time{Time: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)}
+	dp, err = CreateTrillDeployment(instance, "image", "trillian-logserver", "sa", map[string]string{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(dp.Spec.Template.Annotations).Should(HaveKeyWithValue(CredentialsRotatedAnnotation, "2024-02-01T00:00:00Z"))
+}
@@ -0,0 +1,193 @@
+package trillianUtils
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/securesign/operator/api/v1alpha1"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+)
+
+const (
+	DBTLSDisabled   = "Disabled"
+	DBTLSSkipVerify = "SkipVerify"
+	DBTLSVerifyFull = "VerifyFull"
+
+	dbCAVolume    = "db-ca"
+	dbCAMountPath = "/var/run/secrets/db-ca"
+
+	dbCheckTimeout = 10 * time.Second
+)
+
+// trillianTables are created by the Trillian schema, see https://github.com/google/trillian/blob/master/storage/mysql/schema/storage.sql
+var trillianTables = []string{"Trees", "TreeControl", "Subtree", "TreeHead", "LeafData", "SequencedLeafData", "Unsequenced"}
+
+// DBTLSMode returns the verification mode of the database connection.
+func DBTLSMode(db v1alpha1.TrillianDB) string {
+	switch {
+	case db.TLS.Mode != "":
+		return db.TLS.Mode
+	case db.TLS.CACertRef != nil:
+		return DBTLSVerifyFull
+	default:
+		return DBTLSDisabled
+	}
+}
+
+// mysqlTLSParam maps the verification mode to the tls parameter of the MySQL DSN.
+func mysqlTLSParam(mode string) string {
+	switch mode {
+	case DBTLSSkipVerify:
+		return "skip-verify"
+	case DBTLSVerifyFull:
+		return "true"
+	default:
+		return "false"
+	}
+}
+
+// UseDatabaseConfig configures TLS and connection pool limits of the database connection.
+func UseDatabaseConfig(dp *apps.Deployment, db v1alpha1.TrillianDB) {
+	template := &dp.Spec.Template.Spec
+	container := &template.Containers[0]
+	for i, arg := range container.Args {
+		if mode := DBTLSMode(db); mode != DBTLSDisabled && arg == mysqlURIArg {
+			container.Args[i] = arg + "?tls=" + mysqlTLSParam(mode)
+		}
+	}
+	if ca := db.TLS.CACertRef; ca != nil && DBTLSMode(db) == DBTLSVerifyFull {
+		// Trillian verifies the server certificate against system CA certificates
+		container.Env = append(container.Env, core.EnvVar{Name: "SSL_CERT_FILE", Value: mountDatabaseCA(template, container, ca)})
+	}
+	if limit := db.ConnectionPool.MaxOpenConnections; limit != nil {
+		container.Args = append(container.Args, fmt.Sprintf("--mysql_max_conns=%d", *limit))
+	}
+	if limit := db.ConnectionPool.MaxIdleConnections; limit != nil {
+		container.Args = append(container.Args, fmt.Sprintf("--mysql_max_idle_conns=%d", *limit))
+	}
+}
+
+// mountDatabaseCA mounts the CA certificate of the database to the container and returns its path.
+func mountDatabaseCA(pod *core.PodSpec, container *core.Container, ca *v1alpha1.SecretKeySelector) string {
+	pod.Volumes = append(pod.Volumes, core.Volume{
+		Name: dbCAVolume,
+		VolumeSource: core.VolumeSource{
+			Secret: &core.SecretVolumeSource{
+				SecretName: ca.Name,
+				Items:      []core.KeyToPath{{Key: ca.Key, Path: ca.Key}},
+			},
+		},
+	})
+	container.VolumeMounts = append(container.VolumeMounts, core.VolumeMount{
+		Name:      dbCAVolume,
+		MountPath: dbCAMountPath,
+		ReadOnly:  true,
+	})
+	return dbCAMountPath + "/" + ca.Key
+}
+
+// DatabaseConnection holds the connection details from the database secret.
+type DatabaseConnection struct {
+	Host     string
+	Port     string
+	User     string
+	Password string
+	Database string
+	TLSMode  string
+	CACert   []byte
+}
+
+// NewDatabaseConnection reads connection details from the data of the database secret.
+func NewDatabaseConnection(data map[string][]byte, db v1alpha1.TrillianDB, caCert []byte) (*DatabaseConnection, error) {
+	conn := &DatabaseConnection{
+		Host:     string(data["mysql-host"]),
+		Port:     string(data["mysql-port"]),
+		User:     string(data["mysql-user"]),
+		Password: string(data["mysql-password"]),
+		Database: string(data["mysql-database"]),
+		TLSMode:  DBTLSMode(db),
+		CACert:   caCert,
+	}
+	for key, value := range map[string]string{
+		"mysql-host":     conn.Host,
+		"mysql-port":     conn.Port,
+		"mysql-user":     conn.User,
+		"mysql-database": conn.Database,
+	} {
+		if value == "" {
+			return nil, fmt.Errorf("database secret does not contain %s", key)
+		}
+	}
+	if _, err := strconv.ParseUint(conn.Port, 10, 16); err != nil {
+		return nil, fmt.Errorf("invalid database port %q", conn.Port)
+	}
+	return conn, nil
+}
+
+func (c *DatabaseConnection) config() (*mysql.Config, error) {
+	cfg := mysql.NewConfig()
+	cfg.Net = "tcp"
+	cfg.Addr = net.JoinHostPort(c.Host, c.Port)
+	cfg.User = c.User
+	cfg.Passwd = c.Password
+	cfg.DBName = c.Database
+	cfg.Timeout = dbCheckTimeout
+
+	switch c.TLSMode {
+	case DBTLSSkipVerify:
+		cfg.TLS = &tls.Config{InsecureSkipVerify: true} // nolint
+	case DBTLSVerifyFull:
+		cfg.TLS = &tls.Config{ServerName: c.Host, MinVersion: tls.VersionTLS12}
+		if c.CACert != nil {
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(c.CACert) {
+				return nil, errors.New("could not parse database CA certificate")
+			}
+			cfg.TLS.RootCAs = pool
+		}
+	}
+	return cfg, nil
+}
+
+// CheckDatabase verifies that the database is reachable and reports whether the Trillian schema is present.
+func CheckDatabase(ctx context.Context, c *DatabaseConnection) (bool, error) {
+	cfg, err := c.config()
+	if err != nil {
+		return false, err
+	}
+	connector, err := mysql.NewConnector(cfg)
+	if err != nil {
+		return false, err
+	}
+	db := sql.OpenDB(connector)
+	defer db.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, dbCheckTimeout)
+	defer cancel()
+	if err = db.PingContext(ctx); err != nil {
+		return false, fmt.Errorf("could not connect to database: %w", err)
+	}
+
+	for _, table := range trillianTables {
+		var name string
+		err = db.QueryRowContext(ctx,
+			"SELECT table_name FROM information_schema.tables WHERE table_schema = ? AND table_name = ?",
+			c.Database, table).Scan(&name)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return false, nil
+		case err != nil:
+			return false, fmt.Errorf("could not verify database schema: %w", err)
+		}
+	}
+	return true, nil
+}
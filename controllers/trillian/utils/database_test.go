@@ -0,0 +1,84 @@
+package trillianUtils
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestUseDatabaseConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	instance := &v1alpha1.Trillian{
+		ObjectMeta: metav1.ObjectMeta{Name: "trillian", Namespace: "default"},
+		Status: v1alpha1.TrillianStatus{
+			Db: v1alpha1.TrillianDB{DatabaseSecretRef: &v1alpha1.LocalObjectReference{Name: "db"}},
+		},
+	}
+	dp, err := CreateTrillDeployment(instance, "image", "trillian-logserver", "sa", map[string]string{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(dp.Spec.Template.Spec.Containers[0].Args).Should(ContainElement(mysqlURIArg))
+	g.Expect(dp.Spec.Template.Spec.Volumes).Should(BeEmpty())
+	g.Expect(dp.Spec.Template.Spec.InitContainers).Should(BeEmpty())
+
+	instance.Spec.Db.TLS.CACertRef = &v1alpha1.SecretKeySelector{
+		LocalObjectReference: v1alpha1.LocalObjectReference{Name: "db-ca"},
+		Key:                  "ca.crt",
+	}
+	instance.Spec.Db.ConnectionPool = v1alpha1.TrillianDBConnectionPool{
+		MaxOpenConnections: utils.Pointer(int32(20)),
+		MaxIdleConnections: utils.Pointer(int32(0)),
+	}
+	dp, err = CreateTrillDeployment(instance, "image", "trillian-logserver", "sa", map[string]string{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	container := dp.Spec.Template.Spec.Containers[0]
+	g.Expect(container.Args).Should(ContainElements(
+		mysqlURIArg+"?tls=true",
+		"--mysql_max_conns=20",
+		"--mysql_max_idle_conns=0",
+	))
+	g.Expect(container.Env).Should(ContainElement(HaveField("Value", "/var/run/secrets/db-ca/ca.crt")))
+	g.Expect(dp.Spec.Template.Spec.Volumes).Should(ContainElement(HaveField("Secret.SecretName", "db-ca")))
+
+	instance.Spec.Db.TLS.Mode = DBTLSSkipVerify
+	dp, err = CreateTrillDeployment(instance, "image", "trillian-logserver", "sa", map[string]string{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(dp.Spec.Template.Spec.Containers[0].Args).Should(ContainElement(mysqlURIArg + "?tls=skip-verify"))
+	g.Expect(dp.Spec.Template.Spec.Volumes).Should(BeEmpty())
+}
+
+func TestNewDatabaseConnection(t *testing.T) {
+	g := NewWithT(t)
+
+	data := map[string][]byte{
+		"mysql-host":     []byte("mysql.example.com"),
+		"mysql-port":     []byte("3306"),
+		"mysql-user":     []byte("trillian"),
+		"mysql-password": []byte("secret"),
+		"mysql-database": []byte("trillian"),
+	}
+	db := v1alpha1.TrillianDB{TLS: v1alpha1.TrillianDBTLS{Mode: DBTLSVerifyFull}}
+	conn, err := NewDatabaseConnection(data, db, nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	cfg, err := conn.config()
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(cfg.Addr).Should(Equal("mysql.example.com:3306"))
+	g.Expect(cfg.TLS.ServerName).Should(Equal("mysql.example.com"))
+	g.Expect(cfg.TLS.InsecureSkipVerify).Should(BeFalse())
+
+	conn, err = NewDatabaseConnection(data, db, []byte("invalid"))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	_, err = conn.config()
+	g.Expect(err).Should(HaveOccurred())
+
+	data["mysql-port"] = []byte("mysql")
+	_, err = NewDatabaseConnection(data, db, nil)
+	g.Expect(err).Should(HaveOccurred())
+
+	delete(data, "mysql-host")
+	_, err = NewDatabaseConnection(data, db, nil)
+	g.Expect(err).Should(MatchError(ContainSubstring("mysql-host")))
+}
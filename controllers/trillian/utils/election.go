@@ -0,0 +1,352 @@
+package trillianUtils
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/common/expfmt"
+	"github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/constants"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	ElectionPort        = 2379
+	ElectionPeerPort    = 2380
+	ElectionMetricsPort = 2381
+
+	electionStorageSize = "1Gi"
+	electionCAVolume    = "election-ca"
+	electionCAMountPath = "/var/run/secrets/election-ca"
+
+	// isMasterMetric is reported by the Logsigner for every tree, 1 on the replica signing the tree
+	isMasterMetric = "is_master"
+	logIDLabel     = "logid"
+)
+
+// SignerReplicas returns the desired number of Logsigner replicas.
+func SignerReplicas(instance *v1alpha1.Trillian) int32 {
+	if r := instance.Spec.LogSigner.Replicas; r != nil {
+		return *r
+	}
+	return 1
+}
+
+// ElectionEnabled reports whether the Logsigner replicas elect the replica signing each tree.
+// A single replica signs all trees without the election.
+func ElectionEnabled(instance *v1alpha1.Trillian) bool {
+	return SignerReplicas(instance) > 1
+}
+
+// ElectionServerEnabled reports whether the etcd server is deployed, it runs the election and stores the quota token buckets.
+func ElectionServerEnabled(instance *v1alpha1.Trillian) bool {
+	return ElectionEnabled(instance) || QuotaBucketsEnabled(instance)
+}
+
+// ElectionReplicas is the number of etcd members, the election and the quota token buckets survive the loss of one member.
+const ElectionReplicas = 3
+
+// ElectionPeersService returns the name of the headless Service resolving the etcd members.
+func ElectionPeersService(electionService string) string {
+	return electionService + "-peers"
+}
+
+// ElectionCASecret returns the name of the Secret with the CA issuing the etcd certificates.
+func ElectionCASecret(electionService string) string {
+	return electionService + "-ca"
+}
+
+// ElectionTLSSecret returns the name of the Secret with the certificate of the etcd members and the CA certificate.
+func ElectionTLSSecret(electionService string) string {
+	return electionService + "-tls"
+}
+
+// ElectionDNSNames returns the DNS names of the etcd members and the client Service.
+func ElectionDNSNames(electionService, namespace string) []string {
+	peers := ElectionPeersService(electionService)
+	return append(ServiceDNSNames(electionService, namespace),
+		fmt.Sprintf("*.%s.%s.svc", peers, namespace),
+		fmt.Sprintf("*.%s.%s.svc.cluster.local", peers, namespace),
+	)
+}
+
+// CreateElectionStatefulSet creates the etcd cluster running the election of the Logsigner replicas and storing the quota token buckets.
+// The members keep their data on persistent volumes, the client and peer connections are secured by TLS.
+func CreateElectionStatefulSet(instance *v1alpha1.Trillian, name string, sa string, labels map[string]string) *apps.StatefulSet {
+	replicas := int32(ElectionReplicas)
+	peers := ElectionPeersService(name)
+	members := make([]string, 0, ElectionReplicas)
+	for i := 0; i < ElectionReplicas; i++ {
+		members = append(members, fmt.Sprintf("%s-%d=https://%s-%d.%s.%s.svc:%d", name, i, name, i, peers, instance.Namespace, ElectionPeerPort))
+	}
+	member := fmt.Sprintf("$(POD_NAME).%s.%s.svc", peers, instance.Namespace)
+	return &apps.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: instance.Namespace,
+			Labels:    labels,
+		},
+		Spec: apps.StatefulSetSpec{
+			Replicas:    &replicas,
+			ServiceName: peers,
+			// all members have to start to form the cluster
+			PodManagementPolicy: apps.ParallelPodManagement,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			Template: core.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: core.PodSpec{
+					ServiceAccountName: sa,
+					Affinity: &core.Affinity{
+						PodAntiAffinity: &core.PodAntiAffinity{
+							PreferredDuringSchedulingIgnoredDuringExecution: []core.WeightedPodAffinityTerm{
+								{
+									Weight: 100,
+									PodAffinityTerm: core.PodAffinityTerm{
+										LabelSelector: &metav1.LabelSelector{MatchLabels: labels},
+										TopologyKey:   core.LabelHostname,
+									},
+								},
+							},
+						},
+					},
+					Volumes: []core.Volume{
+						{
+							Name: "tls",
+							VolumeSource: core.VolumeSource{
+								Secret: &core.SecretVolumeSource{SecretName: ElectionTLSSecret(name)},
+							},
+						},
+					},
+					Containers: []core.Container{
+						{
+							Name:  name,
+							Image: constants.TrillianElectionImage,
+							Command: []string{
+								"etcd",
+								"--name=$(POD_NAME)",
+								"--data-dir=/var/lib/etcd",
+								fmt.Sprintf("--listen-client-urls=https://0.0.0.0:%d", ElectionPort),
+								fmt.Sprintf("--advertise-client-urls=https://%s:%d", member, ElectionPort),
+								fmt.Sprintf("--listen-peer-urls=https://0.0.0.0:%d", ElectionPeerPort),
+								fmt.Sprintf("--initial-advertise-peer-urls=https://%s:%d", member, ElectionPeerPort),
+								fmt.Sprintf("--listen-metrics-urls=http://0.0.0.0:%d", ElectionMetricsPort),
+								"--initial-cluster=" + strings.Join(members, ","),
+								"--initial-cluster-token=" + name,
+								"--initial-cluster-state=new",
+								"--cert-file=/etc/etcd/tls/" + core.TLSCertKey,
+								"--key-file=/etc/etcd/tls/" + core.TLSPrivateKeyKey,
+								"--peer-cert-file=/etc/etcd/tls/" + core.TLSCertKey,
+								"--peer-key-file=/etc/etcd/tls/" + core.TLSPrivateKeyKey,
+								"--peer-trusted-ca-file=/etc/etcd/tls/ca.crt",
+								"--peer-client-cert-auth",
+							},
+							Env: []core.EnvVar{
+								{
+									Name: "POD_NAME",
+									ValueFrom: &core.EnvVarSource{
+										FieldRef: &core.ObjectFieldSelector{FieldPath: "metadata.name"},
+									},
+								},
+							},
+							Ports: []core.ContainerPort{
+								{
+									Name:          "client",
+									Protocol:      core.ProtocolTCP,
+									ContainerPort: ElectionPort,
+								},
+								{
+									Name:          "peer",
+									Protocol:      core.ProtocolTCP,
+									ContainerPort: ElectionPeerPort,
+								},
+								{
+									Name:          "metrics",
+									Protocol:      core.ProtocolTCP,
+									ContainerPort: ElectionMetricsPort,
+								},
+							},
+							ReadinessProbe: &core.Probe{
+								ProbeHandler: core.ProbeHandler{
+									HTTPGet: &core.HTTPGetAction{
+										Path: "/health",
+										Port: intstr.FromInt(ElectionMetricsPort),
+									},
+								},
+								InitialDelaySeconds: 5,
+								PeriodSeconds:       10,
+							},
+							VolumeMounts: []core.VolumeMount{
+								{
+									Name:      "data",
+									MountPath: "/var/lib/etcd",
+								},
+								{
+									Name:      "tls",
+									MountPath: "/etc/etcd/tls",
+									ReadOnly:  true,
+								},
+							},
+						},
+					},
+				},
+			},
+			VolumeClaimTemplates: []core.PersistentVolumeClaim{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "data",
+						Labels: labels,
+					},
+					Spec: core.PersistentVolumeClaimSpec{
+						AccessModes: []core.PersistentVolumeAccessMode{core.ReadWriteOnce},
+						Resources: core.ResourceRequirements{
+							Requests: core.ResourceList{core.ResourceStorage: resource.MustParse(electionStorageSize)},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// CreateElectionPeersService creates the headless Service resolving the etcd members, the members are resolved before they are ready
+// so they can form the cluster.
+func CreateElectionPeersService(namespace string, electionService string, labels map[string]string) *core.Service {
+	return &core.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ElectionPeersService(electionService),
+			Namespace: namespace,
+			Labels:    labels,
+		},
+		Spec: core.ServiceSpec{
+			ClusterIP:                core.ClusterIPNone,
+			PublishNotReadyAddresses: true,
+			Selector:                 labels,
+			Ports: []core.ServicePort{
+				{
+					Name:       "peer",
+					Protocol:   core.ProtocolTCP,
+					Port:       ElectionPeerPort,
+					TargetPort: intstr.FromInt(ElectionPeerPort),
+				},
+			},
+		},
+	}
+}
+
+// trustElectionCA mounts the CA certificate of the etcd cluster to the Trillian container. The etcd client of Trillian
+// verifies the server certificate against system CA certificates, SSL_CERT_DIR adds the mounted one.
+func trustElectionCA(dp *apps.Deployment, electionService string) {
+	pod := &dp.Spec.Template.Spec
+	if slices.ContainsFunc(pod.Volumes, func(v core.Volume) bool { return v.Name == electionCAVolume }) {
+		return
+	}
+	pod.Volumes = append(pod.Volumes, core.Volume{
+		Name: electionCAVolume,
+		VolumeSource: core.VolumeSource{
+			Secret: &core.SecretVolumeSource{
+				SecretName: ElectionTLSSecret(electionService),
+				Items:      []core.KeyToPath{{Key: "ca.crt", Path: "ca.crt"}},
+			},
+		},
+	})
+	container := &pod.Containers[0]
+	container.VolumeMounts = append(container.VolumeMounts, core.VolumeMount{
+		Name:      electionCAVolume,
+		MountPath: electionCAMountPath,
+		ReadOnly:  true,
+	})
+	container.Env = append(container.Env, core.EnvVar{Name: "SSL_CERT_DIR", Value: electionCAMountPath})
+}
+
+// UseElection configures the Logsigner replicas to elect the replica signing each tree on the etcd server.
+// The replicas are spread across nodes so a node loss does not stop the signing.
+func UseElection(dp *apps.Deployment, instance *v1alpha1.Trillian, electionService string) {
+	replicas := SignerReplicas(instance)
+	dp.Spec.Replicas = &replicas
+	container := &dp.Spec.Template.Spec.Containers[0]
+	if !ElectionEnabled(instance) {
+		container.Args = append(container.Args, "--force_master=true")
+		return
+	}
+	container.Args = append(container.Args,
+		etcdServersArg(electionService),
+		"--lock_file_path=/trillian/"+instance.Name+"/logsigner",
+	)
+	trustElectionCA(dp, electionService)
+	dp.Spec.Template.Spec.Affinity = &core.Affinity{
+		PodAntiAffinity: &core.PodAntiAffinity{
+			PreferredDuringSchedulingIgnoredDuringExecution: []core.WeightedPodAffinityTerm{
+				{
+					Weight: 100,
+					PodAffinityTerm: core.PodAffinityTerm{
+						LabelSelector: &metav1.LabelSelector{MatchLabels: dp.Spec.Selector.MatchLabels},
+						TopologyKey:   core.LabelHostname,
+					},
+				},
+			},
+		},
+	}
+}
+
+// LeadingTrees parses the metrics of the Logsigner replica and returns the trees signed by the replica.
+func LeadingTrees(metrics io.Reader) ([]int64, error) {
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(metrics)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse logsigner metrics: %w", err)
+	}
+	family, ok := families[isMasterMetric]
+	if !ok {
+		return nil, nil
+	}
+	var trees []int64
+	for _, m := range family.GetMetric() {
+		if m.GetGauge().GetValue() != 1 {
+			continue
+		}
+		for _, l := range m.GetLabel() {
+			if l.GetName() != logIDLabel {
+				continue
+			}
+			id, err := strconv.ParseInt(l.GetValue(), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid tree ID %q: %w", l.GetValue(), err)
+			}
+			trees = append(trees, id)
+		}
+	}
+	return trees, nil
+}
+
+// SignerLeaders returns the status of the replicas signing the trees ordered by the tree ID.
+// A tree claimed by several replicas while the leader changes is reported with the first replica.
+func SignerLeaders(trees map[string][]int64) []v1alpha1.TrillianTreeLeader {
+	leaders := make(map[int64]string)
+	for pod, ids := range trees {
+		for _, id := range ids {
+			if current, ok := leaders[id]; !ok || pod < current {
+				leaders[id] = pod
+			}
+		}
+	}
+	var status []v1alpha1.TrillianTreeLeader
+	for id, pod := range leaders {
+		status = append(status, v1alpha1.TrillianTreeLeader{TreeID: id, Pod: pod})
+	}
+	slices.SortFunc(status, func(a, b v1alpha1.TrillianTreeLeader) int {
+		return cmp.Compare(a.TreeID, b.TreeID)
+	})
+	return status
+}
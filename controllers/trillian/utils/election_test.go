@@ -0,0 +1,103 @@
+package trillianUtils
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/utils"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestUseElection(t *testing.T) {
+	g := NewWithT(t)
+
+	instance := &v1alpha1.Trillian{
+		ObjectMeta: metav1.ObjectMeta{Name: "trillian", Namespace: "default"},
+		Status: v1alpha1.TrillianStatus{
+			Db: v1alpha1.TrillianDB{DatabaseSecretRef: &v1alpha1.LocalObjectReference{Name: "db"}},
+		},
+	}
+	dp, err := CreateTrillDeployment(instance, "image", "trillian-logsigner", "sa", map[string]string{"app": "signer"})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	UseElection(dp, instance, "trillian-election")
+	g.Expect(*dp.Spec.Replicas).Should(BeNumerically("==", 1))
+	g.Expect(dp.Spec.Template.Spec.Containers[0].Args).Should(ContainElement("--force_master=true"))
+	g.Expect(dp.Spec.Template.Spec.Affinity).Should(BeNil())
+
+	instance.Spec.LogSigner.Replicas = utils.Pointer(int32(3))
+	dp, err = CreateTrillDeployment(instance, "image", "trillian-logsigner", "sa", map[string]string{"app": "signer"})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	UseElection(dp, instance, "trillian-election")
+	g.Expect(*dp.Spec.Replicas).Should(BeNumerically("==", 3))
+	args := dp.Spec.Template.Spec.Containers[0].Args
+	g.Expect(args).ShouldNot(ContainElement("--force_master=true"))
+	g.Expect(args).Should(ContainElements("--etcd_servers=https://trillian-election:2379", "--lock_file_path=/trillian/trillian/logsigner"))
+	g.Expect(dp.Spec.Template.Spec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution).
+		Should(ContainElement(HaveField("PodAffinityTerm.LabelSelector.MatchLabels", HaveKeyWithValue("app", "signer"))))
+	g.Expect(dp.Spec.Template.Spec.Volumes).Should(ContainElement(HaveField("Secret.SecretName", "trillian-election-tls")))
+	g.Expect(dp.Spec.Template.Spec.Containers[0].Env).Should(ContainElement(core.EnvVar{Name: "SSL_CERT_DIR", Value: electionCAMountPath}))
+}
+
+func TestCreateElectionStatefulSet(t *testing.T) {
+	g := NewWithT(t)
+
+	instance := &v1alpha1.Trillian{ObjectMeta: metav1.ObjectMeta{Name: "trillian", Namespace: "default"}}
+	sts := CreateElectionStatefulSet(instance, "trillian-election", "sa", map[string]string{"app": "election"})
+	g.Expect(*sts.Spec.Replicas).Should(BeNumerically("==", ElectionReplicas))
+	g.Expect(sts.Spec.ServiceName).Should(Equal("trillian-election-peers"))
+	g.Expect(sts.Spec.VolumeClaimTemplates).Should(ConsistOf(HaveField("Name", "data")))
+	g.Expect(sts.Spec.Template.Spec.Containers[0].Command).Should(ContainElements(
+		"--listen-client-urls=https://0.0.0.0:2379",
+		"--initial-cluster="+
+			"trillian-election-0=https://trillian-election-0.trillian-election-peers.default.svc:2380,"+
+			"trillian-election-1=https://trillian-election-1.trillian-election-peers.default.svc:2380,"+
+			"trillian-election-2=https://trillian-election-2.trillian-election-peers.default.svc:2380",
+		"--peer-client-cert-auth",
+	))
+
+	peers := CreateElectionPeersService("default", "trillian-election", map[string]string{"app": "election"})
+	g.Expect(peers.Spec.ClusterIP).Should(Equal(core.ClusterIPNone))
+	g.Expect(peers.Spec.PublishNotReadyAddresses).Should(BeTrue())
+
+	// the member certificate covers every member
+	g.Expect(ElectionDNSNames("trillian-election", "default")).Should(ContainElement("*.trillian-election-peers.default.svc"))
+}
+
+func TestLeadingTrees(t *testing.T) {
+	g := NewWithT(t)
+
+	metrics := `# HELP is_master Whether this instance is master (0/1)
+# TYPE is_master gauge
+is_master{logid="1"} 1
+is_master{logid="2"} 0
+is_master{logid="3"} 1
+# HELP known_logs Set to 1 for known logs (whether this instance is master or not)
+# TYPE known_logs gauge
+known_logs{logid="1"} 1
+`
+	trees, err := LeadingTrees(strings.NewReader(metrics))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(trees).Should(ConsistOf(int64(1), int64(3)))
+
+	trees, err = LeadingTrees(strings.NewReader("# TYPE other gauge\nother 1\n"))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(trees).Should(BeEmpty())
+}
+
+func TestSignerLeaders(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(SignerLeaders(map[string][]int64{"signer-b": nil})).Should(BeEmpty())
+	g.Expect(SignerLeaders(map[string][]int64{
+		"signer-b": {9007199254740993, 1},
+		"signer-a": {1},
+		"signer-c": {2},
+	})).Should(Equal([]v1alpha1.TrillianTreeLeader{
+		{TreeID: 1, Pod: "signer-a"},
+		{TreeID: 2, Pod: "signer-c"},
+		{TreeID: 9007199254740993, Pod: "signer-b"},
+	}))
+}
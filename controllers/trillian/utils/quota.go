@@ -0,0 +1,126 @@
+package trillianUtils
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/trillian/quota/etcd/quotapb"
+	"github.com/securesign/operator/api/v1alpha1"
+	"google.golang.org/protobuf/proto"
+	apps "k8s.io/api/apps/v1"
+)
+
+const (
+	quotaSystemArg = "--quota_system="
+
+	quotaRead  = "read"
+	quotaWrite = "write"
+)
+
+// QuotaBucketsEnabled reports whether any token bucket is configured.
+// Token buckets are enforced by the etcd quota system instead of the database one.
+func QuotaBucketsEnabled(instance *v1alpha1.Trillian) bool {
+	q := instance.Spec.Quota
+	return q.Global != nil || len(q.Trees) > 0 || len(q.Users) > 0
+}
+
+// UseQuota configures the quota system of the Logserver and Logsigner.
+// The token buckets are stored on the etcd server, the database quota system limits the entries waiting for the Logsigner.
+func UseQuota(dp *apps.Deployment, instance *v1alpha1.Trillian, electionService string) {
+	container := &dp.Spec.Template.Spec.Containers[0]
+	if QuotaBucketsEnabled(instance) {
+		container.Args = slices.DeleteFunc(container.Args, func(arg string) bool {
+			return strings.HasPrefix(arg, quotaSystemArg)
+		})
+		container.Args = append(container.Args, quotaSystemArg+"etcd")
+		if servers := etcdServersArg(electionService); !slices.Contains(container.Args, servers) {
+			container.Args = append(container.Args, servers)
+		}
+		trustElectionCA(dp, electionService)
+		return
+	}
+	if rows := instance.Spec.Quota.MaxUnsequencedRows; rows != nil {
+		container.Args = append(container.Args, fmt.Sprintf("--max_unsequenced_rows=%d", *rows))
+	}
+}
+
+// QuotaConfigs returns the token buckets of the Trillian quota API ordered by the name.
+func QuotaConfigs(quota v1alpha1.TrillianQuota) []*quotapb.Config {
+	var configs []*quotapb.Config
+	add := func(prefix string, buckets v1alpha1.TrillianQuotaBuckets) {
+		if buckets.Read != nil {
+			configs = append(configs, quotaConfig(prefix+quotaRead, buckets.Read))
+		}
+		if buckets.Write != nil {
+			configs = append(configs, quotaConfig(prefix+quotaWrite, buckets.Write))
+		}
+	}
+	if quota.Global != nil {
+		add("quotas/global/", *quota.Global)
+	}
+	for _, t := range quota.Trees {
+		add("quotas/trees/"+strconv.FormatInt(t.TreeID, 10)+"/", t.TrillianQuotaBuckets)
+	}
+	for _, u := range quota.Users {
+		add("quotas/users/"+u.User+"/", u.TrillianQuotaBuckets)
+	}
+	slices.SortFunc(configs, func(a, b *quotapb.Config) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return configs
+}
+
+func quotaConfig(name string, bucket *v1alpha1.TrillianTokenBucket) *quotapb.Config {
+	config := &quotapb.Config{
+		Name:      name + "/config",
+		State:     quotapb.Config_ENABLED,
+		MaxTokens: bucket.MaxTokens,
+	}
+	if bucket.ReplenishTokens != nil && bucket.ReplenishIntervalSeconds != nil {
+		config.ReplenishmentStrategy = &quotapb.Config_TimeBased{
+			TimeBased: &quotapb.TimeBasedStrategy{
+				TokensToReplenish:        *bucket.ReplenishTokens,
+				ReplenishIntervalSeconds: *bucket.ReplenishIntervalSeconds,
+			},
+		}
+	} else {
+		config.ReplenishmentStrategy = &quotapb.Config_SequencingBased{
+			SequencingBased: &quotapb.SequencingBasedStrategy{},
+		}
+	}
+	return config
+}
+
+// QuotaChanges compares the token buckets known to the quota API with the desired ones.
+// It returns the buckets to create, the buckets to update and the names of the buckets to delete.
+func QuotaChanges(current []*quotapb.Config, desired []*quotapb.Config) (create []*quotapb.Config, update []*quotapb.Config, remove []string) {
+	known := make(map[string]*quotapb.Config, len(current))
+	for _, c := range current {
+		// the current number of tokens is not a part of the configuration
+		c = proto.Clone(c).(*quotapb.Config)
+		c.CurrentTokens = 0
+		known[c.Name] = c
+	}
+	for _, d := range desired {
+		c, ok := known[d.Name]
+		switch {
+		case !ok:
+			create = append(create, d)
+		case !proto.Equal(c, d):
+			update = append(update, d)
+		}
+		delete(known, d.Name)
+	}
+	for name := range known {
+		remove = append(remove, name)
+	}
+	slices.Sort(remove)
+	return create, update, remove
+}
+
+func etcdServersArg(electionService string) string {
+	return fmt.Sprintf("--etcd_servers=https://%s:%d", electionService, ElectionPort)
+}
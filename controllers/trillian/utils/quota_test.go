@@ -0,0 +1,128 @@
+package trillianUtils
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/trillian/quota/etcd/quotapb"
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestUseQuota(t *testing.T) {
+	g := NewWithT(t)
+
+	instance := &v1alpha1.Trillian{
+		ObjectMeta: metav1.ObjectMeta{Name: "trillian", Namespace: "default"},
+		Spec: v1alpha1.TrillianSpec{
+			Quota: v1alpha1.TrillianQuota{MaxUnsequencedRows: utils.Pointer(int64(1000))},
+		},
+		Status: v1alpha1.TrillianStatus{
+			Db: v1alpha1.TrillianDB{DatabaseSecretRef: &v1alpha1.LocalObjectReference{Name: "db"}},
+		},
+	}
+	dp, err := CreateTrillDeployment(instance, "image", "trillian-logserver", "sa", map[string]string{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	UseQuota(dp, instance, "trillian-election")
+	args := dp.Spec.Template.Spec.Containers[0].Args
+	g.Expect(args).Should(ContainElements("--quota_system=mysql", "--max_unsequenced_rows=1000"))
+	g.Expect(args).ShouldNot(ContainElement(HavePrefix("--etcd_servers")))
+
+	instance.Spec.LogSigner.Replicas = utils.Pointer(int32(2))
+	instance.Spec.Quota.Global = &v1alpha1.TrillianQuotaBuckets{Write: &v1alpha1.TrillianTokenBucket{MaxTokens: 100}}
+	dp, err = CreateTrillDeployment(instance, "image", "trillian-logsigner", "sa", map[string]string{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	UseElection(dp, instance, "trillian-election")
+	UseQuota(dp, instance, "trillian-election")
+	args = dp.Spec.Template.Spec.Containers[0].Args
+	g.Expect(args).Should(ContainElement("--quota_system=etcd"))
+	g.Expect(args).ShouldNot(ContainElement("--quota_system=mysql"))
+	g.Expect(args).ShouldNot(ContainElement("--max_unsequenced_rows=1000"))
+	// the etcd server is shared by the election and the quotas
+	g.Expect(slices.DeleteFunc(slices.Clone(args), func(arg string) bool {
+		return !strings.HasPrefix(arg, "--etcd_servers")
+	})).Should(Equal([]string{"--etcd_servers=https://trillian-election:2379"}))
+	g.Expect(dp.Spec.Template.Spec.Volumes).Should(HaveLen(1))
+	g.Expect(dp.Spec.Template.Spec.Containers[0].Env).Should(ContainElement(HaveField("Name", "SSL_CERT_DIR")))
+}
+
+func TestQuotaConfigs(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(QuotaConfigs(v1alpha1.TrillianQuota{})).Should(BeEmpty())
+
+	configs := QuotaConfigs(v1alpha1.TrillianQuota{
+		Global: &v1alpha1.TrillianQuotaBuckets{
+			Write: &v1alpha1.TrillianTokenBucket{MaxTokens: 288000},
+		},
+		Trees: []v1alpha1.TrillianTreeQuota{
+			{TreeID: 42, TrillianQuotaBuckets: v1alpha1.TrillianQuotaBuckets{
+				Read: &v1alpha1.TrillianTokenBucket{MaxTokens: 1000, ReplenishTokens: utils.Pointer(int64(100)), ReplenishIntervalSeconds: utils.Pointer(int64(1))},
+			}},
+		},
+		Users: []v1alpha1.TrillianUserQuota{
+			{User: "rekor", TrillianQuotaBuckets: v1alpha1.TrillianQuotaBuckets{
+				Write: &v1alpha1.TrillianTokenBucket{MaxTokens: 100, ReplenishTokens: utils.Pointer(int64(10)), ReplenishIntervalSeconds: utils.Pointer(int64(60))},
+			}},
+		},
+	})
+	g.Expect(configs).Should(HaveLen(3))
+	g.Expect(configs[0].Name).Should(Equal("quotas/global/write/config"))
+	g.Expect(configs[0].GetSequencingBased()).ShouldNot(BeNil())
+	g.Expect(configs[0].MaxTokens).Should(BeNumerically("==", 288000))
+	g.Expect(configs[1].Name).Should(Equal("quotas/trees/42/read/config"))
+	g.Expect(configs[1].GetTimeBased().GetTokensToReplenish()).Should(BeNumerically("==", 100))
+	g.Expect(configs[1].GetTimeBased().GetReplenishIntervalSeconds()).Should(BeNumerically("==", 1))
+	g.Expect(configs[2].Name).Should(Equal("quotas/users/rekor/write/config"))
+	g.Expect(configs[2].State).Should(Equal(quotapb.Config_ENABLED))
+}
+
+func TestQuotaChanges(t *testing.T) {
+	g := NewWithT(t)
+
+	desired := QuotaConfigs(v1alpha1.TrillianQuota{
+		Global: &v1alpha1.TrillianQuotaBuckets{
+			Read:  &v1alpha1.TrillianTokenBucket{MaxTokens: 100, ReplenishTokens: utils.Pointer(int64(10)), ReplenishIntervalSeconds: utils.Pointer(int64(1))},
+			Write: &v1alpha1.TrillianTokenBucket{MaxTokens: 1000},
+		},
+		Trees: []v1alpha1.TrillianTreeQuota{
+			{TreeID: 1, TrillianQuotaBuckets: v1alpha1.TrillianQuotaBuckets{Write: &v1alpha1.TrillianTokenBucket{MaxTokens: 50}}},
+		},
+	})
+
+	current := []*quotapb.Config{
+		{
+			Name:                  "quotas/global/read/config",
+			State:                 quotapb.Config_ENABLED,
+			MaxTokens:             100,
+			CurrentTokens:         42,
+			ReplenishmentStrategy: &quotapb.Config_TimeBased{TimeBased: &quotapb.TimeBasedStrategy{TokensToReplenish: 10, ReplenishIntervalSeconds: 1}},
+		},
+		{
+			Name:                  "quotas/global/write/config",
+			State:                 quotapb.Config_ENABLED,
+			MaxTokens:             500,
+			ReplenishmentStrategy: &quotapb.Config_SequencingBased{SequencingBased: &quotapb.SequencingBasedStrategy{}},
+		},
+		{
+			Name:                  "quotas/users/llama/read/config",
+			State:                 quotapb.Config_ENABLED,
+			MaxTokens:             10,
+			ReplenishmentStrategy: &quotapb.Config_TimeBased{TimeBased: &quotapb.TimeBasedStrategy{TokensToReplenish: 1, ReplenishIntervalSeconds: 1}},
+		},
+	}
+
+	create, update, remove := QuotaChanges(current, desired)
+	g.Expect(create).Should(ConsistOf(HaveField("Name", "quotas/trees/1/write/config")))
+	g.Expect(update).Should(ConsistOf(HaveField("MaxTokens", BeNumerically("==", 1000))))
+	g.Expect(remove).Should(Equal([]string{"quotas/users/llama/read/config"}))
+	g.Expect(current[0].CurrentTokens).Should(BeNumerically("==", 42))
+
+	create, update, remove = QuotaChanges(desired, desired)
+	g.Expect(create).Should(BeEmpty())
+	g.Expect(update).Should(BeEmpty())
+	g.Expect(remove).Should(BeEmpty())
+}
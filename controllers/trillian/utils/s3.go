@@ -0,0 +1,52 @@
+package trillianUtils
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/securesign/operator/api/v1alpha1"
+)
+
+const s3RequestTimeout = 30 * time.Second
+
+// DeleteS3Object deletes the object holding the dump, missing objects are ignored.
+func DeleteS3Object(ctx context.Context, target *v1alpha1.S3Target, credentials map[string][]byte, dump string) error {
+	client, err := newS3Client(target, credentials)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s3RequestTimeout)
+	defer cancel()
+	err = client.RemoveObject(ctx, target.Bucket, target.Prefix+dump+DumpExtension, minio.RemoveObjectOptions{})
+	if err != nil && minio.ToErrorResponse(err).Code != "NoSuchKey" {
+		return fmt.Errorf("could not delete dump %s: %w", dump, err)
+	}
+	return nil
+}
+
+// newS3Client returns the client of the S3-compatible endpoint, the bucket is addressed by path like in the backup Jobs.
+func newS3Client(target *v1alpha1.S3Target, data map[string][]byte) (*minio.Client, error) {
+	accessKey, secretKey := string(data[S3AccessKeyIDKey]), string(data[S3SecretAccessKeyKey])
+	if accessKey == "" || secretKey == "" {
+		return nil, fmt.Errorf("S3 credentials secret must contain %s and %s", S3AccessKeyIDKey, S3SecretAccessKeyKey)
+	}
+	endpoint, err := url.Parse(target.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid S3 endpoint: %w", err)
+	}
+	if strings.Trim(endpoint.Path, "/") != "" {
+		return nil, fmt.Errorf("S3 endpoint %s must not contain a path", target.Endpoint)
+	}
+	return minio.New(endpoint.Host, &minio.Options{
+		Creds:        credentials.NewStaticV4(accessKey, secretKey, ""),
+		Secure:       endpoint.Scheme == "https",
+		Region:       S3Region(target),
+		BucketLookup: minio.BucketLookupPath,
+	})
+}
@@ -0,0 +1,44 @@
+package trillianUtils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+)
+
+func TestDeleteS3Object(t *testing.T) {
+	g := NewWithT(t)
+
+	var requests []*http.Request
+	status := http.StatusNoContent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	target := &v1alpha1.S3Target{Endpoint: server.URL, Bucket: "bucket", Prefix: "trillian/", Region: "eu-west-1"}
+	credentials := map[string][]byte{S3AccessKeyIDKey: []byte("key"), S3SecretAccessKeyKey: []byte("secret")}
+
+	g.Expect(DeleteS3Object(context.TODO(), target, credentials, "dump")).To(Succeed())
+	g.Expect(requests).To(HaveLen(1))
+	g.Expect(requests[0].Method).To(Equal(http.MethodDelete))
+	g.Expect(requests[0].URL.Path).To(Equal("/bucket/trillian/dump.sql.gz"))
+	g.Expect(requests[0].Header.Get("Authorization")).To(MatchRegexp(
+		`^AWS4-HMAC-SHA256 Credential=key/\d{8}/eu-west-1/s3/aws4_request, SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=[0-9a-f]{64}$`))
+
+	status = http.StatusNotFound
+	g.Expect(DeleteS3Object(context.TODO(), target, credentials, "dump")).To(Succeed())
+
+	status = http.StatusForbidden
+	g.Expect(DeleteS3Object(context.TODO(), target, credentials, "dump")).To(MatchError(ContainSubstring("Access Denied")))
+
+	g.Expect(DeleteS3Object(context.TODO(), target, map[string][]byte{}, "dump")).To(MatchError(ContainSubstring(S3AccessKeyIDKey)))
+
+	target.Endpoint = server.URL + "/s3"
+	g.Expect(DeleteS3Object(context.TODO(), target, credentials, "dump")).To(MatchError(ContainSubstring("must not contain a path")))
+}
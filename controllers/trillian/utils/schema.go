@@ -0,0 +1,163 @@
+package trillianUtils
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/utils/kubernetes"
+	"golang.org/x/mod/semver"
+	batchv1 "k8s.io/api/batch/v1"
+	core "k8s.io/api/core/v1"
+)
+
+const (
+	SchemaScriptKey = "storage.sql"
+
+	schemaVolume    = "schema"
+	schemaMountPath = "/var/run/trillian-schema"
+)
+
+// mysqlSchema is copied from https://github.com/google/trillian/blob/v1.6.0/storage/mysql/schema/storage.sql
+// It is the schema of the first migration, all statements are idempotent so the script is safe to run against a provisioned database.
+//
+//go:embed schema/storage.sql
+var mysqlSchema string
+
+type migration struct {
+	// Version of the schema after the migration
+	Version string
+	// Statements altering the schema of the previous version
+	Statements string
+}
+
+// migrations are ordered by the version, the v1.6.0 schema is the first one managed by the Operator.
+var migrations = []migration{
+	{Version: "v1.6.0"},
+}
+
+// operandVersions maps the digests of the released Trillian images to the Trillian release they are built from.
+var operandVersions = map[string]string{
+	// registry.redhat.io/rhtas/trillian-logserver-rhel9
+	"sha256:4478e867e59b5c2d7a4e2630f76fad7899205de611a6f4648d9ca7389392780d": "v1.6.0",
+	// registry.redhat.io/rhtas/trillian-logsigner-rhel9
+	"sha256:920f2fd735525dd612546a874e24d301761ca83c79ddb6898ee7d31470ffc467": "v1.6.0",
+}
+
+// OperandVersion returns the Trillian release of the image. The semantic version tag of the reference takes precedence
+// over the known digests, the empty string is returned when the release is unknown.
+func OperandVersion(image string) string {
+	name, digest, _ := strings.Cut(image, "@")
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		tag := name[i+1:]
+		if !strings.HasPrefix(tag, "v") {
+			tag = "v" + tag
+		}
+		if semver.IsValid(tag) {
+			return semver.Canonical(tag)
+		}
+	}
+	return operandVersions[digest]
+}
+
+// SchemaVersion returns the version of the schema required by the Trillian image, that is the latest migration not newer than
+// the release of the image. Images of an unknown release get the latest schema.
+func SchemaVersion(image string) string {
+	version := OperandVersion(image)
+	target := migrations[0].Version
+	for _, m := range migrations {
+		if version != "" && semver.Compare(m.Version, version) > 0 {
+			break
+		}
+		target = m.Version
+	}
+	return target
+}
+
+// SchemaScript returns the SQL script upgrading the schema of the current version to the target one.
+// The empty current version means that the schema is provisioned by the script.
+func SchemaScript(current string, target string) string {
+	var script strings.Builder
+	if current == "" {
+		script.WriteString(mysqlSchema)
+		current = migrations[0].Version
+	}
+	for _, m := range migrations {
+		if semver.Compare(m.Version, current) > 0 && semver.Compare(m.Version, target) <= 0 {
+			fmt.Fprintf(&script, "-- migration to %s\n%s\n", m.Version, m.Statements)
+		}
+	}
+	return script.String()
+}
+
+// SchemaResourceName returns the name of the ConfigMap and the Job provisioning the schema of the version.
+func SchemaResourceName(version string) string {
+	return "trillian-schema-" + strings.NewReplacer(".", "-", "+", "-").Replace(strings.TrimPrefix(version, "v"))
+}
+
+// CreateSchemaJob creates the Job running the schema script from the ConfigMap against the Trillian database.
+func CreateSchemaJob(instance *v1alpha1.Trillian, image string, name string, sa string, labels map[string]string) (*batchv1.Job, error) {
+	if instance.Status.Db.DatabaseSecretRef == nil {
+		return nil, errors.New("reference to database secret is not set")
+	}
+	job := kubernetes.CreateJob(instance.Namespace, name, labels, image, sa, 1, 1, 600, 4,
+		[]string{"sh", "-c", `exec mysql "$@" < ` + schemaMountPath + "/" + SchemaScriptKey, "--"},
+		databaseEnv(instance.Status.Db.DatabaseSecretRef.Name))
+	job.Spec.Template.Labels = labels
+
+	pod := &job.Spec.Template.Spec
+	container := &pod.Containers[0]
+	pod.Volumes = append(pod.Volumes, core.Volume{
+		Name: schemaVolume,
+		VolumeSource: core.VolumeSource{
+			ConfigMap: &core.ConfigMapVolumeSource{
+				LocalObjectReference: core.LocalObjectReference{Name: name},
+			},
+		},
+	})
+	container.VolumeMounts = append(container.VolumeMounts, core.VolumeMount{
+		Name:      schemaVolume,
+		MountPath: schemaMountPath,
+		ReadOnly:  true,
+	})
+	container.Args = append(mysqlClientArgs(instance.Spec.Db, pod, container), "-D", "$(MYSQL_DATABASE)")
+	return job, nil
+}
+
+// mysqlClientArgs returns the connection arguments of the MySQL client tools, the CA certificate is mounted to the container.
+func mysqlClientArgs(db v1alpha1.TrillianDB, pod *core.PodSpec, container *core.Container) []string {
+	args := []string{
+		"-h", "$(MYSQL_HOSTNAME)",
+		"-P", "$(MYSQL_PORT)",
+		"-u", "$(MYSQL_USER)",
+		"-p$(MYSQL_PASSWORD)",
+	}
+	switch DBTLSMode(db) {
+	case DBTLSSkipVerify:
+		args = append(args, "--ssl")
+	case DBTLSVerifyFull:
+		args = append(args, "--ssl", "--ssl-verify-server-cert")
+		if ca := db.TLS.CACertRef; ca != nil {
+			args = append(args, "--ssl-ca="+mountDatabaseCA(pod, container, ca))
+		}
+	}
+	return args
+}
+
+// JobFinished returns whether the Job finished and the failure message when it did not succeed.
+func JobFinished(job *batchv1.Job) (bool, error) {
+	for _, c := range job.Status.Conditions {
+		if c.Status != core.ConditionTrue {
+			continue
+		}
+		switch c.Type {
+		case batchv1.JobComplete:
+			return true, nil
+		case batchv1.JobFailed:
+			return true, fmt.Errorf("job %s failed: %s", job.Name, c.Message)
+		}
+	}
+	return false, nil
+}
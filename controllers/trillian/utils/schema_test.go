@@ -0,0 +1,98 @@
+package trillianUtils
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+	batchv1 "k8s.io/api/batch/v1"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSchemaScript(t *testing.T) {
+	g := NewWithT(t)
+
+	defer func(m []migration) { migrations = m }(migrations)
+	migrations = []migration{
+		{Version: "v1.6.0"},
+		{Version: "v1.7.0", Statements: "ALTER TABLE Trees ADD COLUMN A INT;"},
+		{Version: "v1.8.0", Statements: "ALTER TABLE Trees ADD COLUMN B INT;"},
+	}
+
+	g.Expect(SchemaScript("", "v1.6.0")).Should(Equal(mysqlSchema))
+	g.Expect(SchemaScript("", "v1.8.0")).Should(And(HavePrefix(mysqlSchema), ContainSubstring("ADD COLUMN A"), ContainSubstring("ADD COLUMN B")))
+	g.Expect(SchemaScript("v1.8.0", "v1.8.0")).Should(BeEmpty())
+	script := SchemaScript("v1.7.0", "v1.8.0")
+	g.Expect(script).ShouldNot(ContainSubstring("ADD COLUMN A"))
+	g.Expect(script).Should(ContainSubstring("ADD COLUMN B"))
+	script = SchemaScript("v1.6.0", "v1.7.0")
+	g.Expect(script).Should(ContainSubstring("ADD COLUMN A"))
+	g.Expect(script).ShouldNot(ContainSubstring("ADD COLUMN B"))
+	g.Expect(mysqlSchema).Should(ContainSubstring("CREATE TABLE IF NOT EXISTS Trees"))
+}
+
+func TestSchemaVersion(t *testing.T) {
+	g := NewWithT(t)
+
+	defer func(m []migration) { migrations = m }(migrations)
+	migrations = []migration{{Version: "v1.6.0"}, {Version: "v1.6.2"}, {Version: "v1.7.0"}}
+
+	g.Expect(OperandVersion("registry.redhat.io/rhtas/trillian-logserver-rhel9@sha256:4478e867e59b5c2d7a4e2630f76fad7899205de611a6f4648d9ca7389392780d")).Should(Equal("v1.6.0"))
+	g.Expect(OperandVersion("localhost:5000/trillian/logserver:1.6.1")).Should(Equal("v1.6.1"))
+	g.Expect(OperandVersion("localhost:5000/trillian/logserver")).Should(BeEmpty())
+	g.Expect(OperandVersion("example.com/logserver:latest@sha256:0000")).Should(BeEmpty())
+
+	g.Expect(SchemaVersion("example.com/logserver:v1.5.0")).Should(Equal("v1.6.0"))
+	g.Expect(SchemaVersion("example.com/logserver:v1.6.1")).Should(Equal("v1.6.0"))
+	g.Expect(SchemaVersion("example.com/logserver:v1.6.2")).Should(Equal("v1.6.2"))
+	g.Expect(SchemaVersion("example.com/logserver:latest")).Should(Equal("v1.7.0"))
+}
+
+func TestCreateSchemaJob(t *testing.T) {
+	g := NewWithT(t)
+
+	instance := &v1alpha1.Trillian{
+		ObjectMeta: metav1.ObjectMeta{Name: "trillian", Namespace: "default"},
+		Status: v1alpha1.TrillianStatus{
+			Db: v1alpha1.TrillianDB{DatabaseSecretRef: &v1alpha1.LocalObjectReference{Name: "db"}},
+		},
+	}
+	name := SchemaResourceName("v1.6.0")
+	g.Expect(name).Should(Equal("trillian-schema-1-6-0"))
+
+	job, err := CreateSchemaJob(instance, "image", name, "sa", map[string]string{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	container := job.Spec.Template.Spec.Containers[0]
+	g.Expect(container.Args).ShouldNot(ContainElement("--ssl"))
+	g.Expect(container.Env).Should(HaveLen(5))
+	g.Expect(job.Spec.Template.Spec.Volumes).Should(ContainElement(HaveField("ConfigMap.Name", name)))
+
+	instance.Spec.Db.TLS.CACertRef = &v1alpha1.SecretKeySelector{
+		LocalObjectReference: v1alpha1.LocalObjectReference{Name: "db-ca"},
+		Key:                  "ca.crt",
+	}
+	job, err = CreateSchemaJob(instance, "image", name, "sa", map[string]string{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(job.Spec.Template.Spec.Containers[0].Args).Should(ContainElements(
+		"--ssl", "--ssl-verify-server-cert", "--ssl-ca=/var/run/secrets/db-ca/ca.crt"))
+}
+
+func TestJobFinished(t *testing.T) {
+	g := NewWithT(t)
+
+	job := &batchv1.Job{}
+	finished, err := JobFinished(job)
+	g.Expect(finished).Should(BeFalse())
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: core.ConditionTrue, Message: "BackoffLimitExceeded"}}
+	finished, err = JobFinished(job)
+	g.Expect(finished).Should(BeTrue())
+	g.Expect(err).Should(MatchError(ContainSubstring("BackoffLimitExceeded")))
+
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: core.ConditionTrue}}
+	finished, err = JobFinished(job)
+	g.Expect(finished).Should(BeTrue())
+	g.Expect(err).ShouldNot(HaveOccurred())
+}
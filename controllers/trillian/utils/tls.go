@@ -0,0 +1,152 @@
+package trillianUtils
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+)
+
+const (
+	caValidity          = 10 * 365 * 24 * time.Hour
+	certificateValidity = 365 * 24 * time.Hour
+	// certificates are re-issued when they are about to expire
+	renewBefore = 30 * 24 * time.Hour
+)
+
+// CreateCA generates self-signed CA certificate and private key of the internal CA in PEM format.
+func CreateCA(commonName string) (certPEM []byte, keyPEM []byte, err error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	serial, err := serialNumber()
+	if err != nil {
+		return nil, nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: commonName, Organization: []string{"Red Hat"}},
+		NotBefore:             time.Now().Add(-5 * time.Minute),
+		NotAfter:              time.Now().Add(caValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return encode(der, key)
+}
+
+// IssueServerCertificate issues serving certificate for dnsNames signed by the internal CA.
+func IssueServerCertificate(caCertPEM, caKeyPEM []byte, dnsNames []string) (certPEM []byte, keyPEM []byte, err error) {
+	return issueCertificate(caCertPEM, caKeyPEM, dnsNames, x509.ExtKeyUsageServerAuth)
+}
+
+// IssuePeerCertificate issues certificate for dnsNames signed by the CA, it serves connections and authenticates
+// to other members of the cluster.
+func IssuePeerCertificate(caCertPEM, caKeyPEM []byte, dnsNames []string) (certPEM []byte, keyPEM []byte, err error) {
+	return issueCertificate(caCertPEM, caKeyPEM, dnsNames, x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth)
+}
+
+func issueCertificate(caCertPEM, caKeyPEM []byte, dnsNames []string, usage ...x509.ExtKeyUsage) (certPEM []byte, keyPEM []byte, err error) {
+	if len(dnsNames) == 0 {
+		return nil, nil, errors.New("certificate must contain at least one DNS name")
+	}
+	caCert, err := parseCertificate(caCertPEM)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not parse CA certificate: %w", err)
+	}
+	caKey, err := cryptoutils.UnmarshalPEMToPrivateKey(caKeyPEM, cryptoutils.SkipPassword)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not parse CA private key: %w", err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	serial, err := serialNumber()
+	if err != nil {
+		return nil, nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: dnsNames[0]},
+		DNSNames:     dnsNames,
+		NotBefore:    time.Now().Add(-5 * time.Minute),
+		NotAfter:     time.Now().Add(certificateValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  usage,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, caCert, key.Public(), caKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return encode(der, key)
+}
+
+// CertificateIsValid checks that the certificate is issued by the CA for all dnsNames and it is not about to expire.
+func CertificateIsValid(certPEM, caCertPEM []byte, dnsNames []string) bool {
+	cert, err := parseCertificate(certPEM)
+	if err != nil {
+		return false
+	}
+	caCert, err := parseCertificate(caCertPEM)
+	if err != nil {
+		return false
+	}
+	if cert.CheckSignatureFrom(caCert) != nil || time.Now().Add(renewBefore).After(cert.NotAfter) {
+		return false
+	}
+	for _, name := range dnsNames {
+		if !slices.Contains(cert.DNSNames, name) {
+			return false
+		}
+	}
+	return true
+}
+
+// ServiceDNSNames returns DNS names of the service inside the cluster.
+func ServiceDNSNames(service, namespace string) []string {
+	return []string{
+		fmt.Sprintf("%s.%s.svc", service, namespace),
+		fmt.Sprintf("%s.%s.svc.cluster.local", service, namespace),
+		fmt.Sprintf("%s.%s", service, namespace),
+		service,
+	}
+}
+
+func parseCertificate(certPEM []byte) (*x509.Certificate, error) {
+	certs, err := cryptoutils.UnmarshalCertificatesFromPEM(certPEM)
+	if err != nil {
+		return nil, err
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("no certificate found")
+	}
+	return certs[0], nil
+}
+
+func encode(der []byte, key *ecdsa.PrivateKey) ([]byte, []byte, error) {
+	keyPEM, err := cryptoutils.MarshalPrivateKeyToPEM(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cryptoutils.PEMEncode(cryptoutils.CertificatePEMType, der), keyPEM, nil
+}
+
+func serialNumber() (*big.Int, error) {
+	return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+}
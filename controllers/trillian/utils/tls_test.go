@@ -0,0 +1,80 @@
+package trillianUtils
+
+import (
+	"crypto/x509"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+)
+
+func TestIssueServerCertificate(t *testing.T) {
+	g := NewWithT(t)
+
+	caCert, caKey, err := CreateCA("test-ca")
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	dnsNames := ServiceDNSNames("trillian-logserver", "default")
+	cert, key, err := IssueServerCertificate(caCert, caKey, dnsNames)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(key).ShouldNot(BeEmpty())
+	g.Expect(CertificateIsValid(cert, caCert, dnsNames)).Should(BeTrue())
+
+	certs, err := cryptoutils.UnmarshalCertificatesFromPEM(cert)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	pool := x509.NewCertPool()
+	g.Expect(pool.AppendCertsFromPEM(caCert)).Should(BeTrue())
+	_, err = certs[0].Verify(x509.VerifyOptions{DNSName: "trillian-logserver.default.svc", Roots: pool})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	// other service or other CA
+	g.Expect(CertificateIsValid(cert, caCert, ServiceDNSNames("trillian-logsigner", "default"))).Should(BeFalse())
+	otherCA, _, err := CreateCA("other-ca")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(CertificateIsValid(cert, otherCA, dnsNames)).Should(BeFalse())
+	g.Expect(CertificateIsValid(nil, caCert, dnsNames)).Should(BeFalse())
+}
+
+func TestIssuePeerCertificate(t *testing.T) {
+	g := NewWithT(t)
+
+	caCert, caKey, err := CreateCA("test-ca")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	dnsNames := ElectionDNSNames("trillian-election", "default")
+	cert, _, err := IssuePeerCertificate(caCert, caKey, dnsNames)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(CertificateIsValid(cert, caCert, dnsNames)).Should(BeTrue())
+
+	certs, err := cryptoutils.UnmarshalCertificatesFromPEM(cert)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(certs[0].ExtKeyUsage).Should(ConsistOf(x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth))
+	pool := x509.NewCertPool()
+	g.Expect(pool.AppendCertsFromPEM(caCert)).Should(BeTrue())
+	_, err = certs[0].Verify(x509.VerifyOptions{
+		DNSName:   "trillian-election-1.trillian-election-peers.default.svc",
+		Roots:     pool,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+}
+
+func TestUseTLS(t *testing.T) {
+	g := NewWithT(t)
+
+	dp := &apps.Deployment{}
+	dp.Spec.Template.Spec.Containers = []core.Container{{Name: "trillian"}}
+
+	UseTLS(dp, nil)
+	g.Expect(dp.Spec.Template.Spec.Containers[0].Args).Should(BeEmpty())
+
+	UseTLS(dp, &v1alpha1.LocalObjectReference{Name: "tls"})
+	g.Expect(dp.Spec.Template.Spec.Volumes).Should(HaveLen(1))
+	g.Expect(dp.Spec.Template.Spec.Volumes[0].Secret.SecretName).Should(Equal("tls"))
+	g.Expect(dp.Spec.Template.Spec.Containers[0].Args).Should(ConsistOf(
+		"--tls_cert_file=/var/run/secrets/tls/tls.crt",
+		"--tls_key_file=/var/run/secrets/tls/tls.key",
+	))
+}
@@ -0,0 +1,59 @@
+package trillianUtils
+
+import (
+	"slices"
+
+	"github.com/google/trillian"
+	"github.com/securesign/operator/api/v1alpha1"
+)
+
+// TreeStatus converts trees listed by the Logserver to the status of the Trillian CR ordered by the tree ID.
+// Owners map tree IDs to custom resources using them.
+func TreeStatus(trees []*trillian.Tree, owners map[int64]string) []v1alpha1.TrillianTree {
+	status := make([]v1alpha1.TrillianTree, 0, len(trees))
+	for _, tree := range trees {
+		if tree.Deleted {
+			continue
+		}
+		status = append(status, v1alpha1.TrillianTree{
+			TreeID:      tree.TreeId,
+			Type:        tree.TreeType.String(),
+			State:       tree.TreeState.String(),
+			DisplayName: tree.DisplayName,
+			Owner:       owners[tree.TreeId],
+		})
+	}
+	slices.SortFunc(status, func(a, b v1alpha1.TrillianTree) int {
+		switch {
+		case a.TreeID < b.TreeID:
+			return -1
+		case a.TreeID > b.TreeID:
+			return 1
+		}
+		return 0
+	})
+	return status
+}
+
+// TreeStateChanges returns desired states of trees which differ from the current ones.
+// Trees unknown to the Logserver are returned in missing.
+func TreeStateChanges(trees []*trillian.Tree, desired []v1alpha1.TrillianTreeState) (changes map[int64]trillian.TreeState, missing []int64) {
+	current := make(map[int64]trillian.TreeState, len(trees))
+	for _, tree := range trees {
+		if !tree.Deleted {
+			current[tree.TreeId] = tree.TreeState
+		}
+	}
+	changes = make(map[int64]trillian.TreeState)
+	for _, d := range desired {
+		state, ok := current[d.TreeID]
+		if !ok {
+			missing = append(missing, d.TreeID)
+			continue
+		}
+		if want := trillian.TreeState(trillian.TreeState_value[d.State]); want != state {
+			changes[d.TreeID] = want
+		}
+	}
+	return changes, missing
+}
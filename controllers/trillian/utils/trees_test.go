@@ -0,0 +1,40 @@
+package trillianUtils
+
+import (
+	"testing"
+
+	"github.com/google/trillian"
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+)
+
+func testTrees() []*trillian.Tree {
+	return []*trillian.Tree{
+		{TreeId: 3, TreeType: trillian.TreeType_LOG, TreeState: trillian.TreeState_ACTIVE, DisplayName: "ctlog-tree"},
+		{TreeId: 1, TreeType: trillian.TreeType_LOG, TreeState: trillian.TreeState_FROZEN, DisplayName: "rekor-tree"},
+		{TreeId: 2, TreeType: trillian.TreeType_LOG, TreeState: trillian.TreeState_ACTIVE, Deleted: true},
+	}
+}
+
+func TestTreeStatus(t *testing.T) {
+	g := NewWithT(t)
+
+	status := TreeStatus(testTrees(), map[int64]string{3: "CTlog/ctlog"})
+	g.Expect(status).Should(Equal([]v1alpha1.TrillianTree{
+		{TreeID: 1, Type: "LOG", State: "FROZEN", DisplayName: "rekor-tree"},
+		{TreeID: 3, Type: "LOG", State: "ACTIVE", DisplayName: "ctlog-tree", Owner: "CTlog/ctlog"},
+	}))
+}
+
+func TestTreeStateChanges(t *testing.T) {
+	g := NewWithT(t)
+
+	changes, missing := TreeStateChanges(testTrees(), []v1alpha1.TrillianTreeState{
+		{TreeID: 1, State: "FROZEN"},
+		{TreeID: 2, State: "FROZEN"},
+		{TreeID: 3, State: "DRAINING"},
+		{TreeID: 4, State: "ACTIVE"},
+	})
+	g.Expect(changes).Should(Equal(map[int64]trillian.TreeState{3: trillian.TreeState_DRAINING}))
+	g.Expect(missing).Should(ConsistOf(int64(2), int64(4)))
+}
@@ -17,6 +17,7 @@ func CreateTrillDb(instance *v1alpha1.Trillian, dpName string, sa string, opensh
 	if instance.Status.Db.Pvc.Name == "" {
 		return nil, errors.New("reference to database pvc is not set")
 	}
+	container := mysqlContainer(instance, dpName)
 	replicas := int32(1)
 	var secCont *core.PodSecurityContext
 	if !openshift {
@@ -55,102 +56,7 @@ func CreateTrillDb(instance *v1alpha1.Trillian, dpName string, sa string, opensh
 							},
 						},
 					},
-					Containers: []core.Container{
-						{
-							Name:  dpName,
-							Image: constants.TrillianDbImage,
-							ReadinessProbe: &core.Probe{
-								ProbeHandler: core.ProbeHandler{
-									Exec: &core.ExecAction{
-										Command: []string{
-											"mysqladmin",
-											"ping",
-											"-h",
-											"localhost",
-											"-u",
-											"$(MYSQL_USER)",
-											"-p$(MYSQL_PASSWORD)",
-										},
-									},
-								},
-								InitialDelaySeconds: 10,
-								TimeoutSeconds:      1,
-								PeriodSeconds:       10,
-								SuccessThreshold:    1,
-								FailureThreshold:    3,
-							},
-							Ports: []core.ContainerPort{
-								{
-									Protocol:      core.ProtocolTCP,
-									ContainerPort: 3306,
-								},
-							},
-							// Env variables from secret trillian-mysql
-							Env: []core.EnvVar{
-								{
-									Name: "MYSQL_USER",
-									ValueFrom: &core.EnvVarSource{
-										SecretKeyRef: &core.SecretKeySelector{
-											Key: "mysql-user",
-											LocalObjectReference: core.LocalObjectReference{
-												Name: instance.Status.Db.DatabaseSecretRef.Name,
-											},
-										},
-									},
-								},
-								{
-									Name: "MYSQL_PASSWORD",
-									ValueFrom: &core.EnvVarSource{
-										SecretKeyRef: &core.SecretKeySelector{
-											Key: "mysql-password",
-											LocalObjectReference: core.LocalObjectReference{
-												Name: instance.Status.Db.DatabaseSecretRef.Name,
-											},
-										},
-									},
-								},
-								{
-									Name: "MYSQL_ROOT_PASSWORD",
-									ValueFrom: &core.EnvVarSource{
-										SecretKeyRef: &core.SecretKeySelector{
-											Key: "mysql-root-password",
-											LocalObjectReference: core.LocalObjectReference{
-												Name: instance.Status.Db.DatabaseSecretRef.Name,
-											},
-										},
-									},
-								},
-								{
-									Name: "MYSQL_PORT",
-									ValueFrom: &core.EnvVarSource{
-										SecretKeyRef: &core.SecretKeySelector{
-											Key: "mysql-port",
-											LocalObjectReference: core.LocalObjectReference{
-												Name: instance.Status.Db.DatabaseSecretRef.Name,
-											},
-										},
-									},
-								},
-								{
-									Name: "MYSQL_DATABASE",
-									ValueFrom: &core.EnvVarSource{
-										SecretKeyRef: &core.SecretKeySelector{
-											Key: "mysql-database",
-											LocalObjectReference: core.LocalObjectReference{
-												Name: instance.Status.Db.DatabaseSecretRef.Name,
-											},
-										},
-									},
-								},
-							},
-							VolumeMounts: []core.VolumeMount{
-								{
-									Name:      "storage",
-									MountPath: "/var/lib/mysql",
-								},
-							},
-						},
-					},
+					Containers: []core.Container{container},
 				},
 			},
 			Strategy: apps.DeploymentStrategy{
@@ -159,3 +65,100 @@ func CreateTrillDb(instance *v1alpha1.Trillian, dpName string, sa string, opensh
 		},
 	}, nil
 }
+
+func mysqlContainer(instance *v1alpha1.Trillian, dpName string) core.Container {
+	return core.Container{
+		Name:  dpName,
+		Image: constants.TrillianDbImage,
+		ReadinessProbe: &core.Probe{
+			ProbeHandler: core.ProbeHandler{
+				Exec: &core.ExecAction{
+					Command: []string{
+						"mysqladmin",
+						"ping",
+						"-h",
+						"localhost",
+						"-u",
+						"$(MYSQL_USER)",
+						"-p$(MYSQL_PASSWORD)",
+					},
+				},
+			},
+			InitialDelaySeconds: 10,
+			TimeoutSeconds:      1,
+			PeriodSeconds:       10,
+			SuccessThreshold:    1,
+			FailureThreshold:    3,
+		},
+		Ports: []core.ContainerPort{
+			{
+				Protocol:      core.ProtocolTCP,
+				ContainerPort: 3306,
+			},
+		},
+		// Env variables from secret trillian-mysql
+		Env: []core.EnvVar{
+			{
+				Name: "MYSQL_USER",
+				ValueFrom: &core.EnvVarSource{
+					SecretKeyRef: &core.SecretKeySelector{
+						Key: "mysql-user",
+						LocalObjectReference: core.LocalObjectReference{
+							Name: instance.Status.Db.DatabaseSecretRef.Name,
+						},
+					},
+				},
+			},
+			{
+				Name: "MYSQL_PASSWORD",
+				ValueFrom: &core.EnvVarSource{
+					SecretKeyRef: &core.SecretKeySelector{
+						Key: "mysql-password",
+						LocalObjectReference: core.LocalObjectReference{
+							Name: instance.Status.Db.DatabaseSecretRef.Name,
+						},
+					},
+				},
+			},
+			{
+				Name: "MYSQL_ROOT_PASSWORD",
+				ValueFrom: &core.EnvVarSource{
+					SecretKeyRef: &core.SecretKeySelector{
+						Key: "mysql-root-password",
+						LocalObjectReference: core.LocalObjectReference{
+							Name: instance.Status.Db.DatabaseSecretRef.Name,
+						},
+					},
+				},
+			},
+			{
+				Name: "MYSQL_PORT",
+				ValueFrom: &core.EnvVarSource{
+					SecretKeyRef: &core.SecretKeySelector{
+						Key: "mysql-port",
+						LocalObjectReference: core.LocalObjectReference{
+							Name: instance.Status.Db.DatabaseSecretRef.Name,
+						},
+					},
+				},
+			},
+			{
+				Name: "MYSQL_DATABASE",
+				ValueFrom: &core.EnvVarSource{
+					SecretKeyRef: &core.SecretKeySelector{
+						Key: "mysql-database",
+						LocalObjectReference: core.LocalObjectReference{
+							Name: instance.Status.Db.DatabaseSecretRef.Name,
+						},
+					},
+				},
+			},
+		},
+		VolumeMounts: []core.VolumeMount{
+			{
+				Name:      "storage",
+				MountPath: "/var/lib/mysql",
+			},
+		},
+	}
+}
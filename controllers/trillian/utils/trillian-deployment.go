@@ -2,20 +2,22 @@ package trillianUtils
 
 import (
 	"errors"
+	"time"
 
 	"github.com/securesign/operator/api/v1alpha1"
-	"github.com/securesign/operator/controllers/constants"
 	apps "k8s.io/api/apps/v1"
 	core "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const mysqlURIArg = "--mysql_uri=$(MYSQL_USER):$(MYSQL_PASSWORD)@tcp($(MYSQL_HOSTNAME):$(MYSQL_PORT))/$(MYSQL_DATABASE)"
+
 func CreateTrillDeployment(instance *v1alpha1.Trillian, image string, dpName string, sa string, labels map[string]string) (*apps.Deployment, error) {
 	if instance.Status.Db.DatabaseSecretRef == nil {
 		return nil, errors.New("reference to database secret is not set")
 	}
 	replicas := int32(1)
-	return &apps.Deployment{
+	dp := &apps.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      dpName,
 			Namespace: instance.Namespace,
@@ -32,47 +34,12 @@ func CreateTrillDeployment(instance *v1alpha1.Trillian, image string, dpName str
 				},
 				Spec: core.PodSpec{
 					ServiceAccountName: sa,
-					InitContainers: []core.Container{
-						{
-							Name:  "wait-for-trillian-db",
-							Image: constants.TrillianNetcatImage,
-							Env: []core.EnvVar{
-								{
-									Name: "MYSQL_HOSTNAME",
-									ValueFrom: &core.EnvVarSource{
-										SecretKeyRef: &core.SecretKeySelector{
-											Key: "mysql-host",
-											LocalObjectReference: core.LocalObjectReference{
-												Name: instance.Status.Db.DatabaseSecretRef.Name,
-											},
-										},
-									},
-								},
-								{
-									Name: "MYSQL_PORT",
-									ValueFrom: &core.EnvVarSource{
-										SecretKeyRef: &core.SecretKeySelector{
-											Key: "mysql-port",
-											LocalObjectReference: core.LocalObjectReference{
-												Name: instance.Status.Db.DatabaseSecretRef.Name,
-											},
-										},
-									},
-								},
-							},
-							Command: []string{
-								"sh",
-								"-c",
-								"until nc -z -v -w30 $MYSQL_HOSTNAME $MYSQL_PORT; do echo \"Waiting for MySQL to start\"; sleep 5; done;",
-							},
-						},
-					},
 					Containers: []core.Container{
 						{
 							Args: []string{
 								"--storage_system=mysql",
 								"--quota_system=mysql",
-								"--mysql_uri=$(MYSQL_USER):$(MYSQL_PASSWORD)@tcp($(MYSQL_HOSTNAME):$(MYSQL_PORT))/$(MYSQL_DATABASE)",
+								mysqlURIArg,
 								"--rpc_endpoint=0.0.0.0:8091",
 								"--http_endpoint=0.0.0.0:8090",
 								"--alsologtostderr",
@@ -86,67 +53,65 @@ func CreateTrillDeployment(instance *v1alpha1.Trillian, image string, dpName str
 								},
 							},
 							// Env variables from secret trillian-mysql
-							Env: []core.EnvVar{
-								{
-									Name: "MYSQL_USER",
-									ValueFrom: &core.EnvVarSource{
-										SecretKeyRef: &core.SecretKeySelector{
-											Key: "mysql-user",
-											LocalObjectReference: core.LocalObjectReference{
-												Name: instance.Status.Db.DatabaseSecretRef.Name,
-											},
-										},
-									},
-								},
-								{
-									Name: "MYSQL_PASSWORD",
-									ValueFrom: &core.EnvVarSource{
-										SecretKeyRef: &core.SecretKeySelector{
-											Key: "mysql-password",
-											LocalObjectReference: core.LocalObjectReference{
-												Name: instance.Status.Db.DatabaseSecretRef.Name,
-											},
-										},
-									},
-								},
-								{
-									Name: "MYSQL_HOSTNAME",
-									ValueFrom: &core.EnvVarSource{
-										SecretKeyRef: &core.SecretKeySelector{
-											Key: "mysql-host",
-											LocalObjectReference: core.LocalObjectReference{
-												Name: instance.Status.Db.DatabaseSecretRef.Name,
-											},
-										},
-									},
-								},
-								{
-									Name: "MYSQL_PORT",
-									ValueFrom: &core.EnvVarSource{
-										SecretKeyRef: &core.SecretKeySelector{
-											Key: "mysql-port",
-											LocalObjectReference: core.LocalObjectReference{
-												Name: instance.Status.Db.DatabaseSecretRef.Name,
-											},
-										},
-									},
-								},
-								{
-									Name: "MYSQL_DATABASE",
-									ValueFrom: &core.EnvVarSource{
-										SecretKeyRef: &core.SecretKeySelector{
-											Key: "mysql-database",
-											LocalObjectReference: core.LocalObjectReference{
-												Name: instance.Status.Db.DatabaseSecretRef.Name,
-											},
-										},
-									},
-								},
-							},
+							Env: databaseEnv(instance.Status.Db.DatabaseSecretRef.Name),
 						},
 					},
 				},
 			},
 		},
-	}, nil
+	}
+	UseDatabaseConfig(dp, instance.Spec.Db)
+	if t := instance.Status.DbCredentials.LastRotationTime; t != nil {
+		dp.Spec.Template.Annotations = map[string]string{CredentialsRotatedAnnotation: t.UTC().Format(time.RFC3339)}
+	}
+	return dp, nil
+}
+
+// databaseEnv returns environment variables with the connection details from the database secret.
+func databaseEnv(secretName string) []core.EnvVar {
+	env := make([]core.EnvVar, 0, 5)
+	for _, v := range []struct{ name, key string }{
+		{"MYSQL_USER", "mysql-user"},
+		{"MYSQL_PASSWORD", DbPasswordKey},
+		{"MYSQL_HOSTNAME", "mysql-host"},
+		{"MYSQL_PORT", "mysql-port"},
+		{"MYSQL_DATABASE", "mysql-database"},
+	} {
+		env = append(env, core.EnvVar{
+			Name: v.name,
+			ValueFrom: &core.EnvVarSource{
+				SecretKeyRef: &core.SecretKeySelector{
+					Key:                  v.key,
+					LocalObjectReference: core.LocalObjectReference{Name: secretName},
+				},
+			},
+		})
+	}
+	return env
+}
+
+// UseTLS configures the Trillian server to serve the gRPC and HTTP endpoints using the certificate from the TLS secret.
+func UseTLS(dp *apps.Deployment, secret *v1alpha1.LocalObjectReference) {
+	if secret == nil {
+		return
+	}
+	template := &dp.Spec.Template.Spec
+	template.Volumes = append(template.Volumes, core.Volume{
+		Name: "tls-cert",
+		VolumeSource: core.VolumeSource{
+			Secret: &core.SecretVolumeSource{
+				SecretName: secret.Name,
+			},
+		},
+	})
+	container := &template.Containers[0]
+	container.VolumeMounts = append(container.VolumeMounts, core.VolumeMount{
+		Name:      "tls-cert",
+		MountPath: "/var/run/secrets/tls",
+		ReadOnly:  true,
+	})
+	container.Args = append(container.Args,
+		"--tls_cert_file=/var/run/secrets/tls/"+core.TLSCertKey,
+		"--tls_key_file=/var/run/secrets/tls/"+core.TLSPrivateKeyKey,
+	)
 }
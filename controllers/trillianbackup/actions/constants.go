@@ -0,0 +1,12 @@
+package actions
+
+const (
+	ComponentName = "trillian-backup"
+
+	BackupCondition = "LastBackupSucceeded"
+)
+
+// ResourceName returns the name of the PVC, CronJob and Job of the backup.
+func ResourceName(backup string) string {
+	return "trillian-backup-" + backup
+}
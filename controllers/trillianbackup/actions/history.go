@@ -0,0 +1,108 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/action"
+	k8sutils "github.com/securesign/operator/controllers/common/utils/kubernetes"
+	"github.com/securesign/operator/controllers/constants"
+	trillianUtils "github.com/securesign/operator/controllers/trillian/utils"
+	"github.com/securesign/operator/controllers/trillianbackup/utils"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func NewHistoryAction() action.Action[rhtasv1alpha1.TrillianBackup] {
+	return &historyAction{}
+}
+
+type historyAction struct {
+	action.BaseAction
+}
+
+func (i historyAction) Name() string {
+	return "backup history"
+}
+
+func (i historyAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.TrillianBackup) bool {
+	return meta.IsStatusConditionTrue(instance.Status.Conditions, constants.Ready)
+}
+
+// Handle records finished backup Jobs in the history and deletes the dumps expired by the retention policy from S3.
+// Dumps stored on the PVC are deleted by the backup Job.
+func (i historyAction) Handle(ctx context.Context, instance *rhtasv1alpha1.TrillianBackup) *action.Result {
+	jobs := &batchv1.JobList{}
+	if err := i.Client.List(ctx, jobs, client.InNamespace(instance.Namespace),
+		client.MatchingLabels(constants.LabelsForComponent(ComponentName, instance.Name))); err != nil {
+		return i.Failed(fmt.Errorf("could not list backup jobs: %w", err))
+	}
+	known := make(map[string]bool, len(instance.Status.History))
+	for _, r := range instance.Status.History {
+		known[r.Name] = true
+	}
+	var records []rhtasv1alpha1.BackupRecord
+	for j := range jobs.Items {
+		record := utils.JobRecord(instance, &jobs.Items[j])
+		if record == nil || known[record.Name] {
+			continue
+		}
+		records = append(records, *record)
+	}
+
+	history, expired := utils.MergeHistory(instance.Status.History, records, instance.Spec.Retention)
+	for _, r := range history {
+		switch {
+		case known[r.Name]:
+		case r.Result == utils.BackupSucceeded:
+			i.Recorder.Eventf(instance, v1.EventTypeNormal, "BackupSucceeded", "Database dumped to %s", r.Location)
+		default:
+			i.Recorder.Eventf(instance, v1.EventTypeWarning, "BackupFailed", "Backup %s failed: %s", r.Name, r.Message)
+		}
+	}
+	if s3 := instance.Spec.Target.S3; s3 != nil && len(expired) > 0 {
+		secret, err := k8sutils.GetSecret(i.Client, instance.Namespace, s3.CredentialsRef.Name)
+		if err != nil {
+			return i.Failed(fmt.Errorf("could not get S3 credentials: %w", err))
+		}
+		// dumps expired before they were recorded are deleted as well, missing objects are ignored
+		for _, r := range expired {
+			if err = trillianUtils.DeleteS3Object(ctx, s3, secret.Data, r.Name); err != nil {
+				i.Recorder.Event(instance, v1.EventTypeWarning, "BackupRetentionFailed", err.Error())
+				return i.Failed(err)
+			}
+			i.Logger.Info("Expired dump deleted", "location", r.Location)
+		}
+	}
+
+	status := instance.Status.DeepCopy()
+	status.History = history
+	if last := utils.LastSuccessful(history); last != nil {
+		status.LastSuccessfulTime = last.CompletionTime
+	}
+	if len(history) > 0 {
+		condition := metav1.Condition{
+			Type:    BackupCondition,
+			Status:  metav1.ConditionTrue,
+			Reason:  utils.BackupSucceeded,
+			Message: "Database dumped to " + history[0].Location,
+		}
+		if history[0].Result != utils.BackupSucceeded {
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = utils.BackupFailed
+			condition.Message = history[0].Message
+		}
+		meta.SetStatusCondition(&status.Conditions, condition)
+	}
+
+	if equality.Semantic.DeepEqual(*status, instance.Status) {
+		return i.Continue()
+	}
+	instance.Status = *status
+	return i.StatusUpdate(ctx, instance)
+}
@@ -0,0 +1,70 @@
+package actions
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+	testAction "github.com/securesign/operator/controllers/common/test/action"
+	"github.com/securesign/operator/controllers/constants"
+	trillianUtils "github.com/securesign/operator/controllers/trillian/utils"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestHistoryDeletesExpiredDumps(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.TODO()
+
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			deleted = append(deleted, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	instance := &v1alpha1.TrillianBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "default"},
+		Spec: v1alpha1.TrillianBackupSpec{
+			Retention: 1,
+			Target: v1alpha1.BackupTarget{S3: &v1alpha1.S3Target{
+				Endpoint:       server.URL,
+				Bucket:         "bucket",
+				CredentialsRef: v1alpha1.LocalObjectReference{Name: "s3"},
+			}},
+		},
+	}
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{Type: constants.Ready, Status: metav1.ConditionTrue, Reason: constants.Ready})
+	job := func(name string, age time.Duration) *batchv1.Job {
+		start := metav1.NewTime(time.Now().Add(-age))
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: constants.LabelsForComponent(ComponentName, instance.Name)},
+			Status: batchv1.JobStatus{
+				StartTime:  &start,
+				Conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: v1.ConditionTrue}},
+			},
+		}
+	}
+	c := testAction.FakeClientBuilder().
+		WithStatusSubresource(instance).
+		WithObjects(instance, job("dump-1", 3*time.Hour), job("dump-2", 2*time.Hour), job("dump-3", time.Hour),
+			&v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "s3", Namespace: "default"}, Data: map[string][]byte{
+				trillianUtils.S3AccessKeyIDKey: []byte("key"), trillianUtils.S3SecretAccessKeyKey: []byte("secret")}},
+		).
+		Build()
+	a := testAction.PrepareAction(c, NewHistoryAction())
+
+	// the dumps expire before they were recorded in the history
+	g.Expect(a.CanHandle(ctx, instance)).To(BeTrue())
+	g.Expect(a.Handle(ctx, instance)).ToNot(BeNil())
+	g.Expect(deleted).To(ConsistOf("/bucket/dump-1.sql.gz", "/bucket/dump-2.sql.gz"))
+	g.Expect(instance.Status.History).To(ConsistOf(HaveField("Name", "dump-3")))
+}
@@ -0,0 +1,140 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/robfig/cron/v3"
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/action"
+	"github.com/securesign/operator/controllers/constants"
+	trillian "github.com/securesign/operator/controllers/trillian/actions"
+	trillianUtils "github.com/securesign/operator/controllers/trillian/utils"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func NewJobAction() action.Action[rhtasv1alpha1.TrillianBackup] {
+	return &jobAction{}
+}
+
+type jobAction struct {
+	action.BaseAction
+}
+
+func (i jobAction) Name() string {
+	return "backup job"
+}
+
+func (i jobAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.TrillianBackup) bool {
+	// the schedule is mutable, so the action recovers from the failure once it is fixed
+	return instance.Spec.Target.Pvc == nil || instance.Status.PvcName != ""
+}
+
+// Handle runs the backup Job on the schedule, a single backup Job is run when the schedule is not set.
+func (i jobAction) Handle(ctx context.Context, instance *rhtasv1alpha1.TrillianBackup) *action.Result {
+	t := &rhtasv1alpha1.Trillian{}
+	if err := i.Client.Get(ctx, types.NamespacedName{Name: instance.Spec.TrillianRef.Name, Namespace: instance.Namespace}, t); err != nil {
+		if apierrors.IsNotFound(err) {
+			return i.waiting(ctx, instance, fmt.Sprintf("Waiting for Trillian %s", instance.Spec.TrillianRef.Name))
+		}
+		return i.Failed(err)
+	}
+	if t.Status.Db.DatabaseSecretRef == nil {
+		return i.waiting(ctx, instance, "Waiting for Trillian database")
+	}
+
+	name := ResourceName(instance.Name)
+	labels := constants.LabelsFor(ComponentName, name, instance.Name)
+	job, err := trillianUtils.CreateBackupJob(t, instance, constants.TrillianDbImage, name, constants.InstanceName(trillian.RBACName, t.Name), labels)
+	if err != nil {
+		return i.fail(ctx, instance, err)
+	}
+
+	var message string
+	if instance.Spec.Schedule != "" {
+		if _, err = cron.ParseStandard(instance.Spec.Schedule); err != nil {
+			return i.fail(ctx, instance, fmt.Errorf("invalid schedule: %w", err))
+		}
+		cronJob := &batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: instance.Namespace,
+				Labels:    labels,
+			},
+			Spec: batchv1.CronJobSpec{
+				Schedule:          instance.Spec.Schedule,
+				ConcurrencyPolicy: batchv1.ForbidConcurrent,
+				JobTemplate: batchv1.JobTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: labels},
+					Spec:       job.Spec,
+				},
+			},
+		}
+		if err = controllerutil.SetControllerReference(instance, cronJob, i.Client.Scheme()); err != nil {
+			return i.Failed(fmt.Errorf("could not set controller reference for backup CronJob: %w", err))
+		}
+		if _, err = i.Ensure(ctx, cronJob); err != nil {
+			return i.fail(ctx, instance, fmt.Errorf("could not create backup CronJob: %w", err))
+		}
+		message = "Backup scheduled " + instance.Spec.Schedule
+	} else {
+		cronJob := &batchv1.CronJob{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: instance.Namespace}}
+		if err = i.Client.Delete(ctx, cronJob); client.IgnoreNotFound(err) != nil {
+			return i.Failed(fmt.Errorf("could not delete backup CronJob: %w", err))
+		}
+		// the single backup is not repeated once its Job is deleted
+		if len(instance.Status.History) == 0 {
+			if err = controllerutil.SetControllerReference(instance, job, i.Client.Scheme()); err != nil {
+				return i.Failed(fmt.Errorf("could not set controller reference for backup Job: %w", err))
+			}
+			if err = i.Client.Create(ctx, job); client.IgnoreAlreadyExists(err) != nil {
+				return i.fail(ctx, instance, fmt.Errorf("could not create backup Job: %w", err))
+			}
+		}
+		message = "Backup started"
+	}
+
+	if c := meta.FindStatusCondition(instance.Status.Conditions, constants.Ready); c.Status == metav1.ConditionTrue && c.Message == message {
+		return i.Continue()
+	}
+	i.Recorder.Event(instance, v1.EventTypeNormal, "BackupConfigured", message)
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:    constants.Ready,
+		Status:  metav1.ConditionTrue,
+		Reason:  constants.Ready,
+		Message: message,
+	})
+	return i.StatusUpdate(ctx, instance)
+}
+
+func (i jobAction) waiting(ctx context.Context, instance *rhtasv1alpha1.TrillianBackup, message string) *action.Result {
+	c := meta.FindStatusCondition(instance.Status.Conditions, constants.Ready)
+	if c.Reason == constants.Pending && c.Message == message {
+		i.Logger.Info(message)
+		return i.Requeue()
+	}
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:    constants.Ready,
+		Status:  metav1.ConditionFalse,
+		Reason:  constants.Pending,
+		Message: message,
+	})
+	return i.StatusUpdate(ctx, instance)
+}
+
+func (i jobAction) fail(ctx context.Context, instance *rhtasv1alpha1.TrillianBackup, err error) *action.Result {
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:    constants.Ready,
+		Status:  metav1.ConditionFalse,
+		Reason:  constants.Failure,
+		Message: err.Error(),
+	})
+	return i.FailedWithStatusUpdate(ctx, fmt.Errorf("could not configure trillian backup: %w", err), instance)
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trillianbackup
+
+import (
+	"context"
+
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/action"
+	"github.com/securesign/operator/controllers/trillianbackup/actions"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// TrillianBackupReconciler reconciles a TrillianBackup object
+type TrillianBackupReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+//+kubebuilder:rbac:groups=rhtas.redhat.com,resources=trillianbackups,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=rhtas.redhat.com,resources=trillianbackups/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=rhtas.redhat.com,resources=trillianbackups/finalizers,verbs=update
+
+// Reconcile dumps the Trillian database on the schedule and records the backup history.
+func (r *TrillianBackupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	rlog := log.FromContext(ctx).WithName("controller").WithName("trillianbackup")
+	rlog.V(1).Info("Reconciling TrillianBackup", "request", req)
+
+	instance := &rhtasv1alpha1.TrillianBackup{}
+	if err := r.Client.Get(ctx, req.NamespacedName, instance); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	target := instance.DeepCopy()
+	acs := []action.Action[rhtasv1alpha1.TrillianBackup]{
+		actions.NewToPendingPhaseAction(),
+		actions.NewCreatePvcAction(),
+		actions.NewJobAction(),
+		actions.NewHistoryAction(),
+	}
+
+	for _, a := range acs {
+		a.InjectClient(r.Client)
+		a.InjectLogger(rlog.WithName(a.Name()))
+		a.InjectRecorder(r.Recorder)
+
+		if a.CanHandle(ctx, target) {
+			rlog.V(2).Info("Executing " + a.Name())
+			result := a.Handle(ctx, target)
+			if result != nil {
+				return result.Result, result.Err
+			}
+		}
+	}
+	return reconcile.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *TrillianBackupReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&rhtasv1alpha1.TrillianBackup{}).
+		Owns(&batchv1.CronJob{}).
+		Owns(&v1.PersistentVolumeClaim{}).
+		// Jobs created by the CronJob are not owned by the backup
+		Watches(&batchv1.Job{}, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, object client.Object) []reconcile.Request {
+			labels := object.GetLabels()
+			if labels["app.kubernetes.io/component"] != actions.ComponentName {
+				return nil
+			}
+			val, ok := labels["app.kubernetes.io/instance"]
+			if !ok {
+				return nil
+			}
+			return []reconcile.Request{
+				{
+					NamespacedName: types.NamespacedName{
+						Namespace: object.GetNamespace(),
+						Name:      val,
+					},
+				},
+			}
+		})).
+		Complete(r)
+}
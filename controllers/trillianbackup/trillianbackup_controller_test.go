@@ -0,0 +1,155 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trillianbackup
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/utils"
+	"github.com/securesign/operator/controllers/constants"
+	"github.com/securesign/operator/controllers/trillianbackup/actions"
+	backupUtils "github.com/securesign/operator/controllers/trillianbackup/utils"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	k8sresource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("TrillianBackup controller", func() {
+	Context("TrillianBackup controller test", func() {
+
+		const (
+			Name      = "test-backup"
+			Namespace = "backup"
+		)
+
+		ctx := context.Background()
+
+		namespace := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      Namespace,
+				Namespace: Namespace,
+			},
+		}
+
+		typeNamespaceName := types.NamespacedName{Name: Name, Namespace: Namespace}
+		instance := &v1alpha1.TrillianBackup{}
+
+		BeforeEach(func() {
+			By("Creating the Namespace to perform the tests")
+			err := k8sClient.Create(ctx, namespace)
+			Expect(err).To(Not(HaveOccurred()))
+		})
+
+		AfterEach(func() {
+			By("Deleting the Namespace to perform the tests")
+			_ = k8sClient.Delete(ctx, namespace)
+		})
+
+		It("should successfully reconcile a custom resource for TrillianBackup", func() {
+			By("creating the Trillian instance")
+			trillian := &v1alpha1.Trillian{
+				ObjectMeta: metav1.ObjectMeta{Name: "trillian", Namespace: Namespace},
+				Spec:       v1alpha1.TrillianSpec{Db: v1alpha1.TrillianDB{Create: utils.Pointer(true)}},
+			}
+			Expect(k8sClient.Create(ctx, trillian)).To(Succeed())
+
+			By("creating the custom resource for the Kind TrillianBackup")
+			storage := k8sresource.MustParse("1Gi")
+			instance = &v1alpha1.TrillianBackup{
+				ObjectMeta: metav1.ObjectMeta{Name: Name, Namespace: Namespace},
+				Spec: v1alpha1.TrillianBackupSpec{
+					TrillianRef: v1alpha1.LocalObjectReference{Name: "trillian"},
+					Schedule:    "0 3 * * *",
+					Retention:   2,
+					Target: v1alpha1.BackupTarget{
+						Pvc: &v1alpha1.Pvc{Size: &storage, Retain: utils.Pointer(false)},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, instance)).To(Succeed())
+
+			By("Waiting for the Trillian database")
+			Eventually(func(g Gomega) string {
+				found := &v1alpha1.TrillianBackup{}
+				g.Expect(k8sClient.Get(ctx, typeNamespaceName, found)).To(Succeed())
+				g.Expect(found.Status.PvcName).To(Equal(actions.ResourceName(Name)))
+				return meta.FindStatusCondition(found.Status.Conditions, constants.Ready).Message
+			}).Should(Equal("Waiting for Trillian database"))
+
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: "trillian", Namespace: Namespace}, trillian)).To(Succeed())
+			trillian.Status.Db.DatabaseSecretRef = &v1alpha1.LocalObjectReference{Name: "trillian-db"}
+			Expect(k8sClient.Status().Update(ctx, trillian)).To(Succeed())
+
+			By("Backup CronJob created")
+			cronJob := &batchv1.CronJob{}
+			Eventually(func() error {
+				return k8sClient.Get(ctx, types.NamespacedName{Name: actions.ResourceName(Name), Namespace: Namespace}, cronJob)
+			}).Should(Succeed())
+			Expect(cronJob.Spec.Schedule).To(Equal("0 3 * * *"))
+			Expect(cronJob.Spec.JobTemplate.Spec.Template.Spec.Volumes).To(ContainElement(
+				HaveField("PersistentVolumeClaim.ClaimName", actions.ResourceName(Name))))
+			Eventually(func(g Gomega) bool {
+				found := &v1alpha1.TrillianBackup{}
+				g.Expect(k8sClient.Get(ctx, typeNamespaceName, found)).To(Succeed())
+				return meta.IsStatusConditionTrue(found.Status.Conditions, constants.Ready)
+			}).Should(BeTrue())
+
+			By("Finished backups recorded in the history")
+			for i, result := range []batchv1.JobConditionType{batchv1.JobComplete, batchv1.JobFailed, batchv1.JobComplete, batchv1.JobComplete} {
+				job := &batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      actions.ResourceName(Name) + "-" + string(rune('a'+i)),
+						Namespace: Namespace,
+						Labels:    cronJob.Spec.JobTemplate.Labels,
+					},
+					Spec: cronJob.Spec.JobTemplate.Spec,
+				}
+				Expect(k8sClient.Create(ctx, job)).To(Succeed())
+				start := metav1.NewTime(time.Now().Add(time.Duration(i) * time.Minute))
+				job.Status.StartTime = &start
+				job.Status.CompletionTime = &start
+				job.Status.Conditions = []batchv1.JobCondition{{Type: result, Status: corev1.ConditionTrue, LastTransitionTime: start}}
+				if result == batchv1.JobFailed {
+					job.Status.CompletionTime = nil
+				}
+				Expect(k8sClient.Status().Update(ctx, job)).To(Succeed())
+			}
+
+			Eventually(func(g Gomega) []v1alpha1.BackupRecord {
+				found := &v1alpha1.TrillianBackup{}
+				g.Expect(k8sClient.Get(ctx, typeNamespaceName, found)).To(Succeed())
+				return found.Status.History
+			}).Should(HaveExactElements(
+				And(HaveField("Name", actions.ResourceName(Name)+"-d"), HaveField("Result", backupUtils.BackupSucceeded)),
+				HaveField("Name", actions.ResourceName(Name)+"-c"),
+				And(HaveField("Name", actions.ResourceName(Name)+"-b"), HaveField("Result", backupUtils.BackupFailed)),
+			))
+
+			found := &v1alpha1.TrillianBackup{}
+			Expect(k8sClient.Get(ctx, typeNamespaceName, found)).To(Succeed())
+			Expect(found.Status.LastSuccessfulTime).ToNot(BeNil())
+			Expect(meta.IsStatusConditionTrue(found.Status.Conditions, actions.BackupCondition)).To(BeTrue())
+		})
+	})
+})
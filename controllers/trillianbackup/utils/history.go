@@ -0,0 +1,127 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/securesign/operator/api/v1alpha1"
+	trillianUtils "github.com/securesign/operator/controllers/trillian/utils"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	BackupSucceeded = "Succeeded"
+	BackupFailed    = "Failed"
+
+	// maxFailedRecords limits the number of failed backups kept in the history
+	maxFailedRecords = 5
+)
+
+// JobRecord returns the history record of the finished backup Job, nil is returned while the Job is running.
+func JobRecord(backup *v1alpha1.TrillianBackup, job *batchv1.Job) *v1alpha1.BackupRecord {
+	finished, err := trillianUtils.JobFinished(job)
+	if !finished {
+		return nil
+	}
+	record := &v1alpha1.BackupRecord{
+		Name:           job.Name,
+		Location:       trillianUtils.DumpLocation(backup, job.Name),
+		Result:         BackupSucceeded,
+		StartTime:      job.Status.StartTime,
+		CompletionTime: job.Status.CompletionTime,
+	}
+	if err != nil {
+		record.Result = BackupFailed
+		record.Message = err.Error()
+		for _, c := range job.Status.Conditions {
+			if c.Type == batchv1.JobFailed {
+				record.CompletionTime = c.LastTransitionTime.DeepCopy()
+			}
+		}
+	}
+	return record
+}
+
+// MergeHistory adds the records of finished backups to the history and applies the retention policy.
+// It returns the history ordered from the newest record and the successful records whose dumps expired.
+func MergeHistory(history []v1alpha1.BackupRecord, records []v1alpha1.BackupRecord, retention int32) ([]v1alpha1.BackupRecord, []v1alpha1.BackupRecord) {
+	byName := make(map[string]v1alpha1.BackupRecord, len(history)+len(records))
+	for _, r := range history {
+		byName[r.Name] = r
+	}
+	for _, r := range records {
+		byName[r.Name] = r
+	}
+
+	all := make([]v1alpha1.BackupRecord, 0, len(byName))
+	for _, r := range byName {
+		all = append(all, r)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		ti, tj := startTime(all[i]), startTime(all[j])
+		if ti.Equal(&tj) {
+			return all[i].Name > all[j].Name
+		}
+		return tj.Before(&ti)
+	})
+
+	var (
+		merged, expired   []v1alpha1.BackupRecord
+		succeeded, failed int32
+	)
+	for _, r := range all {
+		switch r.Result {
+		case BackupSucceeded:
+			succeeded++
+			if succeeded > retention {
+				expired = append(expired, r)
+				continue
+			}
+		default:
+			failed++
+			if failed > maxFailedRecords {
+				continue
+			}
+		}
+		merged = append(merged, r)
+	}
+	return merged, expired
+}
+
+// LastSuccessful returns the newest successful record of the history.
+func LastSuccessful(history []v1alpha1.BackupRecord) *v1alpha1.BackupRecord {
+	for i := range history {
+		if history[i].Result == BackupSucceeded {
+			return &history[i]
+		}
+	}
+	return nil
+}
+
+// FindDump returns the successful record of the dump, the newest one is returned for the empty name.
+func FindDump(history []v1alpha1.BackupRecord, name string) (*v1alpha1.BackupRecord, error) {
+	if name == "" {
+		if r := LastSuccessful(history); r != nil {
+			return r, nil
+		}
+		return nil, errors.New("no successful backup found")
+	}
+	for i := range history {
+		if history[i].Name == name {
+			if history[i].Result != BackupSucceeded {
+				return nil, fmt.Errorf("backup %s did not succeed", name)
+			}
+			return &history[i], nil
+		}
+	}
+	return nil, fmt.Errorf("backup %s not found in the history", name)
+}
+
+func startTime(r v1alpha1.BackupRecord) metav1.Time {
+	if r.StartTime == nil {
+		return metav1.Time{}
+	}
+	return *r.StartTime
+}
@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+	batchv1 "k8s.io/api/batch/v1"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func record(name string, hour int, result string) v1alpha1.BackupRecord {
+	start := metav1.NewTime(time.Date(2024, 1, 1, hour, 0, 0, 0, time.UTC))
+	return v1alpha1.BackupRecord{Name: name, StartTime: &start, Result: result}
+}
+
+func TestMergeHistory(t *testing.T) {
+	g := NewWithT(t)
+
+	history := []v1alpha1.BackupRecord{
+		record("b", 2, BackupSucceeded),
+		record("a", 1, BackupSucceeded),
+	}
+	merged, expired := MergeHistory(history, []v1alpha1.BackupRecord{
+		record("d", 4, BackupSucceeded),
+		record("c", 3, BackupFailed),
+	}, 2)
+	g.Expect(merged).To(HaveExactElements(
+		HaveField("Name", "d"),
+		HaveField("Name", "c"),
+		HaveField("Name", "b"),
+	))
+	g.Expect(expired).To(HaveExactElements(HaveField("Name", "a")))
+
+	var failed []v1alpha1.BackupRecord
+	for i := 0; i < 10; i++ {
+		failed = append(failed, record(string(rune('e'+i)), 5+i, BackupFailed))
+	}
+	merged, expired = MergeHistory(merged, failed, 2)
+	g.Expect(expired).To(BeEmpty())
+	g.Expect(merged).To(HaveLen(maxFailedRecords + 2))
+	g.Expect(LastSuccessful(merged)).To(HaveField("Name", "d"))
+}
+
+func TestFindDump(t *testing.T) {
+	g := NewWithT(t)
+
+	history := []v1alpha1.BackupRecord{
+		record("c", 3, BackupFailed),
+		record("b", 2, BackupSucceeded),
+		record("a", 1, BackupSucceeded),
+	}
+	g.Expect(FindDump(history, "")).To(HaveField("Name", "b"))
+	g.Expect(FindDump(history, "a")).To(HaveField("Name", "a"))
+	_, err := FindDump(history, "c")
+	g.Expect(err).To(MatchError(ContainSubstring("did not succeed")))
+	_, err = FindDump(history, "x")
+	g.Expect(err).To(MatchError(ContainSubstring("not found")))
+	_, err = FindDump(nil, "")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestJobRecord(t *testing.T) {
+	g := NewWithT(t)
+
+	backup := &v1alpha1.TrillianBackup{Status: v1alpha1.TrillianBackupStatus{PvcName: "pvc"}}
+	job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "job"}}
+	g.Expect(JobRecord(backup, job)).To(BeNil())
+
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: core.ConditionTrue}}
+	g.Expect(JobRecord(backup, job)).To(And(
+		HaveField("Result", BackupSucceeded),
+		HaveField("Location", "pvc://pvc/job.sql.gz"),
+	))
+
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: core.ConditionTrue, Message: "DeadlineExceeded"}}
+	g.Expect(JobRecord(backup, job)).To(And(
+		HaveField("Result", BackupFailed),
+		HaveField("Message", ContainSubstring("DeadlineExceeded")),
+	))
+}
@@ -0,0 +1,12 @@
+package actions
+
+const (
+	ComponentName = "trillian-restore"
+
+	SignerCondition = "LogSignerPaused"
+)
+
+// ResourceName returns the name of the restore Job.
+func ResourceName(restore string) string {
+	return "trillian-restore-" + restore
+}
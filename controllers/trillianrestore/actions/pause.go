@@ -0,0 +1,80 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/action"
+	k8sutils "github.com/securesign/operator/controllers/common/utils/kubernetes"
+	"github.com/securesign/operator/controllers/constants"
+	trillian "github.com/securesign/operator/controllers/trillian/actions"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func NewPauseSignerAction() action.Action[rhtasv1alpha1.TrillianRestore] {
+	return &pauseSignerAction{}
+}
+
+type pauseSignerAction struct {
+	action.BaseAction
+}
+
+func (i pauseSignerAction) Name() string {
+	return "pause log signer"
+}
+
+func (i pauseSignerAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.TrillianRestore) bool {
+	c := meta.FindStatusCondition(instance.Status.Conditions, constants.Ready)
+	return c.Reason == constants.Creating && !meta.IsStatusConditionTrue(instance.Status.Conditions, SignerCondition)
+}
+
+// Handle scales the log signer down so no new entries are integrated into the trees while the dump is loaded.
+func (i pauseSignerAction) Handle(ctx context.Context, instance *rhtasv1alpha1.TrillianRestore) *action.Result {
+	t := &rhtasv1alpha1.Trillian{}
+	if err := i.Client.Get(ctx, types.NamespacedName{Name: instance.Spec.TrillianRef.Name, Namespace: instance.Namespace}, t); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fail(ctx, &i.BaseAction, instance, fmt.Errorf("trillian %s not found", instance.Spec.TrillianRef.Name))
+		}
+		return i.Failed(err)
+	}
+	if owner, ok := t.Annotations[trillian.PausedByRestoreAnnotation]; ok && owner != instance.Name {
+		i.Logger.Info("Waiting for another restore", "restore", owner)
+		return i.Requeue()
+	}
+	if _, err := k8sutils.EnsureAnnotations(ctx, i.Client, t, map[string]string{trillian.PausedByRestoreAnnotation: instance.Name}); err != nil {
+		return i.Failed(fmt.Errorf("could not pause log signer: %w", err))
+	}
+
+	signer := &appsv1.Deployment{}
+	err := i.Client.Get(ctx, types.NamespacedName{Name: constants.InstanceName(trillian.LogsignerDeploymentName, t.Name), Namespace: instance.Namespace}, signer)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return i.Failed(err)
+	}
+	if err == nil && signer.Status.Replicas > 0 {
+		i.Logger.Info("Waiting for log signer to scale down")
+		return i.Requeue()
+	}
+
+	i.Recorder.Event(instance, v1.EventTypeNormal, "LogSignerPaused", "Log signer paused")
+	now := metav1.Now()
+	instance.Status.StartTime = &now
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:    SignerCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  constants.Creating,
+		Message: "Log signer paused",
+	})
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:    constants.Ready,
+		Status:  metav1.ConditionFalse,
+		Reason:  constants.Creating,
+		Message: "Restoring dump " + instance.Status.Dump.Name,
+	})
+	return i.StatusUpdate(ctx, instance)
+}
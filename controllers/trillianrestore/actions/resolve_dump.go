@@ -0,0 +1,62 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/action"
+	"github.com/securesign/operator/controllers/constants"
+	"github.com/securesign/operator/controllers/trillianbackup/utils"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func NewResolveDumpAction() action.Action[rhtasv1alpha1.TrillianRestore] {
+	return &resolveDumpAction{}
+}
+
+type resolveDumpAction struct {
+	action.BaseAction
+}
+
+func (i resolveDumpAction) Name() string {
+	return "resolve dump"
+}
+
+func (i resolveDumpAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.TrillianRestore) bool {
+	c := meta.FindStatusCondition(instance.Status.Conditions, constants.Ready)
+	return c.Reason == constants.Pending
+}
+
+func (i resolveDumpAction) Handle(ctx context.Context, instance *rhtasv1alpha1.TrillianRestore) *action.Result {
+	backup := &rhtasv1alpha1.TrillianBackup{}
+	if err := i.Client.Get(ctx, types.NamespacedName{Name: instance.Spec.Source.BackupRef.Name, Namespace: instance.Namespace}, backup); err != nil {
+		return fail(ctx, &i.BaseAction, instance, fmt.Errorf("could not get TrillianBackup: %w", err))
+	}
+	dump, err := utils.FindDump(backup.Status.History, instance.Spec.Source.Dump)
+	if err != nil {
+		return fail(ctx, &i.BaseAction, instance, err)
+	}
+
+	instance.Status.Dump = dump.DeepCopy()
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:    constants.Ready,
+		Status:  metav1.ConditionFalse,
+		Reason:  constants.Creating,
+		Message: "Pausing log signer",
+	})
+	return i.StatusUpdate(ctx, instance)
+}
+
+// fail moves the restore to the terminal failure phase, the paused log signer is resumed when the TrillianRestore is deleted.
+func fail(ctx context.Context, a *action.BaseAction, instance *rhtasv1alpha1.TrillianRestore, err error) *action.Result {
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:    constants.Ready,
+		Status:  metav1.ConditionFalse,
+		Reason:  constants.Failure,
+		Message: err.Error(),
+	})
+	return a.FailedWithStatusUpdate(ctx, fmt.Errorf("could not restore trillian database: %w", err), instance)
+}
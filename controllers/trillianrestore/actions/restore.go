@@ -0,0 +1,89 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/action"
+	"github.com/securesign/operator/controllers/constants"
+	trillian "github.com/securesign/operator/controllers/trillian/actions"
+	trillianUtils "github.com/securesign/operator/controllers/trillian/utils"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func NewRestoreAction() action.Action[rhtasv1alpha1.TrillianRestore] {
+	return &restoreAction{}
+}
+
+type restoreAction struct {
+	action.BaseAction
+}
+
+func (i restoreAction) Name() string {
+	return "restore dump"
+}
+
+func (i restoreAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.TrillianRestore) bool {
+	c := meta.FindStatusCondition(instance.Status.Conditions, constants.Ready)
+	return c.Reason == constants.Creating && meta.IsStatusConditionTrue(instance.Status.Conditions, SignerCondition)
+}
+
+// Handle loads the dump to the database, the log signer stays paused when the restore fails.
+func (i restoreAction) Handle(ctx context.Context, instance *rhtasv1alpha1.TrillianRestore) *action.Result {
+	name := ResourceName(instance.Name)
+	job := &batchv1.Job{}
+	err := i.Client.Get(ctx, types.NamespacedName{Name: name, Namespace: instance.Namespace}, job)
+	switch {
+	case apierrors.IsNotFound(err):
+		t := &rhtasv1alpha1.Trillian{}
+		if err = i.Client.Get(ctx, types.NamespacedName{Name: instance.Spec.TrillianRef.Name, Namespace: instance.Namespace}, t); err != nil {
+			return fail(ctx, &i.BaseAction, instance, fmt.Errorf("could not get Trillian: %w", err))
+		}
+		backup := &rhtasv1alpha1.TrillianBackup{}
+		if err = i.Client.Get(ctx, types.NamespacedName{Name: instance.Spec.Source.BackupRef.Name, Namespace: instance.Namespace}, backup); err != nil {
+			return fail(ctx, &i.BaseAction, instance, fmt.Errorf("could not get TrillianBackup: %w", err))
+		}
+		labels := constants.LabelsFor(ComponentName, name, instance.Name)
+		if job, err = trillianUtils.CreateRestoreJob(t, backup, instance.Status.Dump.Name, constants.TrillianDbImage, name, constants.InstanceName(trillian.RBACName, t.Name), labels); err != nil {
+			return fail(ctx, &i.BaseAction, instance, err)
+		}
+		if err = controllerutil.SetControllerReference(instance, job, i.Client.Scheme()); err != nil {
+			return i.Failed(fmt.Errorf("could not set controller reference for restore Job: %w", err))
+		}
+		if err = i.Client.Create(ctx, job); err != nil {
+			return fail(ctx, &i.BaseAction, instance, fmt.Errorf("could not create restore Job: %w", err))
+		}
+		i.Recorder.Eventf(instance, v1.EventTypeNormal, "RestoreJobCreated", "Restoring dump %s", instance.Status.Dump.Location)
+		return i.Requeue()
+	case err != nil:
+		return i.Failed(err)
+	}
+
+	finished, err := trillianUtils.JobFinished(job)
+	if err != nil {
+		i.Recorder.Event(instance, v1.EventTypeWarning, "RestoreFailed", err.Error())
+		return fail(ctx, &i.BaseAction, instance, fmt.Errorf("%w, log signer stays paused until the TrillianRestore is deleted", err))
+	}
+	if !finished {
+		i.Logger.Info("Waiting for restore job", "name", job.Name)
+		return i.Requeue()
+	}
+
+	i.Recorder.Eventf(instance, v1.EventTypeNormal, "RestoreSucceeded", "Dump %s restored", instance.Status.Dump.Name)
+	now := metav1.Now()
+	instance.Status.CompletionTime = &now
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:    constants.Ready,
+		Status:  metav1.ConditionTrue,
+		Reason:  constants.Ready,
+		Message: "Dump " + instance.Status.Dump.Name + " restored",
+	})
+	return i.StatusUpdate(ctx, instance)
+}
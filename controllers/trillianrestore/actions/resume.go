@@ -0,0 +1,44 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/action"
+	"github.com/securesign/operator/controllers/constants"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func NewResumeSignerAction() action.Action[rhtasv1alpha1.TrillianRestore] {
+	return &resumeSignerAction{}
+}
+
+type resumeSignerAction struct {
+	action.BaseAction
+}
+
+func (i resumeSignerAction) Name() string {
+	return "resume log signer"
+}
+
+func (i resumeSignerAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.TrillianRestore) bool {
+	return meta.IsStatusConditionTrue(instance.Status.Conditions, constants.Ready) &&
+		meta.IsStatusConditionTrue(instance.Status.Conditions, SignerCondition)
+}
+
+func (i resumeSignerAction) Handle(ctx context.Context, instance *rhtasv1alpha1.TrillianRestore) *action.Result {
+	if err := ResumeSigner(ctx, i.Client, instance); err != nil {
+		return i.Failed(fmt.Errorf("could not resume log signer: %w", err))
+	}
+	i.Recorder.Event(instance, v1.EventTypeNormal, "LogSignerResumed", "Log signer resumed")
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:    SignerCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  constants.Ready,
+		Message: "Log signer resumed",
+	})
+	return i.StatusUpdate(ctx, instance)
+}
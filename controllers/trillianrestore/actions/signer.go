@@ -0,0 +1,23 @@
+package actions
+
+import (
+	"context"
+
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	trillian "github.com/securesign/operator/controllers/trillian/actions"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ResumeSigner removes the pause annotation of the restore from the Trillian instance.
+func ResumeSigner(ctx context.Context, cli client.Client, instance *rhtasv1alpha1.TrillianRestore) error {
+	t := &rhtasv1alpha1.Trillian{}
+	if err := cli.Get(ctx, types.NamespacedName{Name: instance.Spec.TrillianRef.Name, Namespace: instance.Namespace}, t); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if t.Annotations[trillian.PausedByRestoreAnnotation] != instance.Name {
+		return nil
+	}
+	delete(t.Annotations, trillian.PausedByRestoreAnnotation)
+	return cli.Update(ctx, t)
+}
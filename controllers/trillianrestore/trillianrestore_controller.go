@@ -0,0 +1,109 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trillianrestore
+
+import (
+	"context"
+
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/action"
+	"github.com/securesign/operator/controllers/trillianrestore/actions"
+	batchv1 "k8s.io/api/batch/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// finalizer resumes the log signer paused by the deleted restore
+	finalizer = "trillianrestore.rhtas.redhat.com"
+)
+
+// TrillianRestoreReconciler reconciles a TrillianRestore object
+type TrillianRestoreReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+//+kubebuilder:rbac:groups=rhtas.redhat.com,resources=trillianrestores,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=rhtas.redhat.com,resources=trillianrestores/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=rhtas.redhat.com,resources=trillianrestores/finalizers,verbs=update
+
+// Reconcile pauses the log signer, loads the dump to the Trillian database and resumes the log signer.
+func (r *TrillianRestoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	rlog := log.FromContext(ctx).WithName("controller").WithName("trillianrestore")
+	rlog.V(1).Info("Reconciling TrillianRestore", "request", req)
+
+	instance := &rhtasv1alpha1.TrillianRestore{}
+	if err := r.Client.Get(ctx, req.NamespacedName, instance); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+	target := instance.DeepCopy()
+
+	if instance.DeletionTimestamp != nil {
+		if err := actions.ResumeSigner(ctx, r.Client, target); err != nil {
+			return reconcile.Result{}, err
+		}
+		controllerutil.RemoveFinalizer(target, finalizer)
+		return ctrl.Result{}, r.Update(ctx, target)
+	}
+
+	if !controllerutil.ContainsFinalizer(target, finalizer) {
+		controllerutil.AddFinalizer(target, finalizer)
+		return ctrl.Result{}, r.Update(ctx, target)
+	}
+
+	acs := []action.Action[rhtasv1alpha1.TrillianRestore]{
+		actions.NewToPendingPhaseAction(),
+		actions.NewResolveDumpAction(),
+		actions.NewPauseSignerAction(),
+		actions.NewRestoreAction(),
+		actions.NewResumeSignerAction(),
+	}
+
+	for _, a := range acs {
+		a.InjectClient(r.Client)
+		a.InjectLogger(rlog.WithName(a.Name()))
+		a.InjectRecorder(r.Recorder)
+
+		if a.CanHandle(ctx, target) {
+			rlog.V(2).Info("Executing " + a.Name())
+			result := a.Handle(ctx, target)
+			if result != nil {
+				return result.Result, result.Err
+			}
+		}
+	}
+	return reconcile.Result{}, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *TrillianRestoreReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&rhtasv1alpha1.TrillianRestore{}).
+		Owns(&batchv1.Job{}).
+		Complete(r)
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trillianrestore
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/utils"
+	"github.com/securesign/operator/controllers/constants"
+	trillian "github.com/securesign/operator/controllers/trillian/actions"
+	backupUtils "github.com/securesign/operator/controllers/trillianbackup/utils"
+	"github.com/securesign/operator/controllers/trillianrestore/actions"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	k8sresource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+var _ = Describe("TrillianRestore controller", func() {
+	Context("TrillianRestore controller test", func() {
+
+		const (
+			Name      = "test-restore"
+			Namespace = "restore"
+		)
+
+		ctx := context.Background()
+
+		namespace := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      Namespace,
+				Namespace: Namespace,
+			},
+		}
+
+		trillianName := types.NamespacedName{Name: "trillian", Namespace: Namespace}
+
+		BeforeEach(func() {
+			By("Creating the Namespace to perform the tests")
+			err := k8sClient.Create(ctx, namespace)
+			Expect(err).To(Not(HaveOccurred()))
+		})
+
+		AfterEach(func() {
+			By("Deleting the Namespace to perform the tests")
+			_ = k8sClient.Delete(ctx, namespace)
+		})
+
+		// pausedBy returns the TrillianRestore pausing the log signer
+		pausedBy := func(g Gomega) string {
+			t := &v1alpha1.Trillian{}
+			g.Expect(k8sClient.Get(ctx, trillianName, t)).To(Succeed())
+			return t.Annotations[trillian.PausedByRestoreAnnotation]
+		}
+
+		// finishJob sets the result of the restore Job
+		finishJob := func(restore string, result batchv1.JobConditionType) {
+			job := &batchv1.Job{}
+			Eventually(func() error {
+				return k8sClient.Get(ctx, types.NamespacedName{Name: actions.ResourceName(restore), Namespace: Namespace}, job)
+			}).Should(Succeed())
+			Expect(job.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "DUMP_NAME", Value: "dump-b"}))
+			now := metav1.Now()
+			job.Status.StartTime = &now
+			if result == batchv1.JobComplete {
+				job.Status.CompletionTime = &now
+			}
+			job.Status.Conditions = []batchv1.JobCondition{{Type: result, Status: corev1.ConditionTrue, LastTransitionTime: now}}
+			Expect(k8sClient.Status().Update(ctx, job)).To(Succeed())
+		}
+
+		It("should successfully reconcile a custom resource for TrillianRestore", func() {
+			By("creating the Trillian instance")
+			t := &v1alpha1.Trillian{
+				ObjectMeta: metav1.ObjectMeta{Name: trillianName.Name, Namespace: Namespace},
+				Spec:       v1alpha1.TrillianSpec{Db: v1alpha1.TrillianDB{Create: utils.Pointer(true)}},
+			}
+			Expect(k8sClient.Create(ctx, t)).To(Succeed())
+			t.Status.Db.DatabaseSecretRef = &v1alpha1.LocalObjectReference{Name: "trillian-db"}
+			Expect(k8sClient.Status().Update(ctx, t)).To(Succeed())
+
+			By("creating the TrillianBackup with dumps in the history")
+			storage := k8sresource.MustParse("1Gi")
+			backup := &v1alpha1.TrillianBackup{
+				ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: Namespace},
+				Spec: v1alpha1.TrillianBackupSpec{
+					TrillianRef: v1alpha1.LocalObjectReference{Name: t.Name},
+					Schedule:    "0 3 * * *",
+					Target:      v1alpha1.BackupTarget{Pvc: &v1alpha1.Pvc{Size: &storage}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, backup)).To(Succeed())
+			now := metav1.Now()
+			earlier := metav1.NewTime(now.Add(-time.Hour))
+			backup.Status.PvcName = "trillian-backup-backup"
+			backup.Status.History = []v1alpha1.BackupRecord{
+				{Name: "dump-b", Location: "pvc://trillian-backup-backup/dump-b.sql.gz", Result: backupUtils.BackupSucceeded, StartTime: &now},
+				{Name: "dump-a", Location: "pvc://trillian-backup-backup/dump-a.sql.gz", Result: backupUtils.BackupSucceeded, StartTime: &earlier},
+			}
+			Expect(k8sClient.Status().Update(ctx, backup)).To(Succeed())
+
+			By("creating the custom resource for the Kind TrillianRestore")
+			newRestore := func(name string) *v1alpha1.TrillianRestore {
+				return &v1alpha1.TrillianRestore{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: Namespace},
+					Spec: v1alpha1.TrillianRestoreSpec{
+						TrillianRef: v1alpha1.LocalObjectReference{Name: t.Name},
+						Source:      v1alpha1.RestoreSource{BackupRef: v1alpha1.LocalObjectReference{Name: backup.Name}},
+					},
+				}
+			}
+			Expect(k8sClient.Create(ctx, newRestore(Name))).To(Succeed())
+
+			By("Log signer paused")
+			Eventually(pausedBy).Should(Equal(Name))
+			found := &v1alpha1.TrillianRestore{}
+			Eventually(func(g Gomega) bool {
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: Name, Namespace: Namespace}, found)).To(Succeed())
+				return meta.IsStatusConditionTrue(found.Status.Conditions, actions.SignerCondition)
+			}).Should(BeTrue())
+			Expect(controllerutil.ContainsFinalizer(found, finalizer)).To(BeTrue())
+			Expect(found.Status.Dump.Name).To(Equal("dump-b"))
+			Expect(found.Status.StartTime).ToNot(BeNil())
+
+			By("Restore Job succeeded")
+			finishJob(Name, batchv1.JobComplete)
+			Eventually(func(g Gomega) bool {
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: Name, Namespace: Namespace}, found)).To(Succeed())
+				return meta.IsStatusConditionTrue(found.Status.Conditions, constants.Ready)
+			}).Should(BeTrue())
+			Expect(found.Status.CompletionTime).ToNot(BeNil())
+
+			By("Log signer resumed")
+			Eventually(pausedBy).Should(BeEmpty())
+			Eventually(func(g Gomega) bool {
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: Name, Namespace: Namespace}, found)).To(Succeed())
+				return meta.IsStatusConditionFalse(found.Status.Conditions, actions.SignerCondition)
+			}).Should(BeTrue())
+
+			By("Failed restore keeps the log signer paused")
+			const failed = Name + "-failed"
+			Expect(k8sClient.Create(ctx, newRestore(failed))).To(Succeed())
+			Eventually(pausedBy).Should(Equal(failed))
+			finishJob(failed, batchv1.JobFailed)
+			Eventually(func(g Gomega) string {
+				g.Expect(k8sClient.Get(ctx, types.NamespacedName{Name: failed, Namespace: Namespace}, found)).To(Succeed())
+				return meta.FindStatusCondition(found.Status.Conditions, constants.Ready).Reason
+			}).Should(Equal(constants.Failure))
+			Consistently(pausedBy, 2*time.Second).Should(Equal(failed))
+
+			By("Finalizer resumes the log signer of the deleted restore")
+			Expect(k8sClient.Delete(ctx, found)).To(Succeed())
+			Eventually(pausedBy).Should(BeEmpty())
+			Eventually(func() bool {
+				return apierrors.IsNotFound(k8sClient.Get(ctx, types.NamespacedName{Name: failed, Namespace: Namespace}, &v1alpha1.TrillianRestore{}))
+			}).Should(BeTrue())
+		})
+	})
+})
@@ -4,4 +4,9 @@ const (
 	ComponentName  = "tuf"
 	DeploymentName = "tuf"
 	RBACName       = "tuf"
+	RepositoryName = "tuf-repository"
+	RootStagedName = "tuf-root-staged"
+
+	RepositoryCondition       = "Repository"
+	MetadataExpiringCondition = "MetadataExpiring"
 )
@@ -6,6 +6,7 @@ import (
 
 	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
 	"github.com/securesign/operator/controllers/common/action"
+	k8sutils "github.com/securesign/operator/controllers/common/utils/kubernetes"
 	"github.com/securesign/operator/controllers/constants"
 	tufutils "github.com/securesign/operator/controllers/tuf/utils"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -38,7 +39,11 @@ func (i deployAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Tuf) *
 
 	labels := constants.LabelsFor(ComponentName, DeploymentName, instance.Name)
 
-	dp := tufutils.CreateTufDeployment(instance, DeploymentName, RBACName, labels)
+	repository, err := k8sutils.GetConfigMap(ctx, i.Client, instance.Namespace, constants.InstanceName(RepositoryName, instance.Name))
+	if err != nil {
+		return i.Failed(fmt.Errorf("could not find TUF repository: %w", err))
+	}
+	dp := tufutils.CreateTufDeployment(instance, constants.InstanceName(DeploymentName, instance.Name), constants.InstanceName(RBACName, instance.Name), labels, repository)
 
 	if err = controllerutil.SetControllerReference(instance, dp, i.Client.Scheme()); err != nil {
 		return i.Failed(fmt.Errorf("could not set controller reference for Deployment: %w", err))
@@ -0,0 +1,83 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/action"
+	"github.com/securesign/operator/controllers/constants"
+	tufutils "github.com/securesign/operator/controllers/tuf/utils"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func NewExpirationAction() action.Action[rhtasv1alpha1.Tuf] {
+	return &expirationAction{}
+}
+
+type expirationAction struct {
+	action.BaseAction
+}
+
+func (i expirationAction) Name() string {
+	return "track expiration"
+}
+
+func (i expirationAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.Tuf) bool {
+	return meta.IsStatusConditionTrue(instance.Status.Conditions, constants.Ready) && instance.Status.Metadata != nil
+}
+
+// Handle reports the root and targets metadata close to expiration and requeues the reconcile
+// when the snapshot and timestamp metadata are due to be signed again or the next expiration needs to be reported.
+func (i expirationAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Tuf) *action.Result {
+	now := time.Now()
+	expiration := tufutils.ExpirationFor(instance.Spec.Expiration)
+	metadata := map[string]tufutils.RoleMetadata{
+		tufutils.RootRole:      roleMetadata(instance.Status.Metadata.Root),
+		tufutils.TargetsRole:   roleMetadata(instance.Status.Metadata.Targets),
+		tufutils.SnapshotRole:  roleMetadata(instance.Status.Metadata.Snapshot),
+		tufutils.TimestampRole: roleMetadata(instance.Status.Metadata.Timestamp),
+	}
+
+	condition := metav1.Condition{
+		Type:   MetadataExpiringCondition,
+		Status: metav1.ConditionFalse,
+		Reason: constants.Ready,
+	}
+	if expiring := expiration.Expiring(metadata, now); len(expiring) > 0 {
+		messages := make([]string, len(expiring))
+		for j, role := range expiring {
+			state := "expires"
+			if !now.Before(metadata[role].Expires) {
+				state = "expired"
+			}
+			messages[j] = fmt.Sprintf("%s metadata %s at %s", role, state, metadata[role].Expires.UTC().Format(time.RFC3339))
+		}
+		condition = metav1.Condition{
+			Type:    MetadataExpiringCondition,
+			Status:  metav1.ConditionTrue,
+			Reason:  "Expiring",
+			Message: strings.Join(messages, ", ") + ", sign new versions with the offline keys",
+		}
+	}
+
+	if c := meta.FindStatusCondition(instance.Status.Conditions, MetadataExpiringCondition); c == nil ||
+		c.Status != condition.Status || c.Message != condition.Message {
+		if condition.Status == metav1.ConditionTrue {
+			i.Recorder.Event(instance, v1.EventTypeWarning, "MetadataExpiring", condition.Message)
+		}
+		meta.SetStatusCondition(&instance.Status.Conditions, condition)
+		// status update triggers next reconcile which schedules next check
+		return i.StatusUpdate(ctx, instance)
+	}
+
+	if next := expiration.NextCheck(metadata, now); !next.IsZero() {
+		return &action.Result{Result: reconcile.Result{RequeueAfter: next.Sub(now)}}
+	}
+	return i.Continue()
+}
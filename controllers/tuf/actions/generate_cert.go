@@ -13,6 +13,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/meta"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func NewResolveKeysAction() action.Action[rhtasv1alpha1.Tuf] {
@@ -38,13 +39,8 @@ func (i resolveKeysAction) CanHandle(ctx context.Context, instance *rhtasv1alpha
 	}
 	for index, k := range instance.Spec.Keys {
 		if k.SecretRef == nil {
-			if scr, _ := k8sutils.FindSecret(ctx, i.Client, instance.Namespace, fmt.Sprintf("%s/%s", constants.LabelNamespace, k.Name)); scr != nil {
-				if instance.Status.Keys[index].SecretRef == nil ||
-					instance.Status.Keys[index].SecretRef.Name != scr.Name ||
-					instance.Status.Keys[index].SecretRef.Key != scr.Labels[fmt.Sprintf("%s/%s", constants.LabelNamespace, k.Name)] {
-					return true
-				}
-			} else {
+			ref, err := i.discoverSecret(ctx, instance, &k)
+			if err != nil || instance.Status.Keys[index].SecretRef == nil || *instance.Status.Keys[index].SecretRef != *ref {
 				return true
 			}
 		}
@@ -104,7 +100,7 @@ func (i resolveKeysAction) Handle(ctx context.Context, instance *rhtasv1alpha1.T
 func (i resolveKeysAction) handleKey(ctx context.Context, instance *rhtasv1alpha1.Tuf, key *rhtasv1alpha1.TufKey) (*rhtasv1alpha1.TufKey, error) {
 	switch {
 	case key.SecretRef == nil:
-		sks, err := i.discoverSecret(ctx, instance.Namespace, key)
+		sks, err := i.discoverSecret(ctx, instance, key)
 		if err != nil {
 			return nil, err
 		}
@@ -117,7 +113,52 @@ func (i resolveKeysAction) handleKey(ctx context.Context, instance *rhtasv1alpha
 	}
 }
 
-func (i resolveKeysAction) discoverSecret(ctx context.Context, namespace string, key *rhtasv1alpha1.TufKey) (*rhtasv1alpha1.SecretKeySelector, error) {
+// discoverSecret finds the Secret of the key which isn't referenced explicitly.
+func (i resolveKeysAction) discoverSecret(ctx context.Context, instance *rhtasv1alpha1.Tuf, key *rhtasv1alpha1.TufKey) (*rhtasv1alpha1.SecretKeySelector, error) {
+	if instance.Spec.KeyDiscovery == rhtasv1alpha1.KeyDiscoveryLabels {
+		return i.labeledSecret(ctx, instance.Namespace, key)
+	}
+	return i.statusSecret(ctx, instance, key)
+}
+
+// statusSecret returns the key published in the status of the component managed by the same Securesign.
+func (i resolveKeysAction) statusSecret(ctx context.Context, instance *rhtasv1alpha1.Tuf, key *rhtasv1alpha1.TufKey) (*rhtasv1alpha1.SecretKeySelector, error) {
+	var (
+		component client.Object
+		ref       func() *rhtasv1alpha1.SecretKeySelector
+	)
+	switch key.Name {
+	case "fulcio_v1.crt.pem":
+		fulcio := &rhtasv1alpha1.Fulcio{}
+		component, ref = fulcio, func() *rhtasv1alpha1.SecretKeySelector {
+			if fulcio.Status.Certificate == nil {
+				return nil
+			}
+			return fulcio.Status.Certificate.CARef
+		}
+	case "ctfe.pub":
+		ctlog := &rhtasv1alpha1.CTlog{}
+		component, ref = ctlog, func() *rhtasv1alpha1.SecretKeySelector { return ctlog.Status.PublicKeyRef }
+	case "rekor.pub":
+		rekor := &rhtasv1alpha1.Rekor{}
+		component, ref = rekor, func() *rhtasv1alpha1.SecretKeySelector { return rekor.Status.PublicKeyRef }
+	default:
+		return nil, fmt.Errorf("unable to resolve %s key, set secret reference", key.Name)
+	}
+
+	if err := k8sutils.GetSibling(ctx, i.Client, instance, component); err != nil {
+		if errors.Is(err, k8sutils.ErrNoController) {
+			return nil, fmt.Errorf("unable to resolve %s key, set secret reference or use Labels key discovery: %w", key.Name, err)
+		}
+		return nil, fmt.Errorf("unable to resolve %s key: %w", key.Name, err)
+	}
+	if r := ref(); r != nil {
+		return r.DeepCopy(), nil
+	}
+	return nil, fmt.Errorf("%s key is not published in %s status yet", key.Name, component.GetName())
+}
+
+func (i resolveKeysAction) labeledSecret(ctx context.Context, namespace string, key *rhtasv1alpha1.TufKey) (*rhtasv1alpha1.SecretKeySelector, error) {
 	labelName := constants.LabelNamespace + "/" + key.Name
 	s, err := k8sutils.FindSecret(ctx, i.Client, namespace, labelName)
 	if err != nil {
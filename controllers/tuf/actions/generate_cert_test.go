@@ -8,12 +8,15 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/securesign/operator/api/v1alpha1"
 	common "github.com/securesign/operator/controllers/common/action"
+	testaction "github.com/securesign/operator/controllers/common/test/action"
 	"github.com/securesign/operator/controllers/common/utils/kubernetes"
 	"github.com/securesign/operator/controllers/constants"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
 var testAction = resolveKeysAction{
@@ -31,7 +34,7 @@ func TestKeyAutogenerate(t *testing.T) {
 
 	testAction.Client.Create(testContext, kubernetes.CreateSecret("testSecret", t.Name(),
 		map[string][]byte{"key": nil}, map[string]string{constants.LabelNamespace + "/rekor.pub": "key"}))
-	instance := &v1alpha1.Tuf{Spec: v1alpha1.TufSpec{Keys: []v1alpha1.TufKey{
+	instance := &v1alpha1.Tuf{Spec: v1alpha1.TufSpec{KeyDiscovery: v1alpha1.KeyDiscoveryLabels, Keys: []v1alpha1.TufKey{
 		{
 			Name: "rekor.pub",
 		},
@@ -125,7 +128,7 @@ func TestKeyDelete(t *testing.T) {
 	testAction.Client.Create(testContext, kubernetes.CreateSecret("new", t.Name(),
 		map[string][]byte{"key": nil}, map[string]string{constants.LabelNamespace + "/ctfe.pub": "key"}))
 	instance := &v1alpha1.Tuf{
-		Spec: v1alpha1.TufSpec{Keys: []v1alpha1.TufKey{
+		Spec: v1alpha1.TufSpec{KeyDiscovery: v1alpha1.KeyDiscoveryLabels, Keys: []v1alpha1.TufKey{
 			{
 				Name:      "ctfe.pub",
 				SecretRef: nil,
@@ -158,3 +161,55 @@ func TestKeyDelete(t *testing.T) {
 
 	g.Expect(meta.IsStatusConditionTrue(instance.Status.Conditions, "ctfe.pub")).To(BeTrue())
 }
+
+func TestKeyStatus(t *testing.T) {
+	g := NewWithT(t)
+	owner := &v1alpha1.Securesign{ObjectMeta: metav1.ObjectMeta{Name: "securesign", Namespace: "default", UID: "securesign-uid"}}
+	scheme := testaction.FakeClientBuilder().Build().Scheme()
+	controlled := func(obj client.Object) client.Object {
+		g.Expect(controllerutil.SetControllerReference(owner, obj, scheme)).To(Succeed())
+		return obj
+	}
+	ref := &v1alpha1.SecretKeySelector{LocalObjectReference: v1alpha1.LocalObjectReference{Name: "rekor-public"}, Key: "public"}
+	c := testaction.FakeClientBuilder().WithObjects(
+		controlled(&v1alpha1.Rekor{ObjectMeta: metav1.ObjectMeta{Name: "securesign", Namespace: "default"}, Status: v1alpha1.RekorStatus{PublicKeyRef: ref}}),
+		controlled(&v1alpha1.CTlog{ObjectMeta: metav1.ObjectMeta{Name: "securesign", Namespace: "default"}}),
+		// labeled secrets of other instances are ignored
+		kubernetes.CreateSecret("other", "default", map[string][]byte{"key": nil}, map[string]string{constants.LabelNamespace + "/rekor.pub": "key"}),
+		kubernetes.CreateSecret("another", "default", map[string][]byte{"key": nil}, map[string]string{constants.LabelNamespace + "/rekor.pub": "key"}),
+	).Build()
+	a := testaction.PrepareAction(c, NewResolveKeysAction())
+
+	instance := controlled(&v1alpha1.Tuf{
+		ObjectMeta: metav1.ObjectMeta{Name: "securesign", Namespace: "default"},
+		Spec: v1alpha1.TufSpec{KeyDiscovery: v1alpha1.KeyDiscoveryStatus, Keys: []v1alpha1.TufKey{
+			{
+				Name: "rekor.pub",
+			},
+			{
+				Name: "ctfe.pub",
+			},
+		}},
+		Status: v1alpha1.TufStatus{Conditions: []metav1.Condition{
+			{
+				Type:   constants.Ready,
+				Reason: constants.Pending,
+				Status: metav1.ConditionFalse,
+			},
+		}}}).(*v1alpha1.Tuf)
+	g.Expect(a.CanHandle(testContext, instance)).To(BeTrue())
+	a.Handle(testContext, instance)
+
+	// the CTlog didn't publish its key yet
+	g.Expect(instance.Status.Keys).To(HaveLen(1))
+	g.Expect(instance.Status.Keys[0].SecretRef).To(Equal(ref))
+	g.Expect(meta.IsStatusConditionTrue(instance.Status.Conditions, "rekor.pub")).To(BeTrue())
+	g.Expect(meta.FindStatusCondition(instance.Status.Conditions, "ctfe.pub").Message).To(ContainSubstring("not published"))
+
+	// keys of a Tuf without owner must be referenced explicitly
+	instance.OwnerReferences = nil
+	instance.Status.Keys = nil
+	a.Handle(testContext, instance)
+	g.Expect(instance.Status.Keys).To(BeEmpty())
+	g.Expect(meta.FindStatusCondition(instance.Status.Conditions, "rekor.pub").Message).To(ContainSubstring("Labels key discovery"))
+}
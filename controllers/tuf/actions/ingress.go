@@ -35,7 +35,7 @@ func (i ingressAction) CanHandle(_ context.Context, tuf *rhtasv1alpha1.Tuf) bool
 
 func (i ingressAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Tuf) *action.Result {
 	var updated bool
-	ok := types.NamespacedName{Name: DeploymentName, Namespace: instance.Namespace}
+	ok := types.NamespacedName{Name: constants.InstanceName(DeploymentName, instance.Name), Namespace: instance.Namespace}
 	labels := constants.LabelsFor(ComponentName, DeploymentName, instance.Name)
 
 	svc := &v1.Service{}
@@ -0,0 +1,25 @@
+package actions
+
+import (
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common"
+	"github.com/securesign/operator/controllers/constants"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+// LegacyNames lists the objects created before the names were scoped by the instance.
+// The signed repository, the staged root and the Ingress host are kept, the other objects are created again under the new names.
+func LegacyNames(instance *rhtasv1alpha1.Tuf) []common.LegacyName {
+	return []common.LegacyName{
+		{Object: &v1.ServiceAccount{}, Name: RBACName},
+
+		{Object: &v1.ConfigMap{}, Name: RepositoryName, Rename: constants.InstanceName(RepositoryName, instance.Name)},
+		{Object: &v1.ConfigMap{}, Name: RootStagedName, Rename: constants.InstanceName(RootStagedName, instance.Name)},
+
+		{Object: &appsv1.Deployment{}, Name: DeploymentName},
+		{Object: &v1.Service{}, Name: DeploymentName},
+		{Object: &networkingv1.Ingress{}, Name: DeploymentName, Rename: constants.InstanceName(DeploymentName, instance.Name), DeleteFirst: true},
+	}
+}
@@ -0,0 +1,69 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/action"
+	"github.com/securesign/operator/controllers/common/utils"
+	k8sutils "github.com/securesign/operator/controllers/common/utils/kubernetes"
+	"github.com/securesign/operator/controllers/constants"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const PvcNameFormat = "tuf-%s-pvc"
+
+func NewCreatePvcAction() action.Action[rhtasv1alpha1.Tuf] {
+	return &createPvcAction{}
+}
+
+type createPvcAction struct {
+	action.BaseAction
+}
+
+func (i createPvcAction) Name() string {
+	return "create PVC"
+}
+
+func (i createPvcAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.Tuf) bool {
+	c := meta.FindStatusCondition(instance.Status.Conditions, constants.Ready)
+	return c.Reason == constants.Creating && instance.Spec.Repository.Type == rhtasv1alpha1.TufRepositoryPVC && instance.Status.PvcName == ""
+}
+
+func (i createPvcAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Tuf) *action.Result {
+	var err error
+	if instance.Spec.Repository.Pvc.Name != "" {
+		instance.Status.PvcName = instance.Spec.Repository.Pvc.Name
+		return i.StatusUpdate(ctx, instance)
+	}
+
+	if instance.Spec.Repository.Pvc.Size == nil {
+		return i.Failed(fmt.Errorf("PVC size is not set"))
+	}
+
+	i.Logger.V(1).Info("Creating new PVC")
+	pvc := k8sutils.CreatePVC(instance.Namespace, fmt.Sprintf(PvcNameFormat, instance.Name), *instance.Spec.Repository.Pvc.Size,
+		instance.Spec.Repository.Pvc.StorageClass, constants.LabelsFor(ComponentName, DeploymentName, instance.Name))
+	if !utils.OptionalBool(instance.Spec.Repository.Pvc.Retain) {
+		if err = controllerutil.SetControllerReference(instance, pvc, i.Client.Scheme()); err != nil {
+			return i.Failed(fmt.Errorf("could not set controller reference for PVC: %w", err))
+		}
+	}
+
+	if _, err = i.Ensure(ctx, pvc); err != nil {
+		meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+			Type:    constants.Ready,
+			Status:  metav1.ConditionFalse,
+			Reason:  constants.Failure,
+			Message: err.Error(),
+		})
+		return i.FailedWithStatusUpdate(ctx, fmt.Errorf("could not create TUF PVC: %w", err), instance)
+	}
+	i.Recorder.Event(instance, v1.EventTypeNormal, "PersistentVolumeCreated", "New PersistentVolume created")
+	instance.Status.PvcName = pvc.Name
+	return i.StatusUpdate(ctx, instance)
+}
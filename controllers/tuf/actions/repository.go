@@ -0,0 +1,289 @@
+package actions
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/action"
+	k8sutils "github.com/securesign/operator/controllers/common/utils/kubernetes"
+	"github.com/securesign/operator/controllers/constants"
+	tufutils "github.com/securesign/operator/controllers/tuf/utils"
+	"github.com/theupdateframework/go-tuf/data"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+func NewRepositoryAction() action.Action[rhtasv1alpha1.Tuf] {
+	return &repositoryAction{}
+}
+
+type repositoryAction struct {
+	action.BaseAction
+}
+
+func (i repositoryAction) Name() string {
+	return "sign repository"
+}
+
+func (i repositoryAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.Tuf) bool {
+	c := meta.FindStatusCondition(instance.Status.Conditions, constants.Ready)
+	return (c.Reason == constants.Creating || c.Reason == constants.Ready) && instance.Status.RoleKeys != nil
+}
+
+// Handle signs the TUF metadata of the resolved keys and stores the repository in a ConfigMap.
+// The published metadata is kept as long as the keys and targets don't change.
+// A new root version waiting for signatures is stored in the staged root ConfigMap.
+func (i repositoryAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Tuf) *action.Result {
+	targets := make(map[string][]byte, len(instance.Status.Keys))
+	for _, key := range instance.Status.Keys {
+		if key.SecretRef == nil {
+			return i.failed(ctx, instance, fmt.Errorf("%s key is not resolved", key.Name))
+		}
+		content, err := k8sutils.GetSecretData(i.Client, instance.Namespace, key.SecretRef)
+		if err != nil {
+			return i.failed(ctx, instance, fmt.Errorf("could not read %s: %w", key.Name, err))
+		}
+		targets[key.Name] = content
+	}
+
+	signers := make(tufutils.RoleSigners, len(tufutils.Roles))
+	for _, role := range tufutils.Roles {
+		if role == tufutils.RootRole {
+			continue
+		}
+		ref := *roleKey(instance.Status.RoleKeys, role)
+		if ref == nil {
+			return i.failed(ctx, instance, fmt.Errorf("%s key is not resolved", role))
+		}
+		content, err := k8sutils.GetSecretData(i.Client, instance.Namespace, ref)
+		if err != nil {
+			return i.failed(ctx, instance, fmt.Errorf("could not read %s key: %w", role, err))
+		}
+		if signers[role], err = tufutils.ParseRoleKey(content); err != nil {
+			return i.failed(ctx, instance, fmt.Errorf("invalid %s key: %w", role, err))
+		}
+	}
+
+	if instance.Status.RoleKeys.Root == nil {
+		return i.failed(ctx, instance, fmt.Errorf("%s key is not resolved", tufutils.RootRole))
+	}
+	rootKeys := append([]rhtasv1alpha1.SecretKeySelector{*instance.Status.RoleKeys.Root}, instance.Spec.Root.Keys...)
+	root, err := i.rootSigning(ctx, instance, rootKeys)
+	if err != nil {
+		return i.failed(ctx, instance, err)
+	}
+	staged := &v1.ConfigMap{}
+	if err = i.Client.Get(ctx, types.NamespacedName{Name: constants.InstanceName(RootStagedName, instance.Name), Namespace: instance.Namespace}, staged); err == nil {
+		if content, ok := staged.Data[tufutils.RootRole+".json"]; ok {
+			root.Staged = []byte(content)
+		}
+	} else if !apierrors.IsNotFound(err) {
+		return i.Failed(err)
+	}
+	stagedContent := root.Staged
+
+	cm := &v1.ConfigMap{}
+	exists := true
+	if err := i.Client.Get(ctx, types.NamespacedName{Name: constants.InstanceName(RepositoryName, instance.Name), Namespace: instance.Namespace}, cm); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return i.Failed(err)
+		}
+		exists = false
+		cm = k8sutils.InitConfigmap(instance.Namespace, constants.InstanceName(RepositoryName, instance.Name), constants.LabelsFor(ComponentName, DeploymentName, instance.Name), nil)
+	}
+	repository, err := tufutils.RepositoryFromConfigMap(cm.Data, cm.BinaryData)
+	if err != nil {
+		return i.failed(ctx, instance, err)
+	}
+	updated, err := repository.Update(targets, signers, root, tufutils.ExpirationFor(instance.Spec.Expiration), time.Now())
+	if err != nil {
+		return i.failed(ctx, instance, err)
+	}
+
+	if len(updated) > 0 {
+		cm.Data, cm.BinaryData = repository.ConfigMapData()
+		if err = controllerutil.SetControllerReference(instance, cm, i.Client.Scheme()); err != nil {
+			return i.Failed(fmt.Errorf("could not set controller reference for ConfigMap: %w", err))
+		}
+		if exists {
+			err = i.Client.Update(ctx, cm)
+		} else {
+			err = i.Client.Create(ctx, cm)
+		}
+		if err != nil {
+			return i.failed(ctx, instance, fmt.Errorf("could not store TUF repository: %w", err))
+		}
+		i.Recorder.Eventf(instance, v1.EventTypeNormal, "RepositoryUpdated", "TUF metadata signed: %s", strings.Join(updated, ", "))
+	}
+
+	rootStatus := &rhtasv1alpha1.TufRootStatus{Keys: rootKeys}
+	if root.Staged == nil || !bytes.Equal(root.Staged, stagedContent) {
+		if err = i.stage(ctx, instance, staged, root.Staged); err != nil {
+			return i.failed(ctx, instance, fmt.Errorf("could not store staged root: %w", err))
+		}
+	}
+	if root.Staged != nil {
+		m, err := tufutils.ParseMetadata(root.Staged)
+		if err != nil {
+			return i.failed(ctx, instance, err)
+		}
+		rootStatus.StagedVersion, rootStatus.Signatures = m.Version, root.StagedSignatures
+		// the published root keys keep signing the staged root until it's published
+		if instance.Status.Root != nil && len(instance.Status.Root.Keys) > 0 {
+			rootStatus.Keys = instance.Status.Root.Keys
+		}
+	}
+
+	metadata, err := repository.Metadata()
+	if err != nil {
+		return i.failed(ctx, instance, err)
+	}
+	status := &rhtasv1alpha1.TufMetadataStatus{
+		Root:      roleMetadataStatus(metadata[tufutils.RootRole]),
+		Targets:   roleMetadataStatus(metadata[tufutils.TargetsRole]),
+		Snapshot:  roleMetadataStatus(metadata[tufutils.SnapshotRole]),
+		Timestamp: roleMetadataStatus(metadata[tufutils.TimestampRole]),
+	}
+	rootStatus.PublishedVersion = status.Root.Version
+	condition := metav1.Condition{
+		Type:    RepositoryCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  constants.Ready,
+		Message: fmt.Sprintf("Root version %d", rootStatus.PublishedVersion),
+	}
+	switch {
+	case rootStatus.PublishedVersion == 0:
+		condition.Status, condition.Reason = metav1.ConditionFalse, constants.Pending
+		condition.Message = fmt.Sprintf("Root version %d is waiting for signatures", rootStatus.StagedVersion)
+	case rootStatus.StagedVersion > 0:
+		condition.Message += fmt.Sprintf(", version %d is waiting for signatures", rootStatus.StagedVersion)
+	}
+
+	if equality.Semantic.DeepEqual(status, instance.Status.Metadata) && equality.Semantic.DeepEqual(rootStatus, instance.Status.Root) &&
+		equalCondition(meta.FindStatusCondition(instance.Status.Conditions, RepositoryCondition), condition) {
+		return i.Continue()
+	}
+	instance.Status.Metadata = status
+	instance.Status.Root = rootStatus
+	meta.SetStatusCondition(&instance.Status.Conditions, condition)
+	return i.StatusUpdate(ctx, instance)
+}
+
+// rootSigning reads the root keys and the signatures of the staged root.
+// The keys of the published root sign the staged root as long as the operator can read them.
+func (i repositoryAction) rootSigning(ctx context.Context, instance *rhtasv1alpha1.Tuf, rootKeys []rhtasv1alpha1.SecretKeySelector) (*tufutils.RootSigning, error) {
+	root := &tufutils.RootSigning{
+		// the threshold is unset in the resources created before it was introduced
+		Threshold:          max(instance.Spec.Root.Threshold, 1),
+		RequiredSignatures: instance.Spec.Root.RequiredSignatures,
+	}
+	for _, ref := range rootKeys {
+		content, err := k8sutils.GetSecretData(i.Client, instance.Namespace, &ref)
+		if err != nil {
+			return nil, fmt.Errorf("could not read root key: %w", err)
+		}
+		public, signer, err := tufutils.ParseRootKey(content)
+		if err != nil {
+			return nil, fmt.Errorf("invalid root key %s/%s: %w", ref.Name, ref.Key, err)
+		}
+		root.Keys = append(root.Keys, public)
+		if signer != nil {
+			root.Signers = append(root.Signers, signer)
+		}
+	}
+	if instance.Status.Root != nil {
+		for _, ref := range instance.Status.Root.Keys {
+			content, err := k8sutils.GetSecretData(i.Client, instance.Namespace, &ref)
+			if err != nil {
+				i.Logger.Info("published root key is not available", "secret", ref.Name, "key", ref.Key, "error", err.Error())
+				continue
+			}
+			if _, signer, err := tufutils.ParseRootKey(content); err == nil && signer != nil {
+				root.Signers = append(root.Signers, signer)
+			}
+		}
+	}
+	for _, ref := range instance.Spec.Root.Signatures {
+		content, err := k8sutils.GetSecretData(i.Client, instance.Namespace, &ref)
+		if err != nil {
+			return nil, fmt.Errorf("could not read root signature: %w", err)
+		}
+		signature := data.Signature{}
+		if err = json.Unmarshal(content, &signature); err != nil {
+			return nil, fmt.Errorf("invalid root signature %s/%s: %w", ref.Name, ref.Key, err)
+		}
+		root.Signatures = append(root.Signatures, signature)
+	}
+	return root, nil
+}
+
+// stage stores the root metadata waiting for signatures in a ConfigMap, so it can be signed outside the operator.
+// The ConfigMap is removed when no root version is staged.
+func (i repositoryAction) stage(ctx context.Context, instance *rhtasv1alpha1.Tuf, cm *v1.ConfigMap, content []byte) error {
+	if content == nil {
+		if cm.Name == "" {
+			return nil
+		}
+		return client.IgnoreNotFound(i.Client.Delete(ctx, cm))
+	}
+
+	m, err := tufutils.ParseMetadata(content)
+	if err != nil {
+		return err
+	}
+	stagedData := map[string]string{tufutils.RootRole + ".json": string(content)}
+	if cm.Name == "" {
+		cm = k8sutils.InitConfigmap(instance.Namespace, constants.InstanceName(RootStagedName, instance.Name), constants.LabelsFor(ComponentName, DeploymentName, instance.Name), stagedData)
+		if err = controllerutil.SetControllerReference(instance, cm, i.Client.Scheme()); err != nil {
+			return fmt.Errorf("could not set controller reference for ConfigMap: %w", err)
+		}
+		err = i.Client.Create(ctx, cm)
+	} else {
+		cm.Data = stagedData
+		err = i.Client.Update(ctx, cm)
+	}
+	if err != nil {
+		return err
+	}
+	i.Recorder.Eventf(instance, v1.EventTypeNormal, "RootStaged", "TUF root version %d staged in ConfigMap %s", m.Version, constants.InstanceName(RootStagedName, instance.Name))
+	return nil
+}
+
+func (i repositoryAction) failed(ctx context.Context, instance *rhtasv1alpha1.Tuf, err error) *action.Result {
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:    RepositoryCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  constants.Failure,
+		Message: err.Error(),
+	})
+	return i.FailedWithStatusUpdate(ctx, fmt.Errorf("could not sign TUF repository: %w", err), instance)
+}
+
+func equalCondition(c *metav1.Condition, expected metav1.Condition) bool {
+	return c != nil && c.Status == expected.Status && c.Reason == expected.Reason && c.Message == expected.Message
+}
+
+func roleMetadataStatus(metadata tufutils.RoleMetadata) rhtasv1alpha1.TufRoleMetadataStatus {
+	return rhtasv1alpha1.TufRoleMetadataStatus{
+		Version: metadata.Version,
+		Expires: metav1.NewTime(metadata.Expires),
+	}
+}
+
+func roleMetadata(status rhtasv1alpha1.TufRoleMetadataStatus) tufutils.RoleMetadata {
+	return tufutils.RoleMetadata{
+		Version: status.Version,
+		Expires: status.Expires.Time,
+	}
+}
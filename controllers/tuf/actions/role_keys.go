@@ -0,0 +1,122 @@
+package actions
+
+import (
+	"context"
+	"fmt"
+
+	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common/action"
+	k8sutils "github.com/securesign/operator/controllers/common/utils/kubernetes"
+	"github.com/securesign/operator/controllers/constants"
+	tufutils "github.com/securesign/operator/controllers/tuf/utils"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const roleKeysSecretNameFormat = "tuf-%s-keys-"
+
+func NewRoleKeysAction() action.Action[rhtasv1alpha1.Tuf] {
+	return &roleKeysAction{}
+}
+
+type roleKeysAction struct {
+	action.BaseAction
+}
+
+func (i roleKeysAction) Name() string {
+	return "resolve role keys"
+}
+
+func (i roleKeysAction) CanHandle(_ context.Context, instance *rhtasv1alpha1.Tuf) bool {
+	c := meta.FindStatusCondition(instance.Status.Conditions, constants.Ready)
+	if c.Reason != constants.Creating && c.Reason != constants.Ready {
+		return false
+	}
+	return instance.Status.RoleKeys == nil || !equality.Semantic.DeepDerivative(instance.Spec.RoleKeys, *instance.Status.RoleKeys)
+}
+
+// Handle resolves the keys of the roles, the keys which are not set in the spec are generated.
+// The generated keys are stored in a new immutable Secret, a key referenced by the status is kept only while its Secret holds it.
+func (i roleKeysAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Tuf) *action.Result {
+	resolved := &rhtasv1alpha1.TufRoleKeys{}
+	if instance.Status.RoleKeys != nil {
+		resolved = instance.Status.RoleKeys.DeepCopy()
+	}
+
+	data := make(map[string][]byte)
+	for _, role := range tufutils.Roles {
+		spec, status := roleKey(&instance.Spec.RoleKeys, role), roleKey(resolved, role)
+		switch {
+		case *spec != nil:
+			*status = *spec
+			continue
+		case *status != nil:
+			err := i.validate(instance.Namespace, *status)
+			if err == nil {
+				continue
+			}
+			// the metadata can't be signed with a lost key, a new key is generated
+			i.Recorder.Eventf(instance, v1.EventTypeWarning, "RoleKeyInvalid", "TUF %s key is not usable, generating a new one: %v", role, err)
+		}
+		key, err := tufutils.GenerateRoleKey()
+		if err != nil {
+			return i.Failed(fmt.Errorf("could not generate %s key: %w", role, err))
+		}
+		data[role] = key
+	}
+
+	if len(data) > 0 {
+		labels := constants.LabelsFor(ComponentName, DeploymentName, instance.Name)
+		secret := k8sutils.CreateImmutableSecret(fmt.Sprintf(roleKeysSecretNameFormat, instance.Name), instance.Namespace, data, labels)
+		if err := controllerutil.SetControllerReference(instance, secret, i.Client.Scheme()); err != nil {
+			return i.Failed(fmt.Errorf("could not set controller reference for Secret: %w", err))
+		}
+		// the Secret name is generated for every key set, so the keys are never written over an existing Secret
+		if err := i.Client.Create(ctx, secret); err != nil {
+			meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+				Type:    RepositoryCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  constants.Failure,
+				Message: err.Error(),
+			})
+			return i.FailedWithStatusUpdate(ctx, fmt.Errorf("could not create TUF keys secret: %w", err), instance)
+		}
+		for role := range data {
+			*roleKey(resolved, role) = &rhtasv1alpha1.SecretKeySelector{
+				LocalObjectReference: rhtasv1alpha1.LocalObjectReference{Name: secret.Name},
+				Key:                  role,
+			}
+		}
+		i.Recorder.Eventf(instance, v1.EventTypeNormal, "RoleKeysCreated", "TUF role keys created in Secret %s", secret.Name)
+	}
+
+	instance.Status.RoleKeys = resolved
+	return i.StatusUpdate(ctx, instance)
+}
+
+// validate checks that the generated key referenced by the status is still stored in its Secret.
+func (i roleKeysAction) validate(namespace string, ref *rhtasv1alpha1.SecretKeySelector) error {
+	key, err := k8sutils.GetSecretData(i.Client, namespace, ref)
+	if err != nil {
+		return err
+	}
+	_, err = tufutils.ParseRoleKey(key)
+	return err
+}
+
+// roleKey returns the reference of the role key so it can be read and set.
+func roleKey(keys *rhtasv1alpha1.TufRoleKeys, role string) **rhtasv1alpha1.SecretKeySelector {
+	switch role {
+	case tufutils.RootRole:
+		return &keys.Root
+	case tufutils.TargetsRole:
+		return &keys.Targets
+	case tufutils.SnapshotRole:
+		return &keys.Snapshot
+	default:
+		return &keys.Timestamp
+	}
+}
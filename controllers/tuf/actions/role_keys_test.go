@@ -0,0 +1,47 @@
+package actions
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+	testaction "github.com/securesign/operator/controllers/common/test/action"
+	"github.com/securesign/operator/controllers/constants"
+	tufutils "github.com/securesign/operator/controllers/tuf/utils"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestRoleKeys(t *testing.T) {
+	g := NewWithT(t)
+
+	instance := &v1alpha1.Tuf{ObjectMeta: metav1.ObjectMeta{Name: "tuf", Namespace: "default"}}
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{Type: constants.Ready, Status: metav1.ConditionFalse, Reason: constants.Creating})
+	c := testaction.FakeClientBuilder().WithStatusSubresource(instance).WithObjects(instance).Build()
+	a := testaction.PrepareAction(c, NewRoleKeysAction())
+
+	g.Expect(a.CanHandle(testContext, instance)).To(BeTrue())
+	g.Expect(a.Handle(testContext, instance)).ToNot(BeNil())
+	first := instance.Status.RoleKeys.DeepCopy()
+	g.Expect(first.Root.Name).To(HavePrefix("tuf-tuf-keys-"))
+	for _, role := range tufutils.Roles {
+		g.Expect(*roleKey(first, role)).To(Equal(&v1alpha1.SecretKeySelector{
+			LocalObjectReference: v1alpha1.LocalObjectReference{Name: first.Root.Name}, Key: role}))
+	}
+	g.Expect(a.CanHandle(testContext, instance)).To(BeFalse())
+
+	// a lost key set is generated again into a new Secret
+	g.Expect(c.Delete(testContext, &v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: first.Root.Name, Namespace: "default"}})).To(Succeed())
+	g.Expect(a.Handle(testContext, instance)).ToNot(BeNil())
+	g.Expect(instance.Status.RoleKeys.Root.Name).ToNot(Equal(first.Root.Name))
+
+	secret := &v1.Secret{}
+	g.Expect(c.Get(testContext, types.NamespacedName{Name: instance.Status.RoleKeys.Root.Name, Namespace: "default"}, secret)).To(Succeed())
+	g.Expect(secret.Data).To(HaveLen(len(tufutils.Roles)))
+	for _, role := range tufutils.Roles {
+		_, err := tufutils.ParseRoleKey(secret.Data[role])
+		g.Expect(err).ToNot(HaveOccurred())
+	}
+}
@@ -38,7 +38,7 @@ func (i serviceAction) Handle(ctx context.Context, instance *rhtasv1alpha1.Tuf)
 
 	labels := constants.LabelsFor(ComponentName, DeploymentName, instance.Name)
 
-	svc := kubernetes.CreateService(instance.Namespace, DeploymentName, 8080, labels)
+	svc := kubernetes.CreateService(instance.Namespace, constants.InstanceName(DeploymentName, instance.Name), 8080, labels)
 	//patch the pregenerated service
 	svc.Spec.Ports[0].Port = instance.Spec.Port
 	if err = controllerutil.SetControllerReference(instance, svc, i.Client.Scheme()); err != nil {
@@ -20,7 +20,9 @@ import (
 	"context"
 
 	rhtasv1alpha1 "github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/common"
 	"github.com/securesign/operator/controllers/common/action"
+	k8sutils "github.com/securesign/operator/controllers/common/utils/kubernetes"
 	ctl "github.com/securesign/operator/controllers/ctlog/actions"
 	fulcio "github.com/securesign/operator/controllers/fulcio/actions"
 	"github.com/securesign/operator/controllers/rekor/actions/server"
@@ -85,9 +87,14 @@ func (r *TufReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 
 	target := instance.DeepCopy()
 	acs := []action.Action[rhtasv1alpha1.Tuf]{
+		common.NewMigrateNamesAction[rhtasv1alpha1.Tuf](actions.LegacyNames),
+
 		actions.NewToPendingPhaseAction(),
 
 		actions.NewResolveKeysAction(),
+		actions.NewRoleKeysAction(),
+		actions.NewCreatePvcAction(),
+		actions.NewRepositoryAction(),
 		actions.NewRBACAction(),
 		actions.NewDeployAction(),
 		actions.NewServiceAction(),
@@ -96,6 +103,7 @@ func (r *TufReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.R
 		actions.NewToInitializePhaseAction(),
 
 		actions.NewInitializeAction(),
+		actions.NewExpirationAction(),
 	}
 
 	for _, a := range acs {
@@ -141,6 +149,7 @@ func (r *TufReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		For(&rhtasv1alpha1.Tuf{}).
 		Owns(&v1.Deployment{}).
 		Owns(&v12.Service{}).
+		Owns(&v12.ConfigMap{}).
 		Owns(&v13.Ingress{}).
 		Watches(&v12.Secret{}, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, object client.Object) []reconcile.Request {
 			val, ok := object.GetLabels()["app.kubernetes.io/instance"]
@@ -164,5 +173,9 @@ func (r *TufReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			return requests
 
 		}), builder.WithPredicates(predicate.Or(fulcio, rekor, ctl))).
+		// keys are published in the status of the components managed by the same Securesign
+		Watches(&rhtasv1alpha1.Fulcio{}, k8sutils.EnqueueSibling()).
+		Watches(&rhtasv1alpha1.CTlog{}, k8sutils.EnqueueSibling()).
+		Watches(&rhtasv1alpha1.Rekor{}, k8sutils.EnqueueSibling()).
 		Complete(r)
 }
@@ -97,7 +97,8 @@ var _ = Describe("TUF controller", func() {
 							Host:    "tuf.localhost",
 							Enabled: true,
 						},
-						Port: 8181,
+						Port:         8181,
+						KeyDiscovery: v1alpha1.KeyDiscoveryLabels,
 						Keys: []v1alpha1.TufKey{
 							{
 								Name: "fulcio_v1.crt.pem",
@@ -156,6 +157,14 @@ var _ = Describe("TUF controller", func() {
 				"public": []byte("secret"),
 			}, secretLabels))
 
+			By("Creating fulcio and rekor secrets referenced by the TUF keys")
+			Expect(k8sClient.Create(ctx, kubernetes.CreateSecret("fulcio-pub-key", typeNamespaceName.Namespace, map[string][]byte{
+				"cert": []byte("fulcio"),
+			}, map[string]string{}))).To(Succeed())
+			Expect(k8sClient.Create(ctx, kubernetes.CreateSecret("rekor-pub-key", typeNamespaceName.Namespace, map[string][]byte{
+				"public": []byte("rekor"),
+			}, map[string]string{}))).To(Succeed())
+
 			By("Waiting until Tuf instance is Initialization")
 			Eventually(func() string {
 				found := &v1alpha1.Tuf{}
@@ -166,7 +175,7 @@ var _ = Describe("TUF controller", func() {
 			deployment := &appsv1.Deployment{}
 			By("Checking if Deployment was successfully created in the reconciliation")
 			Eventually(func() error {
-				return k8sClient.Get(ctx, types.NamespacedName{Name: actions.DeploymentName, Namespace: TufNamespace}, deployment)
+				return k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.DeploymentName, TufName), Namespace: TufNamespace}, deployment)
 			}, time.Minute, time.Second).Should(Succeed())
 
 			By("Move to Ready phase")
@@ -185,14 +194,14 @@ var _ = Describe("TUF controller", func() {
 			By("Checking if Service was successfully created in the reconciliation")
 			service := &corev1.Service{}
 			Eventually(func() error {
-				return k8sClient.Get(ctx, types.NamespacedName{Name: actions.DeploymentName, Namespace: TufNamespace}, service)
+				return k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.DeploymentName, TufName), Namespace: TufNamespace}, service)
 			}, time.Minute, time.Second).Should(Succeed())
 			Expect(service.Spec.Ports[0].Port).Should(Equal(int32(8181)))
 
 			By("Checking if Ingress was successfully created in the reconciliation")
 			ingress := &v1.Ingress{}
 			Eventually(func() error {
-				return k8sClient.Get(ctx, types.NamespacedName{Name: actions.DeploymentName, Namespace: TufNamespace}, ingress)
+				return k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.DeploymentName, TufName), Namespace: TufNamespace}, ingress)
 			}, time.Minute, time.Second).Should(Succeed())
 			Expect(ingress.Spec.Rules[0].Host).Should(Equal("tuf.localhost"))
 			Expect(ingress.Spec.Rules[0].IngressRuleValue.HTTP.Paths[0].Backend.Service.Name).Should(Equal(service.Name))
@@ -213,17 +222,36 @@ var _ = Describe("TUF controller", func() {
 				return nil
 			}, time.Minute, time.Second).Should(Succeed())
 
+			By("Checking the signed TUF repository")
+			repository := &corev1.ConfigMap{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.RepositoryName, TufName), Namespace: TufNamespace}, repository)).To(Succeed())
+			Expect(repository.Data).To(HaveKey("root.json"))
+			Expect(repository.Data).To(HaveKey("1.root.json"))
+			Expect(repository.Data).To(HaveKey("timestamp.json"))
+			Expect(repository.BinaryData).To(HaveKeyWithValue("targets_ctfe.pub", []byte("secret")))
+			Expect(deployment.Spec.Template.Spec.Volumes[0].ConfigMap.Items).To(ContainElement(
+				corev1.KeyToPath{Key: "targets_rekor.pub", Path: "targets/rekor.pub"}))
+			found := &v1alpha1.Tuf{}
+			Expect(k8sClient.Get(ctx, typeNamespaceName, found)).Should(Succeed())
+			Expect(found.Status.RoleKeys.Root).ToNot(BeNil())
+			Expect(found.Status.Metadata.Root.Version).To(Equal(int64(1)))
+			Expect(found.Status.Metadata.Timestamp.Version).To(Equal(int64(1)))
+			Expect(found.Status.Root.PublishedVersion).To(Equal(int64(1)))
+			Expect(found.Status.Root.StagedVersion).To(BeZero())
+			Expect(found.Status.Root.Keys).To(Equal([]v1alpha1.SecretKeySelector{*found.Status.RoleKeys.Root}))
+			Expect(meta.IsStatusConditionTrue(found.Status.Conditions, actions.RepositoryCondition)).To(BeTrue())
+
 			By("Checking if controller will return deployment to desired state")
 			deployment = &appsv1.Deployment{}
 			Eventually(func() error {
-				return k8sClient.Get(ctx, types.NamespacedName{Name: actions.DeploymentName, Namespace: TufNamespace}, deployment)
+				return k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.DeploymentName, TufName), Namespace: TufNamespace}, deployment)
 			}, time.Minute, time.Second).Should(Succeed())
 			replicas := int32(99)
 			deployment.Spec.Replicas = &replicas
 			Expect(k8sClient.Status().Update(ctx, deployment)).Should(Succeed())
 			Eventually(func() int32 {
 				deployment = &appsv1.Deployment{}
-				Expect(k8sClient.Get(ctx, types.NamespacedName{Name: actions.DeploymentName, Namespace: TufNamespace}, deployment)).Should(Succeed())
+				Expect(k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.DeploymentName, TufName), Namespace: TufNamespace}, deployment)).Should(Succeed())
 				return *deployment.Spec.Replicas
 			}, time.Minute, time.Second).Should(Equal(int32(1)))
 		})
@@ -26,7 +26,6 @@ import (
 	"github.com/securesign/operator/controllers/constants"
 	actions2 "github.com/securesign/operator/controllers/ctlog/actions"
 	"github.com/securesign/operator/controllers/tuf/actions"
-	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 
@@ -97,7 +96,8 @@ var _ = Describe("TUF update test", func() {
 							Host:    "tuf.localhost",
 							Enabled: true,
 						},
-						Port: 8181,
+						Port:         8181,
+						KeyDiscovery: v1alpha1.KeyDiscoveryLabels,
 						Keys: []v1alpha1.TufKey{
 							{
 								Name: "fulcio_v1.crt.pem",
@@ -149,6 +149,14 @@ var _ = Describe("TUF update test", func() {
 				"public": []byte("secret"),
 			}, secretLabels))
 
+			By("Creating fulcio and rekor secrets referenced by the TUF keys")
+			Expect(k8sClient.Create(ctx, kubernetes.CreateSecret("fulcio-pub-key", typeNamespaceName.Namespace, map[string][]byte{
+				"cert": []byte("fulcio"),
+			}, map[string]string{}))).To(Succeed())
+			Expect(k8sClient.Create(ctx, kubernetes.CreateSecret("rekor-pub-key", typeNamespaceName.Namespace, map[string][]byte{
+				"public": []byte("rekor"),
+			}, map[string]string{}))).To(Succeed())
+
 			By("Waiting until Tuf instance is Initialization")
 			Eventually(func() string {
 				found := &v1alpha1.Tuf{}
@@ -159,7 +167,7 @@ var _ = Describe("TUF update test", func() {
 			deployment := &appsv1.Deployment{}
 			By("Checking if Deployment was successfully created in the reconciliation")
 			Eventually(func() error {
-				return k8sClient.Get(ctx, types.NamespacedName{Name: actions.DeploymentName, Namespace: TufNamespace}, deployment)
+				return k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.DeploymentName, TufName), Namespace: TufNamespace}, deployment)
 			}, time.Minute, time.Second).Should(Succeed())
 
 			By("Move to Ready phase")
@@ -217,12 +225,15 @@ var _ = Describe("TUF update test", func() {
 				return found.Status.Keys
 			}).Should(ContainElements(WithTransform(func(k v1alpha1.TufKey) string { return k.SecretRef.Name }, Equal("ctlog-update"))))
 
-			By("CTL deployment is updated")
-			Eventually(func() bool {
-				updated := &appsv1.Deployment{}
-				k8sClient.Get(ctx, types.NamespacedName{Name: actions.DeploymentName, Namespace: TufNamespace}, updated)
-				return equality.Semantic.DeepDerivative(deployment.Spec.Template.Spec.Volumes, updated.Spec.Template.Spec.Volumes)
-			}, time.Minute, time.Second).Should(BeFalse())
+			By("TUF repository is signed with the new key")
+			Eventually(func() int64 {
+				found := &v1alpha1.Tuf{}
+				Expect(k8sClient.Get(ctx, typeNamespaceName, found)).Should(Succeed())
+				return found.Status.Metadata.Targets.Version
+			}, time.Minute, time.Second).Should(Equal(int64(2)))
+			repository := &corev1.ConfigMap{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: constants.InstanceName(actions.RepositoryName, TufName), Namespace: TufNamespace}, repository)).To(Succeed())
+			Expect(repository.BinaryData).To(HaveKeyWithValue("targets_ctfe.pub", []byte("update")))
 		})
 	})
 })
@@ -0,0 +1,111 @@
+package utils
+
+import (
+	"slices"
+	"time"
+
+	"github.com/securesign/operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	day = 24 * time.Hour
+
+	defaultResignBefore = 6 * time.Hour
+	defaultWarnBefore   = 30 * day
+)
+
+var defaultValidity = map[string]time.Duration{
+	RootRole:      365 * day,
+	TargetsRole:   365 * day,
+	SnapshotRole:  7 * day,
+	TimestampRole: day,
+}
+
+// OnlineRoles are signed again by the operator before their metadata expires.
+var OnlineRoles = []string{SnapshotRole, TimestampRole}
+
+// Expiration configures the validity of the metadata signed by the operator.
+type Expiration struct {
+	Validity     map[string]time.Duration
+	ResignBefore time.Duration
+	WarnBefore   time.Duration
+}
+
+// ExpirationFor returns the expiration configured in the spec with the defaults of the unset values.
+func ExpirationFor(spec v1alpha1.TufExpiration) Expiration {
+	duration := func(d *metav1.Duration, def time.Duration) time.Duration {
+		if d == nil || d.Duration <= 0 {
+			return def
+		}
+		return d.Duration
+	}
+	return Expiration{
+		Validity: map[string]time.Duration{
+			RootRole:      duration(spec.Root, defaultValidity[RootRole]),
+			TargetsRole:   duration(spec.Targets, defaultValidity[TargetsRole]),
+			SnapshotRole:  duration(spec.Snapshot, defaultValidity[SnapshotRole]),
+			TimestampRole: duration(spec.Timestamp, defaultValidity[TimestampRole]),
+		},
+		ResignBefore: duration(spec.ResignBefore, defaultResignBefore),
+		WarnBefore:   duration(spec.WarnBefore, defaultWarnBefore),
+	}
+}
+
+func (e Expiration) validity(role string) time.Duration {
+	if v, ok := e.Validity[role]; ok && v > 0 {
+		return v
+	}
+	return defaultValidity[role]
+}
+
+// ResignAt returns when the metadata of an online role is signed again.
+// The metadata is signed again at the latest in the half of its validity, so it isn't signed on every reconcile.
+func (e Expiration) ResignAt(role string, expires time.Time) time.Time {
+	return expires.Add(-min(e.ResignBefore, e.validity(role)/2))
+}
+
+// Expiring returns the offline roles whose metadata expires within the warning period.
+func (e Expiration) Expiring(metadata map[string]RoleMetadata, now time.Time) []string {
+	var expiring []string
+	for _, role := range Roles {
+		m, ok := metadata[role]
+		if !ok || slices.Contains(OnlineRoles, role) {
+			continue
+		}
+		if !now.Before(m.Expires.Add(-e.WarnBefore)) {
+			expiring = append(expiring, role)
+		}
+	}
+	return expiring
+}
+
+// NextCheck returns the earliest time an online role has to be signed again
+// or the metadata of an offline role gets into the warning period or expires.
+// It returns the zero time when there is nothing to check.
+func (e Expiration) NextCheck(metadata map[string]RoleMetadata, now time.Time) time.Time {
+	var next time.Time
+	schedule := func(t time.Time) {
+		if t.After(now) && (next.IsZero() || t.Before(next)) {
+			next = t
+		}
+	}
+	for _, role := range Roles {
+		m, ok := metadata[role]
+		if !ok {
+			continue
+		}
+		if slices.Contains(OnlineRoles, role) {
+			resign := e.ResignAt(role, m.Expires)
+			if !resign.After(now) {
+				// the due metadata is signed before the check, don't requeue in a busy loop when the signing fails
+				resign = now.Add(time.Minute)
+			}
+			schedule(resign)
+			continue
+		}
+		schedule(m.Expires.Add(-e.WarnBefore))
+		schedule(m.Expires)
+	}
+	return next
+}
@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestExpirationFor(t *testing.T) {
+	g := NewWithT(t)
+
+	e := ExpirationFor(v1alpha1.TufExpiration{})
+	g.Expect(e.Validity).To(Equal(map[string]time.Duration{
+		RootRole:      365 * day,
+		TargetsRole:   365 * day,
+		SnapshotRole:  7 * day,
+		TimestampRole: day,
+	}))
+	g.Expect(e.ResignBefore).To(Equal(6 * time.Hour))
+	g.Expect(e.WarnBefore).To(Equal(30 * day))
+
+	e = ExpirationFor(v1alpha1.TufExpiration{
+		Timestamp:    &metav1.Duration{Duration: time.Hour},
+		ResignBefore: &metav1.Duration{Duration: 12 * time.Hour},
+	})
+	g.Expect(e.Validity[TimestampRole]).To(Equal(time.Hour))
+	expires := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	// at most half of the validity
+	g.Expect(e.ResignAt(TimestampRole, expires)).To(Equal(expires.Add(-30 * time.Minute)))
+	g.Expect(e.ResignAt(SnapshotRole, expires)).To(Equal(expires.Add(-12 * time.Hour)))
+}
+
+func TestExpiring(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	e := ExpirationFor(v1alpha1.TufExpiration{})
+
+	metadata := map[string]RoleMetadata{
+		RootRole:      {Version: 1, Expires: now.Add(31 * day)},
+		TargetsRole:   {Version: 1, Expires: now.Add(10 * day)},
+		SnapshotRole:  {Version: 1, Expires: now.Add(time.Hour)},
+		TimestampRole: {Version: 1, Expires: now.Add(-time.Hour)},
+	}
+	g.Expect(e.Expiring(metadata, now)).To(Equal([]string{TargetsRole}))
+	g.Expect(e.Expiring(metadata, now.Add(2*day))).To(Equal([]string{RootRole, TargetsRole}))
+	g.Expect(e.Expiring(map[string]RoleMetadata{}, now)).To(BeEmpty())
+}
+
+func TestNextCheck(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	e := ExpirationFor(v1alpha1.TufExpiration{})
+
+	g.Expect(e.NextCheck(map[string]RoleMetadata{}, now)).To(BeZero())
+
+	metadata := map[string]RoleMetadata{
+		RootRole:      {Version: 1, Expires: now.Add(365 * day)},
+		TargetsRole:   {Version: 1, Expires: now.Add(40 * day)},
+		SnapshotRole:  {Version: 1, Expires: now.Add(7 * day)},
+		TimestampRole: {Version: 1, Expires: now.Add(day)},
+	}
+	// the timestamp is signed again 6 hours before it expires
+	g.Expect(e.NextCheck(metadata, now)).To(Equal(now.Add(18 * time.Hour)))
+
+	delete(metadata, TimestampRole)
+	delete(metadata, SnapshotRole)
+	// targets get into the warning period
+	g.Expect(e.NextCheck(metadata, now)).To(Equal(now.Add(10 * day)))
+	// targets expire
+	g.Expect(e.NextCheck(metadata, now.Add(20*day))).To(Equal(now.Add(40 * day)))
+
+	// due timestamp is not checked in a busy loop
+	metadata[TimestampRole] = RoleMetadata{Version: 1, Expires: now.Add(time.Hour)}
+	g.Expect(e.NextCheck(metadata, now)).To(Equal(now.Add(time.Minute)))
+}
@@ -0,0 +1,146 @@
+package utils
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/theupdateframework/go-tuf/data"
+	"github.com/theupdateframework/go-tuf/pkg/keys"
+)
+
+// GenerateRoleKey returns a new PEM encoded ed25519 private key for signing TUF metadata.
+func GenerateRoleKey() ([]byte, error) {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+// ParseRoleKey returns the TUF signer of a PEM encoded private key.
+// Supported are ed25519 and ECDSA P-256 keys in the PKCS#8 or SEC 1 format.
+func ParseRoleKey(key []byte) (keys.Signer, error) {
+	block, _ := pem.Decode(key)
+	if block == nil {
+		return nil, errors.New("private key is not PEM encoded")
+	}
+
+	var (
+		private any
+		err     error
+	)
+	switch block.Type {
+	case "PRIVATE KEY":
+		private, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		private, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %s", block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not parse private key: %w", err)
+	}
+
+	switch k := private.(type) {
+	case ed25519.PrivateKey:
+		return keys.NewEd25519SignerFromKey(keys.Ed25519PrivateKeyValue{
+			Public:  data.HexBytes(k.Public().(ed25519.PublicKey)),
+			Private: data.HexBytes(k),
+		}), nil
+	case *ecdsa.PrivateKey:
+		if k.Curve != elliptic.P256() {
+			return nil, errors.New("unsupported ECDSA curve, only P-256 is supported")
+		}
+		return ecdsaSigner(k)
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", private)
+	}
+}
+
+// ParseRootKey returns the TUF public key of a PEM encoded root key.
+// A private key also returns its signer, a public key is an offline key which doesn't sign in the operator.
+func ParseRootKey(key []byte) (*data.PublicKey, keys.Signer, error) {
+	block, _ := pem.Decode(key)
+	if block == nil {
+		return nil, nil, errors.New("root key is not PEM encoded")
+	}
+	if block.Type != "PUBLIC KEY" {
+		signer, err := ParseRoleKey(key)
+		if err != nil {
+			return nil, nil, err
+		}
+		return signer.PublicData(), signer, nil
+	}
+
+	public, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not parse public key: %w", err)
+	}
+	switch k := public.(type) {
+	case ed25519.PublicKey:
+		value, err := json.Marshal(struct {
+			Public data.HexBytes `json:"public"`
+		}{Public: data.HexBytes(k)})
+		if err != nil {
+			return nil, nil, err
+		}
+		return &data.PublicKey{
+			Type:       data.KeyTypeEd25519,
+			Scheme:     data.KeySchemeEd25519,
+			Algorithms: data.HashAlgorithms,
+			Value:      value,
+		}, nil, nil
+	case *ecdsa.PublicKey:
+		if k.Curve != elliptic.P256() {
+			return nil, nil, errors.New("unsupported ECDSA curve, only P-256 is supported")
+		}
+		value, err := json.Marshal(struct {
+			Public *keys.PKIXPublicKey `json:"public"`
+		}{Public: &keys.PKIXPublicKey{PublicKey: k}})
+		if err != nil {
+			return nil, nil, err
+		}
+		return &data.PublicKey{
+			Type:       data.KeyTypeECDSA_SHA2_P256,
+			Scheme:     data.KeySchemeECDSA_SHA2_P256,
+			Algorithms: data.HashAlgorithms,
+			Value:      value,
+		}, nil, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported public key type %T", public)
+	}
+}
+
+func ecdsaSigner(k *ecdsa.PrivateKey) (keys.Signer, error) {
+	der, err := x509.MarshalECPrivateKey(k)
+	if err != nil {
+		return nil, err
+	}
+	value, err := json.Marshal(struct {
+		Private string              `json:"private"`
+		Public  *keys.PKIXPublicKey `json:"public"`
+	}{
+		Private: string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})),
+		Public:  &keys.PKIXPublicKey{PublicKey: k.Public()},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keys.GetSigner(&data.PrivateKey{
+		Type:       data.KeyTypeECDSA_SHA2_P256,
+		Scheme:     data.KeySchemeECDSA_SHA2_P256,
+		Algorithms: data.HashAlgorithms,
+		Value:      value,
+	})
+}
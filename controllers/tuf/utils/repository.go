@@ -0,0 +1,268 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/theupdateframework/go-tuf/data"
+	"github.com/theupdateframework/go-tuf/pkg/keys"
+	"github.com/theupdateframework/go-tuf/sign"
+	"github.com/theupdateframework/go-tuf/util"
+)
+
+const (
+	RootRole      = "root"
+	TargetsRole   = "targets"
+	SnapshotRole  = "snapshot"
+	TimestampRole = "timestamp"
+
+	targetsDir = "targets/"
+	// targetsKeyPrefix replaces the targets directory in the ConfigMap keys which can't contain a slash
+	targetsKeyPrefix = "targets_"
+)
+
+// Roles are the top-level TUF roles ordered by the dependency of their metadata.
+var Roles = []string{RootRole, TargetsRole, SnapshotRole, TimestampRole}
+
+// RoleSigners maps the targets, snapshot and timestamp roles to the keys signing their metadata.
+// The root metadata is signed as configured by RootSigning.
+type RoleSigners map[string]keys.Signer
+
+// RoleMetadata describes the published metadata of a TUF role.
+type RoleMetadata struct {
+	Version int64     `json:"version"`
+	Expires time.Time `json:"expires"`
+}
+
+// Repository contains the files of a TUF repository keyed by their path relative to the repository root.
+type Repository map[string][]byte
+
+// Update signs a new version of every role whose metadata content or signing key differs from the published one.
+// The metadata of the online roles is signed again also when it is close to expiration.
+// A role whose new key isn't listed in the published root yet keeps its metadata until the new root version is published.
+// The targets are the target files keyed by their name. It returns the updated roles.
+func (r Repository) Update(targets map[string][]byte, signers RoleSigners, root *RootSigning, expiration Expiration, now time.Time) ([]string, error) {
+	for _, role := range Roles {
+		if role != RootRole && signers[role] == nil {
+			return nil, fmt.Errorf("missing %s key", role)
+		}
+	}
+	var updated []string
+
+	desired := data.NewRoot()
+	// the server publishes only the latest version of the targets, snapshot and timestamp metadata
+	desired.ConsistentSnapshot = false
+	for _, role := range Roles {
+		if role == RootRole {
+			continue
+		}
+		key := signers[role].PublicData()
+		desired.AddKey(key)
+		desired.Roles[role] = &data.Role{KeyIDs: key.IDs(), Threshold: 1}
+	}
+	changed, err := r.updateRoot(desired, root, expiration, now)
+	if err != nil {
+		return nil, err
+	}
+	if changed {
+		updated = append(updated, RootRole)
+	}
+	published, err := r.root()
+	if err != nil {
+		return nil, err
+	}
+
+	if trusts(published, TargetsRole, signers[TargetsRole]) {
+		t := data.NewTargets()
+		for name, content := range targets {
+			meta, err := util.GenerateTargetFileMeta(bytes.NewReader(content), "sha256", "sha512")
+			if err != nil {
+				return nil, err
+			}
+			meta.Custom = targetCustom(name)
+			t.Targets[name] = meta
+		}
+		if changed, err = r.sign(TargetsRole, t, &t.Version, &t.Expires, expiration, now, signers[TargetsRole]); err != nil {
+			return nil, err
+		}
+		for path := range r {
+			if strings.HasPrefix(path, targetsDir) {
+				delete(r, path)
+			}
+		}
+		for name, content := range targets {
+			r[targetsDir+name] = content
+		}
+		if changed {
+			updated = append(updated, TargetsRole)
+		}
+	}
+
+	if trusts(published, SnapshotRole, signers[SnapshotRole]) {
+		s := data.NewSnapshot()
+		if s.Meta[TargetsRole+".json"], err = util.GenerateSnapshotFileMeta(bytes.NewReader(r[TargetsRole+".json"]), "sha256"); err != nil {
+			return nil, err
+		}
+		if changed, err = r.sign(SnapshotRole, s, &s.Version, &s.Expires, expiration, now, signers[SnapshotRole]); err != nil {
+			return nil, err
+		}
+		if changed {
+			updated = append(updated, SnapshotRole)
+		}
+	}
+
+	if trusts(published, TimestampRole, signers[TimestampRole]) {
+		ts := data.NewTimestamp()
+		if ts.Meta[SnapshotRole+".json"], err = util.GenerateTimestampFileMeta(bytes.NewReader(r[SnapshotRole+".json"]), "sha256"); err != nil {
+			return nil, err
+		}
+		if changed, err = r.sign(TimestampRole, ts, &ts.Version, &ts.Expires, expiration, now, signers[TimestampRole]); err != nil {
+			return nil, err
+		}
+		if changed {
+			updated = append(updated, TimestampRole)
+		}
+	}
+	return updated, nil
+}
+
+// sign keeps the published metadata of the role when it has the same content and signing key and doesn't need to be signed again.
+// Otherwise, it bumps the version and the expiration of the metadata and signs it.
+func (r Repository) sign(role string, meta any, version *int64, expires *time.Time, expiration Expiration, now time.Time, signer keys.Signer) (bool, error) {
+	file := role + ".json"
+	if content, ok := r[file]; ok {
+		published := &data.Signed{}
+		current := RoleMetadata{}
+		if err := parseSigned(content, published, &current); err != nil {
+			return false, fmt.Errorf("could not parse %s: %w", file, err)
+		}
+		*version, *expires = current.Version, current.Expires
+
+		equal, err := equalSigned(meta, content)
+		if err != nil {
+			return false, err
+		}
+		due := slices.Contains(OnlineRoles, role) && !now.Before(expiration.ResignAt(role, current.Expires))
+		if equal && signedBy(published, signer) && !due {
+			return false, nil
+		}
+	}
+
+	*version++
+	*expires = now.Add(expiration.validity(role)).UTC().Truncate(time.Second)
+	signed, err := sign.Marshal(meta, signer)
+	if err != nil {
+		return false, fmt.Errorf("could not sign %s: %w", file, err)
+	}
+	if r[file], err = json.MarshalIndent(signed, "", "  "); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func signedBy(signed *data.Signed, signer keys.Signer) bool {
+	ids := signer.PublicData().IDs()
+	if len(signed.Signatures) != len(ids) {
+		return false
+	}
+	for _, s := range signed.Signatures {
+		if !slices.Contains(ids, s.KeyID) {
+			return false
+		}
+	}
+	return true
+}
+
+// targetCustom returns the custom metadata sigstore clients use to find the usage of a target.
+func targetCustom(name string) *json.RawMessage {
+	var usage string
+	switch {
+	case strings.HasPrefix(name, "fulcio"):
+		usage = "Fulcio"
+	case strings.HasPrefix(name, "ctfe"):
+		usage = "CTFE"
+	case strings.HasPrefix(name, "rekor"):
+		usage = "Rekor"
+	case strings.HasPrefix(name, "tsa"):
+		usage = "TSA"
+	default:
+		return nil
+	}
+	custom := json.RawMessage(fmt.Sprintf(`{"sigstore":{"status":"Active","usage":"%s"}}`, usage))
+	return &custom
+}
+
+// Metadata returns the version and expiration of the published metadata of each role.
+func (r Repository) Metadata() (map[string]RoleMetadata, error) {
+	metadata := make(map[string]RoleMetadata, len(Roles))
+	for _, role := range Roles {
+		content, ok := r[role+".json"]
+		if !ok {
+			continue
+		}
+		m, err := ParseMetadata(content)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse %s.json: %w", role, err)
+		}
+		metadata[role] = m
+	}
+	return metadata, nil
+}
+
+// ParseMetadata returns the version and expiration of signed metadata.
+func ParseMetadata(content []byte) (RoleMetadata, error) {
+	m := RoleMetadata{}
+	err := parseSigned(content, &data.Signed{}, &m)
+	return m, err
+}
+
+// RepositoryFromConfigMap returns the repository stored in the data of a ConfigMap.
+func RepositoryFromConfigMap(data map[string]string, binaryData map[string][]byte) (Repository, error) {
+	r := make(Repository, len(data)+len(binaryData))
+	for key, content := range data {
+		r[RepositoryPath(key)] = []byte(content)
+	}
+	for key, content := range binaryData {
+		path := RepositoryPath(key)
+		if _, ok := r[path]; ok {
+			return nil, fmt.Errorf("duplicate repository file %s", path)
+		}
+		r[path] = content
+	}
+	return r, nil
+}
+
+// ConfigMapData returns the ConfigMap data storing the repository.
+// The metadata is stored as text, the target files as binary data.
+func (r Repository) ConfigMapData() (map[string]string, map[string][]byte) {
+	data := make(map[string]string)
+	binaryData := make(map[string][]byte)
+	for path, content := range r {
+		if strings.HasPrefix(path, targetsDir) {
+			binaryData[ConfigMapKey(path)] = content
+		} else {
+			data[ConfigMapKey(path)] = string(content)
+		}
+	}
+	return data, binaryData
+}
+
+// ConfigMapKey returns the ConfigMap key of a repository file.
+func ConfigMapKey(path string) string {
+	if name, ok := strings.CutPrefix(path, targetsDir); ok {
+		return targetsKeyPrefix + name
+	}
+	return path
+}
+
+// RepositoryPath returns the path in the repository of a file stored under the ConfigMap key.
+func RepositoryPath(key string) string {
+	if name, ok := strings.CutPrefix(key, targetsKeyPrefix); ok {
+		return targetsDir + name
+	}
+	return key
+}
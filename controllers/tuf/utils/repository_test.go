@@ -0,0 +1,187 @@
+package utils
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+	"github.com/theupdateframework/go-tuf/data"
+	"github.com/theupdateframework/go-tuf/pkg/keys"
+	"github.com/theupdateframework/go-tuf/verify"
+)
+
+func roleSigners(g *WithT) RoleSigners {
+	signers := RoleSigners{}
+	for _, role := range Roles {
+		key, err := GenerateRoleKey()
+		g.Expect(err).ShouldNot(HaveOccurred())
+		signers[role], err = ParseRoleKey(key)
+		g.Expect(err).ShouldNot(HaveOccurred())
+	}
+	return signers
+}
+
+func rootSigning(signer keys.Signer) *RootSigning {
+	return &RootSigning{Keys: []*data.PublicKey{signer.PublicData()}, Threshold: 1, Signers: []keys.Signer{signer}}
+}
+
+func verifyRepository(g *WithT, r Repository) {
+	signed := &data.Signed{}
+	g.Expect(json.Unmarshal(r["root.json"], signed)).To(Succeed())
+	root := &data.Root{}
+	g.Expect(json.Unmarshal(signed.Signed, root)).To(Succeed())
+	g.Expect(root.ConsistentSnapshot).To(BeFalse())
+	g.Expect(r).To(HaveKeyWithValue(fmt.Sprintf("%d.root.json", root.Version), r["root.json"]))
+
+	db := verify.NewDB()
+	for id, key := range root.Keys {
+		g.Expect(db.AddKey(id, key)).To(Succeed())
+	}
+	for name, role := range root.Roles {
+		g.Expect(db.AddRole(name, role)).To(Succeed())
+	}
+	for _, role := range Roles {
+		s := &data.Signed{}
+		g.Expect(json.Unmarshal(r[role+".json"], s)).To(Succeed())
+		g.Expect(db.Verify(s, role, 0)).To(Succeed(), role)
+	}
+}
+
+func TestParseRoleKey(t *testing.T) {
+	g := NewWithT(t)
+
+	key, err := GenerateRoleKey()
+	g.Expect(err).ShouldNot(HaveOccurred())
+	signer, err := ParseRoleKey(key)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(signer.PublicData().Type).To(Equal(data.KeyTypeEd25519))
+
+	ec, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	der, err := x509.MarshalECPrivateKey(ec)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	signer, err = ParseRoleKey(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(signer.PublicData().Type).To(Equal(data.KeyTypeECDSA_SHA2_P256))
+
+	ec, err = ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	der, err = x509.MarshalPKCS8PrivateKey(ec)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	_, err = ParseRoleKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	g.Expect(err).To(MatchError(ContainSubstring("P-256")))
+
+	_, err = ParseRoleKey([]byte("not a key"))
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestRepositoryUpdate(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Now().UTC().Truncate(time.Second)
+	signers := roleSigners(g)
+	root := rootSigning(signers[RootRole])
+	expiration := ExpirationFor(v1alpha1.TufExpiration{})
+	targets := map[string][]byte{
+		"rekor.pub":         []byte("rekor"),
+		"ctfe.pub":          []byte("ctfe"),
+		"fulcio_v1.crt.pem": []byte("fulcio"),
+	}
+
+	r := Repository{}
+	updated, err := r.Update(targets, signers, root, expiration, now)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(updated).To(Equal(Roles))
+	g.Expect(r).To(HaveKeyWithValue("targets/rekor.pub", []byte("rekor")))
+	g.Expect(r).To(HaveKey("1.root.json"))
+	verifyRepository(g, r)
+
+	metadata, err := r.Metadata()
+	g.Expect(err).ShouldNot(HaveOccurred())
+	for _, role := range Roles {
+		g.Expect(metadata).To(HaveKeyWithValue(role, RoleMetadata{Version: 1, Expires: now.Add(expiration.Validity[role])}))
+	}
+
+	s := &data.Signed{}
+	g.Expect(json.Unmarshal(r["targets.json"], s)).To(Succeed())
+	targetsMeta := &data.Targets{}
+	g.Expect(json.Unmarshal(s.Signed, targetsMeta)).To(Succeed())
+	g.Expect(targetsMeta.Targets).To(HaveLen(3))
+	g.Expect([]byte(*targetsMeta.Targets["fulcio_v1.crt.pem"].Custom)).To(MatchJSON(`{"sigstore":{"status":"Active","usage":"Fulcio"}}`))
+
+	// unchanged input keeps the published metadata
+	updated, err = r.Update(targets, signers, root, expiration, now.Add(time.Hour))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(updated).To(BeEmpty())
+
+	// changed target re-signs the targets, snapshot and timestamp
+	targets["rekor.pub"] = []byte("new rekor")
+	delete(targets, "ctfe.pub")
+	updated, err = r.Update(targets, signers, root, expiration, now.Add(time.Hour))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(updated).To(Equal([]string{TargetsRole, SnapshotRole, TimestampRole}))
+	g.Expect(r).To(HaveKeyWithValue("targets/rekor.pub", []byte("new rekor")))
+	g.Expect(r).ToNot(HaveKey("targets/ctfe.pub"))
+	verifyRepository(g, r)
+	metadata, err = r.Metadata()
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(metadata[RootRole].Version).To(Equal(int64(1)))
+	g.Expect(metadata[TimestampRole]).To(Equal(RoleMetadata{Version: 2, Expires: now.Add(time.Hour).Add(24 * time.Hour)}))
+
+	// new timestamp key is published in a new root version
+	signers[TimestampRole] = roleSigners(g)[TimestampRole]
+	updated, err = r.Update(targets, signers, root, expiration, now)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(updated).To(Equal([]string{RootRole, TimestampRole}))
+	g.Expect(r).To(HaveKey("1.root.json"))
+	g.Expect(r).To(HaveKey("2.root.json"))
+	verifyRepository(g, r)
+
+	// timestamp close to expiration is signed again
+	updated, err = r.Update(targets, signers, root, expiration, now.Add(19*time.Hour))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(updated).To(Equal([]string{TimestampRole}))
+	// snapshot close to expiration changes also the timestamp
+	updated, err = r.Update(targets, signers, root, expiration, now.Add(7*24*time.Hour-time.Hour))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(updated).To(Equal([]string{SnapshotRole, TimestampRole}))
+	verifyRepository(g, r)
+
+	_, err = r.Update(targets, RoleSigners{SnapshotRole: signers[SnapshotRole]}, root, expiration, now)
+	g.Expect(err).To(MatchError("missing targets key"))
+}
+
+func TestRepositoryConfigMap(t *testing.T) {
+	g := NewWithT(t)
+
+	r := Repository{
+		"root.json":              []byte("{}"),
+		"1.root.json":            []byte("{}"),
+		"targets.json":           []byte("{}"),
+		"targets/rekor.pub":      []byte("rekor"),
+		"targets/targets.json":   []byte("target"),
+		"targets/fulcio_v1.crt":  []byte("fulcio"),
+		"snapshot.json":          []byte("{}"),
+		"timestamp.json":         []byte("{}"),
+		"targets/trusted.json":   []byte("{}"),
+		"targets/with_underline": []byte("{}"),
+	}
+	data, binaryData := r.ConfigMapData()
+	g.Expect(data).To(HaveLen(5))
+	g.Expect(binaryData).To(HaveKeyWithValue("targets_rekor.pub", []byte("rekor")))
+	g.Expect(binaryData).To(HaveKey("targets_targets.json"))
+
+	restored, err := RepositoryFromConfigMap(data, binaryData)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(restored).To(Equal(r))
+
+	_, err = RepositoryFromConfigMap(map[string]string{"targets_rekor.pub": ""}, map[string][]byte{"targets_rekor.pub": nil})
+	g.Expect(err).To(HaveOccurred())
+}
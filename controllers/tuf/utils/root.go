@@ -0,0 +1,257 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/secure-systems-lab/go-securesystemslib/cjson"
+	"github.com/theupdateframework/go-tuf/data"
+	"github.com/theupdateframework/go-tuf/pkg/keys"
+	"github.com/theupdateframework/go-tuf/sign"
+	"github.com/theupdateframework/go-tuf/util"
+)
+
+// RootSigning configures the keys and signatures of the root metadata.
+type RootSigning struct {
+	// Keys of the root role
+	Keys []*data.PublicKey
+	// Threshold is the number of root keys required to sign the root metadata
+	Threshold int
+	// Signers are the private root keys held by the operator.
+	// They sign a new root version when they are keys of the published or the new root metadata.
+	Signers []keys.Signer
+	// Signatures of the staged root metadata made outside the operator
+	Signatures []data.Signature
+	// RequiredSignatures is the number of keys outside the operator which must sign a new root version before it's published
+	RequiredSignatures int
+
+	// Staged is the root metadata waiting for signatures. Update replaces it by the new staged metadata, or nil when nothing is staged.
+	Staged []byte
+	// StagedSignatures is the number of keys outside the operator which signed the staged metadata, it's set by Update
+	StagedSignatures int
+}
+
+// updateRoot publishes a new version of the root metadata when the desired root differs from the published one.
+// The new version is staged until it's signed by the threshold of both the published and the new root keys
+// and by the required keys outside the operator, so clients trusting the published version can update to it.
+// It returns true when a new version is published.
+func (r Repository) updateRoot(desired *data.Root, root *RootSigning, expiration Expiration, now time.Time) (bool, error) {
+	ids := make([]string, 0, len(root.Keys))
+	for _, key := range root.Keys {
+		desired.AddKey(key)
+		for _, id := range key.IDs() {
+			if !slices.Contains(ids, id) {
+				ids = append(ids, id)
+			}
+		}
+	}
+	sort.Strings(ids)
+	if root.Threshold < 1 || root.Threshold > len(ids) {
+		return false, fmt.Errorf("root threshold %d must be between 1 and the number of root keys %d", root.Threshold, len(ids))
+	}
+	desired.Roles[RootRole] = &data.Role{KeyIDs: ids, Threshold: root.Threshold}
+
+	published, err := r.root()
+	if err != nil {
+		return false, err
+	}
+	version := int64(1)
+	if published != nil {
+		desired.Version, desired.Expires = published.Version, published.Expires
+		equal, err := equalSigned(desired, r[RootRole+".json"])
+		if err != nil {
+			return false, err
+		}
+		if equal {
+			root.Staged, root.StagedSignatures = nil, 0
+			return false, nil
+		}
+		version = published.Version + 1
+	}
+
+	// the staged version is kept while the root doesn't change, so the signatures made outside the operator stay valid
+	signed := &data.Signed{}
+	reuse := false
+	if root.Staged != nil {
+		staged := &data.Root{}
+		if err = parseSigned(root.Staged, signed, staged); err != nil {
+			return false, fmt.Errorf("could not parse staged root: %w", err)
+		}
+		desired.Version, desired.Expires = staged.Version, staged.Expires
+		if reuse, err = equalSigned(desired, root.Staged); err != nil {
+			return false, err
+		}
+		reuse = reuse && staged.Version == version
+	}
+	if !reuse {
+		desired.Version = version
+		desired.Expires = now.Add(expiration.validity(RootRole)).UTC().Truncate(time.Second)
+		content, err := json.Marshal(desired)
+		if err != nil {
+			return false, err
+		}
+		signed = &data.Signed{Signed: content}
+	}
+	canonical, err := cjson.EncodeCanonical(signed.Signed)
+	if err != nil {
+		return false, err
+	}
+
+	trusted := rootKeys(desired)
+	if published != nil {
+		for id, key := range rootKeys(published) {
+			trusted[id] = key
+		}
+	}
+	signatures := make(map[string]data.Signature)
+	add := func(s data.Signature) {
+		key, ok := trusted[s.KeyID]
+		if _, done := signatures[s.KeyID]; !ok || done {
+			return
+		}
+		if verifier, err := keys.GetVerifier(key); err == nil && verifier.Verify(canonical, s.Signature) == nil {
+			signatures[s.KeyID] = s
+		}
+	}
+	for _, s := range signed.Signatures {
+		add(s)
+	}
+	for _, s := range root.Signatures {
+		add(s)
+	}
+	operator := make(map[string]bool)
+	for _, signer := range root.Signers {
+		for _, id := range signer.PublicData().IDs() {
+			operator[id] = true
+			if _, ok := trusted[id]; !ok {
+				continue
+			}
+			if _, ok := signatures[id]; ok {
+				continue
+			}
+			s, err := sign.MakeSignatures(canonical, signer)
+			if err != nil {
+				return false, fmt.Errorf("could not sign root.json: %w", err)
+			}
+			signatures[id] = s[0]
+		}
+	}
+
+	signed.Signatures = make([]data.Signature, 0, len(signatures))
+	root.StagedSignatures = 0
+	for _, id := range sortedKeys(signatures) {
+		signed.Signatures = append(signed.Signatures, signatures[id])
+		if !operator[id] {
+			root.StagedSignatures++
+		}
+	}
+	content, err := json.MarshalIndent(signed, "", "  ")
+	if err != nil {
+		return false, err
+	}
+
+	if !thresholdMet(desired, signatures) || (published != nil && !thresholdMet(published, signatures)) ||
+		root.StagedSignatures < root.RequiredSignatures {
+		root.Staged = content
+		return false, nil
+	}
+	r[RootRole+".json"] = content
+	// clients update the trusted root version by version
+	r[util.VersionedPath(RootRole+".json", desired.Version)] = content
+	root.Staged, root.StagedSignatures = nil, 0
+	return true, nil
+}
+
+// root returns the published root metadata, or nil when the root isn't published yet.
+func (r Repository) root() (*data.Root, error) {
+	content, ok := r[RootRole+".json"]
+	if !ok {
+		return nil, nil
+	}
+	root := &data.Root{}
+	if err := parseSigned(content, &data.Signed{}, root); err != nil {
+		return nil, fmt.Errorf("could not parse root.json: %w", err)
+	}
+	return root, nil
+}
+
+// trusts returns whether the root metadata lists the key of the signer for the role.
+// Every key is trusted when the root isn't published yet.
+func trusts(root *data.Root, role string, signer keys.Signer) bool {
+	if root == nil {
+		return true
+	}
+	r, ok := root.Roles[role]
+	if !ok {
+		return false
+	}
+	for _, id := range signer.PublicData().IDs() {
+		if slices.Contains(r.KeyIDs, id) {
+			return true
+		}
+	}
+	return false
+}
+
+func rootKeys(root *data.Root) map[string]*data.PublicKey {
+	trusted := make(map[string]*data.PublicKey)
+	if role, ok := root.Roles[RootRole]; ok {
+		for _, id := range role.KeyIDs {
+			if key, ok := root.Keys[id]; ok {
+				trusted[id] = key
+			}
+		}
+	}
+	return trusted
+}
+
+func thresholdMet(root *data.Root, signatures map[string]data.Signature) bool {
+	role, ok := root.Roles[RootRole]
+	if !ok {
+		return false
+	}
+	count := 0
+	for _, id := range role.KeyIDs {
+		if _, ok := signatures[id]; ok {
+			count++
+		}
+	}
+	return count >= role.Threshold
+}
+
+// equalSigned returns whether the metadata has the same content as the signed part of the published file.
+func equalSigned(meta any, content []byte) (bool, error) {
+	published := &data.Signed{}
+	if err := json.Unmarshal(content, published); err != nil {
+		return false, err
+	}
+	desired, err := cjson.EncodeCanonical(meta)
+	if err != nil {
+		return false, err
+	}
+	canonical, err := cjson.EncodeCanonical(published.Signed)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(desired, canonical), nil
+}
+
+func parseSigned(content []byte, signed *data.Signed, meta any) error {
+	if err := json.Unmarshal(content, signed); err != nil {
+		return err
+	}
+	return json.Unmarshal(signed.Signed, meta)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	result := make([]string, 0, len(m))
+	for k := range m {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,149 @@
+package utils
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/secure-systems-lab/go-securesystemslib/cjson"
+	"github.com/securesign/operator/api/v1alpha1"
+	"github.com/theupdateframework/go-tuf/data"
+	"github.com/theupdateframework/go-tuf/pkg/keys"
+	"github.com/theupdateframework/go-tuf/sign"
+	"github.com/theupdateframework/go-tuf/verify"
+)
+
+func signStaged(g *WithT, staged []byte, signer keys.Signer) data.Signature {
+	s := &data.Signed{}
+	g.Expect(json.Unmarshal(staged, s)).To(Succeed())
+	canonical, err := cjson.EncodeCanonical(s.Signed)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	signatures, err := sign.MakeSignatures(canonical, signer)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	return signatures[0]
+}
+
+// verifyRoot verifies the root metadata with the keys of the trusted root
+func verifyRoot(g *WithT, trusted []byte, content []byte) {
+	root := &data.Root{}
+	g.Expect(parseSigned(trusted, &data.Signed{}, root)).To(Succeed())
+	db := verify.NewDB()
+	for id, key := range root.Keys {
+		g.Expect(db.AddKey(id, key)).To(Succeed())
+	}
+	g.Expect(db.AddRole(RootRole, root.Roles[RootRole])).To(Succeed())
+	s := &data.Signed{}
+	g.Expect(json.Unmarshal(content, s)).To(Succeed())
+	g.Expect(db.VerifySignatures(s, RootRole)).To(Succeed())
+}
+
+func TestParseRootKey(t *testing.T) {
+	g := NewWithT(t)
+
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	public, signer, err := ParseRootKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(signer).ToNot(BeNil())
+
+	der, err = x509.MarshalPKIXPublicKey(publicKey)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	offline, offlineSigner, err := ParseRootKey(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(offlineSigner).To(BeNil())
+	g.Expect(offline.IDs()).To(Equal(public.IDs()))
+
+	_, _, err = ParseRootKey([]byte("not a key"))
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestRootRotation(t *testing.T) {
+	g := NewWithT(t)
+	now := time.Now().UTC().Truncate(time.Second)
+	expiration := ExpirationFor(v1alpha1.TufExpiration{})
+	signers := roleSigners(g)
+	targets := map[string][]byte{"rekor.pub": []byte("rekor")}
+
+	operatorKey := signers[RootRole]
+	offlineKey := roleSigners(g)[RootRole]
+	root := &RootSigning{
+		Keys:               []*data.PublicKey{operatorKey.PublicData(), offlineKey.PublicData()},
+		Threshold:          2,
+		Signers:            []keys.Signer{operatorKey},
+		RequiredSignatures: 1,
+	}
+
+	// the first root version waits for the offline key
+	r := Repository{}
+	updated, err := r.Update(targets, signers, root, expiration, now)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(updated).To(Equal([]string{TargetsRole, SnapshotRole, TimestampRole}))
+	g.Expect(r).ToNot(HaveKey("root.json"))
+	g.Expect(root.Staged).ToNot(BeNil())
+	g.Expect(root.StagedSignatures).To(Equal(0))
+	staged, err := ParseMetadata(root.Staged)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(staged.Version).To(Equal(int64(1)))
+
+	// the staged version doesn't change while waiting for signatures
+	first := root.Staged
+	_, err = r.Update(targets, signers, root, expiration, now.Add(time.Hour))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(root.Staged).To(Equal(first))
+
+	root.Signatures = []data.Signature{signStaged(g, root.Staged, offlineKey)}
+	updated, err = r.Update(targets, signers, root, expiration, now)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(updated).To(Equal([]string{RootRole}))
+	g.Expect(root.Staged).To(BeNil())
+	g.Expect(r).To(HaveKey("1.root.json"))
+	verifyRepository(g, r)
+
+	// the rotated root is cross-signed by the previous operator key
+	newKey := roleSigners(g)[RootRole]
+	root.Keys = []*data.PublicKey{newKey.PublicData(), offlineKey.PublicData()}
+	root.Signers = []keys.Signer{operatorKey, newKey}
+	// the new timestamp key waits for the new root version
+	signers[TimestampRole] = roleSigners(g)[TimestampRole]
+	updated, err = r.Update(targets, signers, root, expiration, now)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(updated).To(BeEmpty())
+	g.Expect(root.Staged).ToNot(BeNil())
+	// the signature of the previous version isn't valid anymore
+	g.Expect(root.StagedSignatures).To(Equal(0))
+	staged, err = ParseMetadata(root.Staged)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(staged.Version).To(Equal(int64(2)))
+	s := &data.Signed{}
+	g.Expect(json.Unmarshal(root.Staged, s)).To(Succeed())
+	g.Expect(s.Signatures).To(HaveLen(2))
+	verifyRepository(g, r)
+
+	root.Signatures = append(root.Signatures, signStaged(g, root.Staged, offlineKey))
+	updated, err = r.Update(targets, signers, root, expiration, now)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(updated).To(Equal([]string{RootRole, TimestampRole}))
+	g.Expect(root.Staged).To(BeNil())
+	g.Expect(r).To(HaveKey("1.root.json"))
+	g.Expect(r).To(HaveKey("2.root.json"))
+	verifyRoot(g, r["1.root.json"], r["2.root.json"])
+	verifyRoot(g, r["2.root.json"], r["2.root.json"])
+	verifyRepository(g, r)
+
+	// published root keeps no staged version
+	updated, err = r.Update(targets, signers, root, expiration, now)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(updated).To(BeEmpty())
+	g.Expect(root.Staged).To(BeNil())
+
+	root.Threshold = 3
+	_, err = r.Update(targets, signers, root, expiration, now)
+	g.Expect(err).To(MatchError(ContainSubstring("root threshold 3")))
+}
@@ -1,44 +1,60 @@
 package utils
 
 import (
+	"slices"
+
 	"github.com/securesign/operator/api/v1alpha1"
 	"github.com/securesign/operator/controllers/constants"
 	apps "k8s.io/api/apps/v1"
 	core "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
-func secretsVolumeProjection(keys []v1alpha1.TufKey) *core.ProjectedVolumeSource {
+const (
+	// RepositoryAnnotation restarts the server with a PVC when the repository changes
+	RepositoryAnnotation = constants.LabelNamespace + "/tuf-repository"
 
-	projections := make([]core.VolumeProjection, 0)
+	repositoryVolume          = "repository"
+	repositoryConfigMapVolume = "repository-configmap"
+	// document root of the httpd image
+	documentRoot       = "/var/www/html"
+	configMapMountPath = "/var/run/tuf-repository"
 
-	for _, key := range keys {
-		p := core.VolumeProjection{Secret: selectorToProjection(key.SecretRef, key.Name)}
-		projections = append(projections, p)
-	}
+	// copyRepositoryScript copies the files from the ConfigMap to the PVC, the previous root versions are kept.
+	copyRepositoryScript = `set -e
+mkdir -p ` + documentRoot + `/targets
+cd ` + configMapMountPath + `
+for f in *; do
+  case "$f" in
+    ` + targetsKeyPrefix + `*) cp -L "$f" "` + documentRoot + `/targets/${f#` + targetsKeyPrefix + `}" ;;
+    *) cp -L "$f" "` + documentRoot + `/$f" ;;
+  esac
+done
+`
+)
 
-	return &core.ProjectedVolumeSource{
-		Sources: projections,
+// configMapItems maps the ConfigMap keys to the paths in the repository.
+func configMapItems(repository *core.ConfigMap) []core.KeyToPath {
+	keys := make([]string, 0, len(repository.Data)+len(repository.BinaryData))
+	for key := range repository.Data {
+		keys = append(keys, key)
 	}
-}
+	for key := range repository.BinaryData {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
 
-func selectorToProjection(secret *v1alpha1.SecretKeySelector, path string) *core.SecretProjection {
-	return &core.SecretProjection{
-		LocalObjectReference: core.LocalObjectReference{
-			Name: secret.Name,
-		},
-		Items: []core.KeyToPath{
-			{
-				Key:  secret.Key,
-				Path: path,
-			},
-		},
+	items := make([]core.KeyToPath, len(keys))
+	for i, key := range keys {
+		items[i] = core.KeyToPath{Key: key, Path: RepositoryPath(key)}
 	}
+	return items
 }
 
-func CreateTufDeployment(instance *v1alpha1.Tuf, dpName string, sa string, labels map[string]string) *apps.Deployment {
+func CreateTufDeployment(instance *v1alpha1.Tuf, dpName string, sa string, labels map[string]string, repository *core.ConfigMap) *apps.Deployment {
 	replicas := int32(1)
-	return &apps.Deployment{
+	dp := &apps.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      dpName,
 			Namespace: instance.Namespace,
@@ -55,14 +71,6 @@ func CreateTufDeployment(instance *v1alpha1.Tuf, dpName string, sa string, label
 				},
 				Spec: core.PodSpec{
 					ServiceAccountName: sa,
-					Volumes: []core.Volume{
-						{
-							Name: "tuf-secrets",
-							VolumeSource: core.VolumeSource{
-								Projected: secretsVolumeProjection(instance.Status.Keys),
-							},
-						},
-					},
 					Containers: []core.Container{
 						{
 							Name:  "tuf",
@@ -73,16 +81,18 @@ func CreateTufDeployment(instance *v1alpha1.Tuf, dpName string, sa string, label
 									ContainerPort: 8080,
 								},
 							},
-							Env: []core.EnvVar{
-								{
-									Name:  "NAMESPACE",
-									Value: instance.Namespace,
+							ReadinessProbe: &core.Probe{
+								ProbeHandler: core.ProbeHandler{
+									HTTPGet: &core.HTTPGetAction{
+										Path: "/root.json",
+										Port: intstr.FromInt32(8080),
+									},
 								},
 							},
 							VolumeMounts: []core.VolumeMount{
 								{
-									Name:      "tuf-secrets",
-									MountPath: "/var/run/tuf-secrets",
+									Name:      repositoryVolume,
+									MountPath: documentRoot,
 								},
 							},
 						},
@@ -91,4 +101,61 @@ func CreateTufDeployment(instance *v1alpha1.Tuf, dpName string, sa string, label
 			},
 		},
 	}
+
+	if instance.Spec.Repository.Type != v1alpha1.TufRepositoryPVC {
+		// the ConfigMap is projected in the document root, the changed metadata is served without a restart
+		dp.Spec.Template.Spec.Volumes = []core.Volume{
+			{
+				Name: repositoryVolume,
+				VolumeSource: core.VolumeSource{
+					ConfigMap: &core.ConfigMapVolumeSource{
+						LocalObjectReference: core.LocalObjectReference{Name: repository.Name},
+						Items:                configMapItems(repository),
+					},
+				},
+			},
+		}
+		dp.Spec.Template.Spec.Containers[0].VolumeMounts[0].ReadOnly = true
+		return dp
+	}
+
+	dp.Spec.Strategy = apps.DeploymentStrategy{Type: apps.RecreateDeploymentStrategyType}
+	dp.Spec.Template.Annotations = map[string]string{RepositoryAnnotation: repository.ResourceVersion}
+	dp.Spec.Template.Spec.Volumes = []core.Volume{
+		{
+			Name: repositoryVolume,
+			VolumeSource: core.VolumeSource{
+				PersistentVolumeClaim: &core.PersistentVolumeClaimVolumeSource{
+					ClaimName: instance.Status.PvcName,
+				},
+			},
+		},
+		{
+			Name: repositoryConfigMapVolume,
+			VolumeSource: core.VolumeSource{
+				ConfigMap: &core.ConfigMapVolumeSource{
+					LocalObjectReference: core.LocalObjectReference{Name: repository.Name},
+				},
+			},
+		},
+	}
+	dp.Spec.Template.Spec.InitContainers = []core.Container{
+		{
+			Name:    "copy-repository",
+			Image:   constants.TufImage,
+			Command: []string{"/bin/sh", "-c", copyRepositoryScript},
+			VolumeMounts: []core.VolumeMount{
+				{
+					Name:      repositoryVolume,
+					MountPath: documentRoot,
+				},
+				{
+					Name:      repositoryConfigMapVolume,
+					MountPath: configMapMountPath,
+					ReadOnly:  true,
+				},
+			},
+		},
+	}
+	return dp
 }
@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/securesign/operator/api/v1alpha1"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCreateTufDeployment(t *testing.T) {
+	g := NewWithT(t)
+
+	instance := &v1alpha1.Tuf{
+		ObjectMeta: metav1.ObjectMeta{Name: "tuf", Namespace: "default"},
+	}
+	repository := &core.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "tuf-repository", Namespace: "default", ResourceVersion: "42"},
+		Data:       map[string]string{"root.json": "{}", "1.root.json": "{}"},
+		BinaryData: map[string][]byte{"targets_rekor.pub": []byte("rekor")},
+	}
+
+	dp := CreateTufDeployment(instance, "tuf", "tuf", map[string]string{}, repository)
+	g.Expect(dp.Spec.Template.Spec.InitContainers).To(BeEmpty())
+	g.Expect(dp.Spec.Template.Annotations).ToNot(HaveKey(RepositoryAnnotation))
+	g.Expect(dp.Spec.Template.Spec.Volumes).To(HaveLen(1))
+	g.Expect(dp.Spec.Template.Spec.Volumes[0].ConfigMap.Items).To(Equal([]core.KeyToPath{
+		{Key: "1.root.json", Path: "1.root.json"},
+		{Key: "root.json", Path: "root.json"},
+		{Key: "targets_rekor.pub", Path: "targets/rekor.pub"},
+	}))
+	g.Expect(dp.Spec.Template.Spec.Containers[0].VolumeMounts[0].ReadOnly).To(BeTrue())
+
+	instance.Spec.Repository.Type = v1alpha1.TufRepositoryPVC
+	instance.Status.PvcName = "tuf-pvc"
+	dp = CreateTufDeployment(instance, "tuf", "tuf", map[string]string{}, repository)
+	g.Expect(dp.Spec.Strategy.Type).To(Equal(apps.RecreateDeploymentStrategyType))
+	g.Expect(dp.Spec.Template.Annotations).To(HaveKeyWithValue(RepositoryAnnotation, "42"))
+	g.Expect(dp.Spec.Template.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal("tuf-pvc"))
+	g.Expect(dp.Spec.Template.Spec.Volumes[1].ConfigMap.Items).To(BeEmpty())
+	g.Expect(dp.Spec.Template.Spec.InitContainers).To(HaveLen(1))
+	g.Expect(dp.Spec.Template.Spec.InitContainers[0].Command[2]).To(ContainSubstring(`targets_*) cp -L "$f" "/var/www/html/targets/${f#targets_}"`))
+}
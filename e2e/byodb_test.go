@@ -53,12 +53,12 @@ var _ = Describe("Securesign install with byodb", Ordered, func() {
 				},
 			},
 			Spec: v1alpha1.SecuresignSpec{
-				Rekor: v1alpha1.RekorSpec{
+				Rekor: v1alpha1.SecuresignRekorSpec{RekorSpec: v1alpha1.RekorSpec{
 					ExternalAccess: v1alpha1.ExternalAccess{
 						Enabled: true,
 					},
-				},
-				Fulcio: v1alpha1.FulcioSpec{
+				}},
+				Fulcio: v1alpha1.SecuresignFulcioSpec{FulcioSpec: v1alpha1.FulcioSpec{
 					ExternalAccess: v1alpha1.ExternalAccess{
 						Enabled: true,
 					},
@@ -76,19 +76,19 @@ var _ = Describe("Securesign install with byodb", Ordered, func() {
 						OrganizationEmail: "my@email.org",
 						CommonName:        "fulcio",
 					},
-				},
-				Tuf: v1alpha1.TufSpec{
+				}},
+				Tuf: v1alpha1.SecuresignTufSpec{TufSpec: v1alpha1.TufSpec{
 					ExternalAccess: v1alpha1.ExternalAccess{
 						Enabled: true,
 					},
-				},
-				Ctlog: v1alpha1.CTlogSpec{},
-				Trillian: v1alpha1.TrillianSpec{Db: v1alpha1.TrillianDB{
+				}},
+				Ctlog: v1alpha1.SecuresignCTlogSpec{},
+				Trillian: v1alpha1.SecuresignTrillianSpec{TrillianSpec: v1alpha1.TrillianSpec{Db: v1alpha1.TrillianDB{
 					Create: new(bool),
 					DatabaseSecretRef: &v1alpha1.LocalObjectReference{
 						Name: "my-db",
 					},
-				}},
+				}}},
 			},
 		}
 	})
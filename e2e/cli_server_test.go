@@ -11,26 +11,26 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	"github.com/onsi/gomega"
-	v1 "k8s.io/api/networking/v1"
-	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+	"github.com/securesign/operator/api/v1alpha1"
+	"github.com/securesign/operator/controllers/constants"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
 )
 
-const cliServerNs = "trusted-artifact-signer"
-
 var _ = Describe("CliServer is running", func() {
 	cli, _ := CreateClient()
 	ctx := context.TODO()
 
 	When("operator is installed ", func() {
 		It("is up exposed", func() {
-			lst := &v1.IngressList{}
-			gomega.Expect(cli.List(ctx, lst, ctrl.InNamespace(cliServerNs))).To(gomega.Succeed())
-			gomega.Expect(len(lst.Items)).To(gomega.Equal(1))
-			protocol := "http://"
-			if len(lst.Items[0].Spec.TLS) > 0 {
-				protocol = "https://"
-			}
-			url := protocol + lst.Items[0].Spec.Rules[0].Host
+			server := &v1alpha1.ClientServer{}
+			gomega.Eventually(func() bool {
+				if err := cli.Get(ctx, types.NamespacedName{Name: constants.ClientServerName}, server); err != nil {
+					return false
+				}
+				return meta.IsStatusConditionTrue(server.Status.Conditions, constants.Ready)
+			}).Should(gomega.BeTrue())
+			url := server.Status.Url
 			tr := &http.Transport{
 				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
 			}
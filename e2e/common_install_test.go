@@ -53,15 +53,15 @@ var _ = Describe("Securesign install with certificate generation", Ordered, func
 				},
 			},
 			Spec: v1alpha1.SecuresignSpec{
-				Rekor: v1alpha1.RekorSpec{
+				Rekor: v1alpha1.SecuresignRekorSpec{RekorSpec: v1alpha1.RekorSpec{
 					ExternalAccess: v1alpha1.ExternalAccess{
 						Enabled: true,
 					},
 					RekorSearchUI: v1alpha1.RekorSearchUI{
 						Enabled: utils.Pointer(true),
 					},
-				},
-				Fulcio: v1alpha1.FulcioSpec{
+				}},
+				Fulcio: v1alpha1.SecuresignFulcioSpec{FulcioSpec: v1alpha1.FulcioSpec{
 					ExternalAccess: v1alpha1.ExternalAccess{
 						Enabled: true,
 					},
@@ -79,16 +79,16 @@ var _ = Describe("Securesign install with certificate generation", Ordered, func
 						OrganizationEmail: "my@email.org",
 						CommonName:        "fulcio",
 					},
-				},
-				Ctlog: v1alpha1.CTlogSpec{},
-				Tuf: v1alpha1.TufSpec{
+				}},
+				Ctlog: v1alpha1.SecuresignCTlogSpec{},
+				Tuf: v1alpha1.SecuresignTufSpec{TufSpec: v1alpha1.TufSpec{
 					ExternalAccess: v1alpha1.ExternalAccess{
 						Enabled: true,
 					},
-				},
-				Trillian: v1alpha1.TrillianSpec{Db: v1alpha1.TrillianDB{
-					Create: utils.Pointer(true),
 				}},
+				Trillian: v1alpha1.SecuresignTrillianSpec{TrillianSpec: v1alpha1.TrillianSpec{Db: v1alpha1.TrillianDB{
+					Create: utils.Pointer(true),
+				}}},
 			},
 		}
 	})
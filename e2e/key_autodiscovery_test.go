@@ -51,7 +51,7 @@ var _ = Describe("Securesign key autodiscovery test", Ordered, func() {
 				},
 			},
 			Spec: v1alpha1.SecuresignSpec{
-				Rekor: v1alpha1.RekorSpec{
+				Rekor: v1alpha1.SecuresignRekorSpec{RekorSpec: v1alpha1.RekorSpec{
 					ExternalAccess: v1alpha1.ExternalAccess{
 						Enabled: true,
 					},
@@ -64,8 +64,8 @@ var _ = Describe("Securesign key autodiscovery test", Ordered, func() {
 							Key: "private",
 						},
 					},
-				},
-				Fulcio: v1alpha1.FulcioSpec{
+				}},
+				Fulcio: v1alpha1.SecuresignFulcioSpec{FulcioSpec: v1alpha1.FulcioSpec{
 					ExternalAccess: v1alpha1.ExternalAccess{
 						Enabled: true,
 					},
@@ -98,8 +98,8 @@ var _ = Describe("Securesign key autodiscovery test", Ordered, func() {
 							Key: "cert",
 						},
 					},
-				},
-				Ctlog: v1alpha1.CTlogSpec{
+				}},
+				Ctlog: v1alpha1.SecuresignCTlogSpec{CTlogSpec: v1alpha1.CTlogSpec{
 					PrivateKeyRef: &v1alpha1.SecretKeySelector{
 						LocalObjectReference: v1alpha1.LocalObjectReference{
 							Name: "my-ctlog-secret",
@@ -114,15 +114,15 @@ var _ = Describe("Securesign key autodiscovery test", Ordered, func() {
 							Key: "cert",
 						},
 					},
-				},
-				Tuf: v1alpha1.TufSpec{
+				}},
+				Tuf: v1alpha1.SecuresignTufSpec{TufSpec: v1alpha1.TufSpec{
 					ExternalAccess: v1alpha1.ExternalAccess{
 						Enabled: true,
 					},
-				},
-				Trillian: v1alpha1.TrillianSpec{Db: v1alpha1.TrillianDB{
-					Create: utils.Pointer(true),
 				}},
+				Trillian: v1alpha1.SecuresignTrillianSpec{TrillianSpec: v1alpha1.TrillianSpec{Db: v1alpha1.TrillianDB{
+					Create: utils.Pointer(true),
+				}}},
 			},
 		}
 	})
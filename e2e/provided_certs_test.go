@@ -59,7 +59,7 @@ var _ = Describe("Securesign install with provided certs", Ordered, func() {
 				},
 			},
 			Spec: v1alpha1.SecuresignSpec{
-				Rekor: v1alpha1.RekorSpec{
+				Rekor: v1alpha1.SecuresignRekorSpec{RekorSpec: v1alpha1.RekorSpec{
 					ExternalAccess: v1alpha1.ExternalAccess{
 						Enabled: true,
 					},
@@ -72,8 +72,8 @@ var _ = Describe("Securesign install with provided certs", Ordered, func() {
 							Key: "private",
 						},
 					},
-				},
-				Fulcio: v1alpha1.FulcioSpec{
+				}},
+				Fulcio: v1alpha1.SecuresignFulcioSpec{FulcioSpec: v1alpha1.FulcioSpec{
 					ExternalAccess: v1alpha1.ExternalAccess{
 						Enabled: true,
 					},
@@ -106,8 +106,8 @@ var _ = Describe("Securesign install with provided certs", Ordered, func() {
 							Key: "cert",
 						},
 					},
-				},
-				Ctlog: v1alpha1.CTlogSpec{
+				}},
+				Ctlog: v1alpha1.SecuresignCTlogSpec{CTlogSpec: v1alpha1.CTlogSpec{
 					PrivateKeyRef: &v1alpha1.SecretKeySelector{
 						LocalObjectReference: v1alpha1.LocalObjectReference{
 							Name: "my-ctlog-secret",
@@ -122,8 +122,8 @@ var _ = Describe("Securesign install with provided certs", Ordered, func() {
 							Key: "cert",
 						},
 					},
-				},
-				Tuf: v1alpha1.TufSpec{
+				}},
+				Tuf: v1alpha1.SecuresignTufSpec{TufSpec: v1alpha1.TufSpec{
 					ExternalAccess: v1alpha1.ExternalAccess{
 						Enabled: true,
 					},
@@ -156,10 +156,10 @@ var _ = Describe("Securesign install with provided certs", Ordered, func() {
 							},
 						},
 					},
-				},
-				Trillian: v1alpha1.TrillianSpec{Db: v1alpha1.TrillianDB{
-					Create: utils.Pointer(true),
 				}},
+				Trillian: v1alpha1.SecuresignTrillianSpec{TrillianSpec: v1alpha1.TrillianSpec{Db: v1alpha1.TrillianDB{
+					Create: utils.Pointer(true),
+				}}},
 			},
 		}
 	})
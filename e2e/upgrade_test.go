@@ -168,15 +168,15 @@ var _ = Describe("Operator upgrade", Ordered, func() {
 				},
 			},
 			Spec: tasv1alpha.SecuresignSpec{
-				Rekor: tasv1alpha.RekorSpec{
+				Rekor: tasv1alpha.SecuresignRekorSpec{RekorSpec: tasv1alpha.RekorSpec{
 					ExternalAccess: tasv1alpha.ExternalAccess{
 						Enabled: true,
 					},
 					RekorSearchUI: tasv1alpha.RekorSearchUI{
 						Enabled: utils.Pointer(true),
 					},
-				},
-				Fulcio: tasv1alpha.FulcioSpec{
+				}},
+				Fulcio: tasv1alpha.SecuresignFulcioSpec{FulcioSpec: tasv1alpha.FulcioSpec{
 					ExternalAccess: tasv1alpha.ExternalAccess{
 						Enabled: true,
 					},
@@ -194,16 +194,16 @@ var _ = Describe("Operator upgrade", Ordered, func() {
 						OrganizationEmail: "my@email.org",
 						CommonName:        "fulcio",
 					},
-				},
-				Ctlog: tasv1alpha.CTlogSpec{},
-				Tuf: tasv1alpha.TufSpec{
+				}},
+				Ctlog: tasv1alpha.SecuresignCTlogSpec{},
+				Tuf: tasv1alpha.SecuresignTufSpec{TufSpec: tasv1alpha.TufSpec{
 					ExternalAccess: tasv1alpha.ExternalAccess{
 						Enabled: true,
 					},
-				},
-				Trillian: tasv1alpha.TrillianSpec{Db: tasv1alpha.TrillianDB{
-					Create: utils.Pointer(true),
 				}},
+				Trillian: tasv1alpha.SecuresignTrillianSpec{TrillianSpec: tasv1alpha.TrillianSpec{Db: tasv1alpha.TrillianDB{
+					Create: utils.Pointer(true),
+				}}},
 			},
 		}
 
@@ -296,7 +296,8 @@ var _ = Describe("Operator upgrade", Ordered, func() {
 				d := &v13.Deployment{}
 				gomega.Expect(cli.Get(ctx, types.NamespacedName{
 					Namespace: namespace.Name,
-					Name:      k,
+					// the upgrade migrates the Deployments to the names scoped by the instance
+					Name: constants.InstanceName(k, securesignDeployment.Name),
 				}, d)).To(gomega.Succeed())
 
 				return d.Spec.Template.Spec.Containers[0].Image